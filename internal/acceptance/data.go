@@ -55,10 +55,17 @@ type TestData struct {
 
 	// resourceLabel is the local used for the resource - generally "test""
 	resourceLabel string
+
+	// random is used to generate random values when recording or replaying a test, so that these are
+	// consistent between the recording and the replay - otherwise this is nil
+	random *rand.Rand
 }
 
 // BuildTestData generates some test data for the given resource
 func BuildTestData(t *testing.T, resourceType string, resourceLabel string) TestData {
+	// this is started first since the environment variables are set from the recording when replaying
+	recording := startRecording(t)
+
 	testData := TestData{
 		RandomInteger:   RandTimeInt(),
 		RandomString:    randString(5),
@@ -70,6 +77,10 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		resourceLabel: resourceLabel,
 	}
 
+	if recording != nil {
+		testData.RandomInteger, testData.RandomString, testData.random = recording.randomValues()
+	}
+
 	if features.UseDynamicTestLocations() {
 		testData.Locations = availableLocations()
 	} else {
@@ -123,6 +134,10 @@ func (td *TestData) RandomStringOfLength(len int) string {
 		panic("Invalid Test: RandomStringOfLength: length argument must be between 1 and 1024 characters")
 	}
 
+	if td.random != nil {
+		return randStringFromSource(td.random, len, charSetAlphaNum)
+	}
+
 	return randString(len)
 }
 
@@ -140,3 +155,13 @@ func randStringFromCharSet(strlen int, charSet string) string {
 	}
 	return string(result)
 }
+
+// randStringFromSource generates a random string by selecting characters from the charset provided
+// using the specified source, so that the result is reproducible
+func randStringFromSource(source *rand.Rand, strlen int, charSet string) string {
+	result := make([]byte, strlen)
+	for i := 0; i < strlen; i++ {
+		result[i] = charSet[source.Intn(len(charSet))]
+	}
+	return string(result)
+}
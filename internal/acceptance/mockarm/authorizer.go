@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mockarm
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"golang.org/x/oauth2"
+)
+
+// Authorizer is an auth.Authorizer returning a static access token, since the Server doesn't authenticate requests
+type Authorizer struct{}
+
+var _ auth.Authorizer = Authorizer{}
+
+func (Authorizer) Token(_ context.Context, _ *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{
+		AccessToken: "mockarm",
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(time.Hour),
+	}, nil
+}
+
+func (Authorizer) AuxiliaryTokens(_ context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	return nil, nil
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package harness
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	authWrapper "github.com/hashicorp/go-azure-sdk/sdk/auth/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/mockarm"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const (
+	// SubscriptionId is the Subscription ID used by the Client returned from NewClient
+	SubscriptionId = "00000000-0000-0000-0000-000000000000"
+
+	// TenantId is the Tenant ID used by the Client returned from NewClient
+	TenantId = "11111111-1111-1111-1111-111111111111"
+)
+
+// NewClient returns a clients.Client whose Resource Manager requests are sent to the mock Server - since this builds
+// the clients for every Service Package, this can only be used from tests in an external (`_test`) package
+func NewClient(t *testing.T, server *mockarm.Server) *clients.Client {
+	env := environments.AzurePublic()
+	env.ResourceManager = environments.ResourceManagerAPI(server.URL)
+
+	authorizer := mockarm.Authorizer{}
+	client := &clients.Client{
+		Account: &clients.ResourceManagerAccount{
+			Environment:                      *env,
+			ClientId:                         "22222222-2222-2222-2222-222222222222",
+			ObjectId:                         "33333333-3333-3333-3333-333333333333",
+			SubscriptionId:                   SubscriptionId,
+			TenantId:                         TenantId,
+			AuthenticatedAsAServicePrincipal: true,
+		},
+	}
+
+	o := &common.ClientOptions{
+		Authorizers: &common.Authorizers{
+			BatchManagement: authorizer,
+			KeyVault:        authorizer,
+			ManagedHSM:      authorizer,
+			ResourceManager: authorizer,
+			Storage:         authorizer,
+			Synapse:         authorizer,
+			AuthorizerFunc: func(api environments.Api) (auth.Authorizer, error) {
+				return authorizer, nil
+			},
+		},
+		AuthConfig: &auth.Credentials{
+			Environment: *env,
+		},
+		Environment: *env,
+		Features:    features.Default(),
+
+		SubscriptionId: SubscriptionId,
+		TenantId:       TenantId,
+
+		BatchManagementAuthorizer: authWrapper.AutorestAuthorizer(authorizer),
+		KeyVaultAuthorizer:        authWrapper.AutorestAuthorizer(authorizer).BearerAuthorizerCallback(),
+		ManagedHSMAuthorizer:      authWrapper.AutorestAuthorizer(authorizer).BearerAuthorizerCallback(),
+		ResourceManagerAuthorizer: authWrapper.AutorestAuthorizer(authorizer),
+		SynapseAuthorizer:         authWrapper.AutorestAuthorizer(authorizer),
+
+		DisableTerraformPartnerID: true,
+		SkipProviderReg:           true,
+
+		ResourceManagerEndpoint: server.URL,
+	}
+
+	if err := client.Build(context.Background(), o); err != nil {
+		t.Fatalf("building client for the mock Resource Manager server: %+v", err)
+	}
+
+	return client
+}
+
+// ResourceTest runs the Create/Read/Update/Delete functions of a typed Resource against the mock Server, via the same
+// wrapper used by the Provider
+type ResourceTest struct {
+	t        *testing.T
+	client   *clients.Client
+	resource sdk.Resource
+	wrapped  *schema.Resource
+}
+
+func NewResourceTest(t *testing.T, client *clients.Client, resource sdk.Resource) *ResourceTest {
+	wrapper := sdk.NewResourceWrapper(resource)
+	wrapped, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building %q: %+v", resource.ResourceType(), err)
+	}
+
+	return &ResourceTest{
+		t:        t,
+		client:   client,
+		resource: resource,
+		wrapped:  wrapped,
+	}
+}
+
+// Create creates the resource using the raw configuration, returning the ResourceData once it's been read
+func (rt *ResourceTest) Create(config map[string]interface{}) *pluginsdk.ResourceData {
+	rt.t.Helper()
+
+	d := schema.TestResourceDataRaw(rt.t, rt.wrapped.Schema, config)
+	rt.run("Create", rt.resource.Create().Timeout, rt.wrapped.CreateContext, d)
+	return d
+}
+
+// Read reads the resource with the specified ID, the ID of the returned ResourceData is empty when it's gone
+func (rt *ResourceTest) Read(id string) *pluginsdk.ResourceData {
+	rt.t.Helper()
+
+	d := schema.TestResourceDataRaw(rt.t, rt.wrapped.Schema, map[string]interface{}{})
+	d.SetId(id)
+	rt.run("Read", rt.resource.Read().Timeout, rt.wrapped.ReadContext, d)
+	return d
+}
+
+// Update updates the resource with the specified ID using the raw configuration - where all fields in the
+// configuration are considered to have changed
+func (rt *ResourceTest) Update(id string, config map[string]interface{}) *pluginsdk.ResourceData {
+	rt.t.Helper()
+
+	v, ok := rt.resource.(sdk.ResourceWithUpdate)
+	if !ok {
+		rt.t.Fatalf("%q doesn't support being updated", rt.resource.ResourceType())
+	}
+
+	d := schema.TestResourceDataRaw(rt.t, rt.wrapped.Schema, config)
+	d.SetId(id)
+	rt.run("Update", v.Update().Timeout, rt.wrapped.UpdateContext, d)
+	return d
+}
+
+// Delete deletes the resource with the specified ID
+func (rt *ResourceTest) Delete(id string) {
+	rt.t.Helper()
+
+	d := schema.TestResourceDataRaw(rt.t, rt.wrapped.Schema, map[string]interface{}{})
+	d.SetId(id)
+	rt.run("Delete", rt.resource.Delete().Timeout, rt.wrapped.DeleteContext, d)
+}
+
+func (rt *ResourceTest) run(operation string, timeout time.Duration, fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, d *pluginsdk.ResourceData) {
+	rt.t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	for _, v := range fn(ctx, d, rt.client) {
+		if v.Severity == diag.Error {
+			rt.t.Fatalf("running %s for %q: %s", operation, rt.resource.ResourceType(), v.Summary)
+		}
+	}
+}
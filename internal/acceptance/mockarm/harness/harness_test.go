@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package harness_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/mockarm"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/mockarm/harness"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type exampleResourceModel struct {
+	Name     string            `tfschema:"name"`
+	Location string            `tfschema:"location"`
+	Tags     map[string]string `tfschema:"tags"`
+}
+
+// exampleResource is a minimal typed Resource used to exercise the harness
+type exampleResource struct{}
+
+var _ sdk.ResourceWithUpdate = exampleResource{}
+
+func (exampleResource) ResourceType() string {
+	return "azurerm_example"
+}
+
+func (exampleResource) ModelObject() interface{} {
+	return &exampleResourceModel{}
+}
+
+func (exampleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateResourceGroupID
+}
+
+func (exampleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"location": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (exampleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (exampleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.ResourceGroupsClient
+
+			var config exampleResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := commonids.NewResourceGroupID(metadata.Client.Account.SubscriptionId, config.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport("azurerm_example", id)
+			}
+
+			payload := resourcegroups.ResourceGroup{
+				Location: config.Location,
+				Tags:     pointer.To(config.Tags),
+			}
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (exampleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.ResourceGroupsClient
+
+			id, err := commonids.ParseResourceGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := exampleResourceModel{
+				Name: id.ResourceGroupName,
+			}
+			if model := resp.Model; model != nil {
+				state.Location = model.Location
+				state.Tags = pointer.From(model.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (exampleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.ResourceGroupsClient
+
+			id, err := commonids.ParseResourceGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config exampleResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := resourcegroups.ResourceGroupPatchable{}
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(config.Tags)
+			}
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (exampleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.ResourceGroupsClient
+
+			id, err := commonids.ParseResourceGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id, resourcegroups.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func TestResourceTest_CreateReadUpdateDelete(t *testing.T) {
+	server := mockarm.NewServer(t)
+	client := harness.NewClient(t, server)
+	rt := harness.NewResourceTest(t, client, exampleResource{})
+
+	d := rt.Create(map[string]interface{}{
+		"name":     "example",
+		"location": "westeurope",
+		"tags": map[string]interface{}{
+			"env": "test",
+		},
+	})
+
+	expectedId := commonids.NewResourceGroupID(harness.SubscriptionId, "example").ID()
+	if d.Id() != expectedId {
+		t.Fatalf("expected the ID to be %q but got %q", expectedId, d.Id())
+	}
+	if _, ok := server.Resource(expectedId); !ok {
+		t.Fatalf("expected %q to exist on the server", expectedId)
+	}
+
+	rt.Update(d.Id(), map[string]interface{}{
+		"name":     "example",
+		"location": "westeurope",
+		"tags": map[string]interface{}{
+			"env": "prod",
+		},
+	})
+
+	d = rt.Read(expectedId)
+	if v := d.Get("tags.env").(string); v != "prod" {
+		t.Fatalf("expected the tag `env` to be %q but got %q", "prod", v)
+	}
+	if v := d.Get("location").(string); v != "westeurope" {
+		t.Fatalf("expected the location to be %q but got %q", "westeurope", v)
+	}
+
+	rt.Delete(expectedId)
+	if d = rt.Read(expectedId); d.Id() != "" {
+		t.Fatalf("expected the resource to be removed from the state but got %q", d.Id())
+	}
+}
@@ -0,0 +1,435 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mockarm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	operationsPath       = "/mockarm/operations/"
+	operationResultsPath = "/mockarm/operationResults/"
+)
+
+// Request is a request which was received by the Server
+type Request struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+type handler struct {
+	method  string
+	pattern *regexp.Regexp
+	fn      http.HandlerFunc
+}
+
+type operation struct {
+	// remaining is the number of polls remaining until this operation completes
+	remaining int
+}
+
+// Server is an in-process fake of the Azure Resource Manager API, which stores resources in memory keyed by
+// their Resource ID. PUT and PATCH requests are completed as Long Running Operations which are polled using
+// the `Azure-AsyncOperation` header, and POST requests (actions) using the `Location` header.
+type Server struct {
+	// URL is the base URL of the Server, which is used as the Resource Manager endpoint
+	URL string
+
+	// LongRunningOperationPolls is the number of times a Long Running Operation returns `InProgress`
+	// before completing, which defaults to 1
+	LongRunningOperationPolls int
+
+	server *httptest.Server
+
+	mu         sync.Mutex
+	handlers   []handler
+	operations map[string]*operation
+	requests   []Request
+
+	// resources is a map of the lower-cased Resource ID to the resource
+	resources map[string]map[string]interface{}
+}
+
+// NewServer starts a Server which is stopped once the test completes
+func NewServer(t *testing.T) *Server {
+	s := &Server{
+		LongRunningOperationPolls: 1,
+		operations:                map[string]*operation{},
+		resources:                 map[string]map[string]interface{}{},
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+	t.Cleanup(s.server.Close)
+	return s
+}
+
+// Handle registers a handler for requests with the specified HTTP method whose path matches the (case-insensitive)
+// regular expression - which takes precedence over the default behaviour, e.g. for actions returning a body
+func (s *Server) Handle(method, pathPattern string, fn http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers = append(s.handlers, handler{
+		method:  method,
+		pattern: regexp.MustCompile("(?i)" + pathPattern),
+		fn:      fn,
+	})
+}
+
+// SetResource stores the resource with the specified Resource ID, replacing any existing resource
+func (s *Server) SetResource(id string, resource interface{}) error {
+	contents, err := json.Marshal(resource)
+	if err != nil {
+		return fmt.Errorf("marshalling resource %q: %+v", id, err)
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(contents, &body); err != nil {
+		return fmt.Errorf("unmarshalling resource %q: %+v", id, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resources[strings.ToLower(id)] = normalizeResource(id, body)
+	return nil
+}
+
+// Resource returns the resource with the specified Resource ID, if it exists
+func (s *Server) Resource(id string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resource, ok := s.resources[strings.ToLower(id)]
+	return resource, ok
+}
+
+// Requests returns the requests received by the Server, in the order they were received
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	output := make([]Request, len(s.requests))
+	copy(output, s.requests)
+	return output
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("reading request body: %+v", err))
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Body:   body,
+	})
+	var custom http.HandlerFunc
+	for _, h := range s.handlers {
+		if strings.EqualFold(h.method, r.Method) && h.pattern.MatchString(r.URL.Path) {
+			custom = h.fn
+		}
+	}
+	s.mu.Unlock()
+
+	if custom != nil {
+		custom(w, r)
+		return
+	}
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	switch {
+	case strings.HasPrefix(path, operationsPath) && r.Method == http.MethodGet:
+		s.getOperationStatus(w, strings.TrimPrefix(path, operationsPath))
+	case strings.HasPrefix(path, operationResultsPath) && r.Method == http.MethodGet:
+		s.getOperationResult(w, strings.TrimPrefix(path, operationResultsPath))
+	case r.Method == http.MethodGet && isCollection(path):
+		s.list(w, path)
+	case r.Method == http.MethodGet:
+		s.get(w, path)
+	case r.Method == http.MethodPut:
+		s.put(w, r, path, body)
+	case r.Method == http.MethodPatch:
+		s.patch(w, r, path, body)
+	case r.Method == http.MethodDelete:
+		s.delete(w, r, path)
+	case r.Method == http.MethodPost:
+		s.action(w, r, path)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("the method %q is not supported", r.Method))
+	}
+}
+
+func (s *Server) get(w http.ResponseWriter, id string) {
+	resource, ok := s.Resource(id)
+	if !ok {
+		writeNotFound(w, id)
+		return
+	}
+	writeJSON(w, http.StatusOK, resource)
+}
+
+func (s *Server) list(w http.ResponseWriter, path string) {
+	s.mu.Lock()
+	prefix := strings.ToLower(path) + "/"
+	keys := make([]string, 0)
+	for key := range s.resources {
+		if strings.HasPrefix(key, prefix) && !strings.Contains(strings.TrimPrefix(key, prefix), "/") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	values := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, s.resources[key])
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"value": values,
+	})
+}
+
+func (s *Server) put(w http.ResponseWriter, r *http.Request, id string, body []byte) {
+	var resource map[string]interface{}
+	if err := json.Unmarshal(body, &resource); err != nil || resource == nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("the request body for %q must be a JSON object", id))
+		return
+	}
+
+	s.mu.Lock()
+	key := strings.ToLower(id)
+	_, exists := s.resources[key]
+	resource = normalizeResource(id, resource)
+	s.resources[key] = resource
+	s.mu.Unlock()
+
+	statusCode := http.StatusOK
+	if !exists {
+		statusCode = http.StatusCreated
+	}
+	s.startOperation(w, r, "Azure-AsyncOperation", operationsPath)
+	writeJSON(w, statusCode, resource)
+}
+
+func (s *Server) patch(w http.ResponseWriter, r *http.Request, id string, body []byte) {
+	var update map[string]interface{}
+	if err := json.Unmarshal(body, &update); err != nil || update == nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("the request body for %q must be a JSON object", id))
+		return
+	}
+
+	s.mu.Lock()
+	key := strings.ToLower(id)
+	existing, ok := s.resources[key]
+	if !ok {
+		s.mu.Unlock()
+		writeNotFound(w, id)
+		return
+	}
+	resource := normalizeResource(id, mergePatch(existing, update).(map[string]interface{}))
+	s.resources[key] = resource
+	s.mu.Unlock()
+
+	s.startOperation(w, r, "Azure-AsyncOperation", operationsPath)
+	writeJSON(w, http.StatusOK, resource)
+}
+
+func (s *Server) delete(w http.ResponseWriter, r *http.Request, id string) {
+	s.mu.Lock()
+	key := strings.ToLower(id)
+	_, ok := s.resources[key]
+	if ok {
+		// deleting a resource also deletes any nested resources
+		for k := range s.resources {
+			if k == key || strings.HasPrefix(k, key+"/") {
+				delete(s.resources, k)
+			}
+		}
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// the resource is removed immediately, so callers polling on the resource see a 404
+	s.startOperation(w, r, "Azure-AsyncOperation", operationsPath)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) action(w http.ResponseWriter, r *http.Request, path string) {
+	// an action is a POST to a path nested under the resource, e.g. `{resourceId}/restart`
+	idx := strings.LastIndex(path, "/")
+	if idx <= 0 {
+		writeNotFound(w, path)
+		return
+	}
+	if _, ok := s.Resource(path[:idx]); !ok {
+		writeNotFound(w, path[:idx])
+		return
+	}
+
+	s.startOperation(w, r, "Location", operationResultsPath)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// startOperation starts a Long Running Operation, returning the polling URL in the specified header
+func (s *Server) startOperation(w http.ResponseWriter, r *http.Request, header, path string) {
+	s.mu.Lock()
+	name := fmt.Sprintf("operation-%d", len(s.requests))
+	s.operations[strings.ToLower(name)] = &operation{
+		remaining: s.LongRunningOperationPolls,
+	}
+	s.mu.Unlock()
+
+	w.Header().Set(header, fmt.Sprintf("%s%s%s?api-version=%s", s.URL, path, name, r.URL.Query().Get("api-version")))
+	w.Header().Set("Retry-After", "0")
+}
+
+// pollOperation returns whether the named operation has completed, and whether it exists
+func (s *Server) pollOperation(name string) (bool, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	op, ok := s.operations[strings.ToLower(name)]
+	if !ok {
+		return false, false
+	}
+	if op.remaining > 0 {
+		op.remaining--
+		return false, true
+	}
+	return true, true
+}
+
+func (s *Server) getOperationStatus(w http.ResponseWriter, name string) {
+	done, ok := s.pollOperation(name)
+	if !ok {
+		writeNotFound(w, name)
+		return
+	}
+
+	status := "InProgress"
+	if done {
+		status = "Succeeded"
+	}
+	w.Header().Set("Retry-After", "0")
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"name":   name,
+		"status": status,
+	})
+}
+
+func (s *Server) getOperationResult(w http.ResponseWriter, name string) {
+	done, ok := s.pollOperation(name)
+	if !ok {
+		writeNotFound(w, name)
+		return
+	}
+
+	w.Header().Set("Retry-After", "0")
+	if !done {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// isCollection returns whether the path is a collection of resources (e.g. `.../providers/Microsoft.Foo/bars`)
+// rather than a resource - where the segments following the last Resource Provider are type/name pairs
+func isCollection(path string) bool {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := len(segments) - 2; i >= 0; i-- {
+		if strings.EqualFold(segments[i], "providers") {
+			segments = segments[i+2:]
+			break
+		}
+	}
+	return len(segments)%2 == 1
+}
+
+// normalizeResource sets the `id`, `name` and `type` of the resource and marks it as provisioned
+func normalizeResource(id string, resource map[string]interface{}) map[string]interface{} {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	resource["id"] = id
+	resource["name"] = segments[len(segments)-1]
+
+	for i := len(segments) - 2; i >= 0; i-- {
+		if strings.EqualFold(segments[i], "providers") && i+1 < len(segments) {
+			types := []string{segments[i+1]}
+			for j := i + 2; j < len(segments); j += 2 {
+				types = append(types, segments[j])
+			}
+			resource["type"] = strings.Join(types, "/")
+			break
+		}
+	}
+
+	if properties, ok := resource["properties"].(map[string]interface{}); ok {
+		properties["provisioningState"] = "Succeeded"
+	}
+
+	return resource
+}
+
+// mergePatch applies the update to the existing value as a JSON Merge Patch (RFC 7396)
+func mergePatch(existing interface{}, update interface{}) interface{} {
+	updateMap, ok := update.(map[string]interface{})
+	if !ok {
+		return update
+	}
+
+	existingMap, ok := existing.(map[string]interface{})
+	if !ok {
+		existingMap = map[string]interface{}{}
+	}
+
+	output := make(map[string]interface{}, len(existingMap))
+	for k, v := range existingMap {
+		output[k] = v
+	}
+	for k, v := range updateMap {
+		if v == nil {
+			delete(output, k)
+			continue
+		}
+		output[k] = mergePatch(output[k], v)
+	}
+	return output
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeNotFound(w http.ResponseWriter, id string) {
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource %q was not found.", id))
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mockarm_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/mockarm"
+)
+
+func newResourceGroupsClient(t *testing.T, server *mockarm.Server) *resourcegroups.ResourceGroupsClient {
+	client, err := resourcegroups.NewResourceGroupsClientWithBaseURI(environments.ResourceManagerAPI(server.URL))
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	client.Client.SetAuthorizer(mockarm.Authorizer{})
+	return client
+}
+
+func TestServer_CreateReadUpdateDelete(t *testing.T) {
+	server := mockarm.NewServer(t)
+	server.LongRunningOperationPolls = 2
+	client := newResourceGroupsClient(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	id := commonids.NewResourceGroupID("00000000-0000-0000-0000-000000000000", "example")
+
+	resp, err := client.Get(ctx, id)
+	if !response.WasNotFound(resp.HttpResponse) {
+		t.Fatalf("expected a 404 for a resource which doesn't exist but got %+v", err)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, resourcegroups.ResourceGroup{
+		Location: "westeurope",
+		Tags: pointer.To(map[string]string{
+			"env": "test",
+		}),
+	}); err != nil {
+		t.Fatalf("creating %s: %+v", id, err)
+	}
+
+	if _, err := client.Update(ctx, id, resourcegroups.ResourceGroupPatchable{
+		Tags: pointer.To(map[string]string{
+			"env":  "prod",
+			"team": "example",
+		}),
+	}); err != nil {
+		t.Fatalf("updating %s: %+v", id, err)
+	}
+
+	resp, err = client.Get(ctx, id)
+	if err != nil {
+		t.Fatalf("retrieving %s: %+v", id, err)
+	}
+	if resp.Model == nil || resp.Model.Location != "westeurope" || pointer.From(resp.Model.Name) != "example" {
+		t.Fatalf("expected the resource to be returned but got %+v", resp.Model)
+	}
+	if tags := pointer.From(resp.Model.Tags); tags["env"] != "prod" || tags["team"] != "example" {
+		t.Fatalf("expected the tags to be updated but got %+v", tags)
+	}
+
+	list, err := client.ListComplete(ctx, commonids.NewSubscriptionID(id.SubscriptionId), resourcegroups.DefaultListOperationOptions())
+	if err != nil {
+		t.Fatalf("listing resource groups: %+v", err)
+	}
+	if len(list.Items) != 1 {
+		t.Fatalf("expected 1 resource group but got %d", len(list.Items))
+	}
+
+	// exporting a template is an action which is polled using the `Location` header
+	if err := client.ExportTemplateThenPoll(ctx, id, resourcegroups.ExportTemplateRequest{}); err != nil {
+		t.Fatalf("exporting template for %s: %+v", id, err)
+	}
+
+	if _, err := client.Delete(ctx, id, resourcegroups.DefaultDeleteOperationOptions()); err != nil {
+		t.Fatalf("deleting %s: %+v", id, err)
+	}
+	if _, ok := server.Resource(id.ID()); ok {
+		t.Fatalf("expected %s to be deleted", id)
+	}
+
+	operationPolls := 0
+	for _, req := range server.Requests() {
+		if req.Method == http.MethodGet && strings.HasPrefix(req.Path, "/mockarm/") {
+			operationPolls++
+		}
+	}
+	// the export is polled until the operation completes, the create and update aren't long running operations in this API
+	if expected := server.LongRunningOperationPolls + 1; operationPolls != expected {
+		t.Fatalf("expected %d polls of long running operations but got %d", expected, operationPolls)
+	}
+}
+
+func TestServer_Handle(t *testing.T) {
+	server := mockarm.NewServer(t)
+	client := newResourceGroupsClient(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	id := commonids.NewResourceGroupID("00000000-0000-0000-0000-000000000000", "example")
+	if err := server.SetResource(id.ID(), resourcegroups.ResourceGroup{Location: "westeurope"}); err != nil {
+		t.Fatalf("seeding %s: %+v", id, err)
+	}
+
+	server.Handle(http.MethodGet, `/resourceGroups/example$`, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":       id.ID(),
+			"location": "eastus",
+		})
+	})
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		t.Fatalf("retrieving %s: %+v", id, err)
+	}
+	if resp.Model == nil || resp.Model.Location != "eastus" {
+		t.Fatalf("expected the response from the custom handler but got %+v", resp.Model)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// EnvRecordingsDirectory is the Environment Variable which overrides the directory containing the Cassettes
+// for each test, which defaults to `testdata/recordings` within the package being tested
+const EnvRecordingsDirectory = "ARM_TEST_RECORDINGS_DIR"
+
+// recordedEnvironmentVariables are saved into the Cassette when recording and set when replaying, since these
+// are used in the test configurations and as such must match the recorded requests
+var recordedEnvironmentVariables = []string{
+	"ARM_CLIENT_ID",
+	"ARM_CLIENT_ID_ALT",
+	"ARM_SUBSCRIPTION_ID",
+	"ARM_SUBSCRIPTION_ID_ALT",
+	"ARM_TENANT_ID",
+	"ARM_TEST_LOCATION",
+	"ARM_TEST_LOCATION_ALT",
+	"ARM_TEST_LOCATION_ALT2",
+	"ARM_TEST_SUBSCRIPTION_ID_ALT",
+}
+
+type recording struct {
+	recorder *common.Recorder
+
+	// testDataCount is the number of times BuildTestData has been called for this test
+	testDataCount int
+}
+
+var (
+	recordingsLock sync.Mutex
+	recordings     = map[*testing.T]*recording{}
+)
+
+var cassetteNameRegex = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// startRecording returns the recording for this test when `ARM_TEST_RECORDING_MODE` is set, starting it
+// (and making it the active recording) when this is the first call for this test
+func startRecording(t *testing.T) *recording {
+	mode := common.RecordingModeFromEnvironment()
+	if mode == "" {
+		return nil
+	}
+
+	recordingsLock.Lock()
+	defer recordingsLock.Unlock()
+
+	if r, ok := recordings[t]; ok {
+		return r
+	}
+
+	directory := os.Getenv(EnvRecordingsDirectory)
+	if directory == "" {
+		directory = filepath.Join("testdata", "recordings")
+	}
+	path := filepath.Join(directory, cassetteNameRegex.ReplaceAllString(t.Name(), "_")+".json")
+
+	recorder, err := common.NewRecorder(mode, path)
+	if err != nil {
+		t.Fatalf("starting the %s recording: %+v", mode, err)
+	}
+
+	for _, name := range recordedEnvironmentVariables {
+		value := recorder.Variable(name, func() string {
+			return os.Getenv(name)
+		})
+		if mode == common.RecordingModeReplay {
+			os.Setenv(name, value)
+		}
+	}
+	if mode == common.RecordingModeReplay && os.Getenv("ARM_CLIENT_SECRET") == "" {
+		// the client secret isn't recorded, however it's required by the PreCheck
+		os.Setenv("ARM_CLIENT_SECRET", "replayed")
+	}
+
+	common.SetActiveRecorder(recorder)
+
+	r := &recording{
+		recorder: recorder,
+	}
+	recordings[t] = r
+
+	t.Cleanup(func() {
+		recordingsLock.Lock()
+		delete(recordings, t)
+		recordingsLock.Unlock()
+
+		common.SetActiveRecorder(nil)
+		if err := recorder.Stop(); err != nil {
+			t.Errorf("stopping the %s recording: %+v", mode, err)
+		}
+	})
+
+	return r
+}
+
+// randomValues returns the random values for a TestData, which are saved into the Cassette when recording
+// and loaded from the Cassette when replaying
+func (r *recording) randomValues() (int, string, *rand.Rand) {
+	recordingsLock.Lock()
+	suffix := ""
+	if r.testDataCount > 0 {
+		suffix = fmt.Sprintf("_%d", r.testDataCount)
+	}
+	r.testDataCount++
+	recordingsLock.Unlock()
+
+	randomInteger, _ := strconv.Atoi(r.recorder.Variable("random_integer"+suffix, func() string {
+		return strconv.Itoa(RandTimeInt())
+	}))
+	randomString := r.recorder.Variable("random_string"+suffix, func() string {
+		return randString(5)
+	})
+	seed, _ := strconv.ParseInt(r.recorder.Variable("random_seed"+suffix, func() string {
+		return strconv.FormatInt(rand.Int63(), 10)
+	}), 10, 64)
+
+	return randomInteger, randomString, rand.New(rand.NewSource(seed)) // nolint:gosec
+}
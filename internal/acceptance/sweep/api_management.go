@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/deletedservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func init() {
+	AddSweeper("azurerm_api_management", []string{"azurerm_resource_group"}, sweepDeletedApiManagements)
+}
+
+func sweepDeletedApiManagements(ctx context.Context, client *clients.Client, subscriptionId commonids.SubscriptionId, options Options) error {
+	resp, err := client.ApiManagement.DeletedServicesClient.ListBySubscriptionComplete(ctx, subscriptionId)
+	if err != nil {
+		return fmt.Errorf("listing the soft-deleted API Management Services within %s: %+v", subscriptionId, err)
+	}
+
+	ids := make([]deletedservice.DeletedServiceId, 0)
+	for _, item := range resp.Items {
+		if item.Id == nil || item.Name == nil || item.Properties == nil {
+			continue
+		}
+		props := item.Properties
+
+		if !isTestResource(*item.Name, props.ServiceId) || !options.InRegion(pointer.From(item.Location)) || !options.OldEnough(parseTime(props.DeletionDate)) {
+			continue
+		}
+
+		id, err := deletedservice.ParseDeletedServiceIDInsensitively(*item.Id)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, *id)
+	}
+
+	return sweep(ctx, options, "soft-deleted API Management Service", ids, func(ctx context.Context, id deletedservice.DeletedServiceId) error {
+		return client.ApiManagement.DeletedServicesClient.PurgeThenPoll(ctx, id)
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/deletedconfigurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func init() {
+	AddSweeper("azurerm_app_configuration", []string{"azurerm_resource_group"}, sweepDeletedAppConfigurations)
+}
+
+func sweepDeletedAppConfigurations(ctx context.Context, client *clients.Client, subscriptionId commonids.SubscriptionId, options Options) error {
+	resp, err := client.AppConfiguration.DeletedConfigurationStoresClient.ConfigurationStoresListDeletedComplete(ctx, subscriptionId)
+	if err != nil {
+		return fmt.Errorf("listing the soft-deleted App Configurations within %s: %+v", subscriptionId, err)
+	}
+
+	ids := make([]deletedconfigurationstores.DeletedConfigurationStoreId, 0)
+	for _, item := range resp.Items {
+		if item.Id == nil || item.Name == nil || item.Properties == nil {
+			continue
+		}
+		props := item.Properties
+
+		if !isTestResource(*item.Name, props.ConfigurationStoreId) || !options.InRegion(pointer.From(props.Location)) || !options.OldEnough(parseTime(props.DeletionDate)) {
+			continue
+		}
+		if pointer.From(props.PurgeProtectionEnabled) {
+			log.Printf("[DEBUG] Skipping the soft-deleted App Configuration %q since Purge Protection is enabled", *item.Name)
+			continue
+		}
+
+		id, err := deletedconfigurationstores.ParseDeletedConfigurationStoreIDInsensitively(*item.Id)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, *id)
+	}
+
+	return sweep(ctx, options, "soft-deleted App Configuration", ids, func(ctx context.Context, id deletedconfigurationstores.DeletedConfigurationStoreId) error {
+		return client.AppConfiguration.DeletedConfigurationStoresClient.ConfigurationStoresPurgeDeletedThenPoll(ctx, id)
+	})
+}
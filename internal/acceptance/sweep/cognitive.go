@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2023-05-01/cognitiveservicesaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func init() {
+	AddSweeper("azurerm_cognitive_account", []string{"azurerm_resource_group"}, sweepDeletedCognitiveAccounts)
+}
+
+func sweepDeletedCognitiveAccounts(ctx context.Context, client *clients.Client, subscriptionId commonids.SubscriptionId, options Options) error {
+	resp, err := client.Cognitive.AccountsClient.DeletedAccountsListComplete(ctx, subscriptionId)
+	if err != nil {
+		return fmt.Errorf("listing the soft-deleted Cognitive Accounts within %s: %+v", subscriptionId, err)
+	}
+
+	ids := make([]cognitiveservicesaccounts.DeletedAccountId, 0)
+	for _, item := range resp.Items {
+		if item.Id == nil || item.Name == nil {
+			continue
+		}
+
+		// the ID of a soft-deleted Cognitive Account contains the Resource Group which it was in
+		if !isTestResource(*item.Name, item.Id) || !options.InRegion(pointer.From(item.Location)) {
+			continue
+		}
+		var deletedAt *string
+		if item.Properties != nil {
+			deletedAt = item.Properties.DeletionDate
+		}
+		if !options.OldEnough(parseTime(deletedAt)) {
+			continue
+		}
+
+		id, err := cognitiveservicesaccounts.ParseDeletedAccountIDInsensitively(*item.Id)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, *id)
+	}
+
+	return sweep(ctx, options, "soft-deleted Cognitive Account", ids, func(ctx context.Context, id cognitiveservicesaccounts.DeletedAccountId) error {
+		return client.Cognitive.AccountsClient.DeletedAccountsPurgeThenPoll(ctx, id)
+	})
+}
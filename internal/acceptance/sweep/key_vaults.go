@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-02-01/vaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func init() {
+	// deleting a Resource Group soft-deletes the Key Vaults and Managed HSMs within it, so these are purged afterwards
+	AddSweeper("azurerm_key_vault", []string{"azurerm_resource_group"}, sweepDeletedKeyVaults)
+	AddSweeper("azurerm_key_vault_managed_hardware_security_module", []string{"azurerm_resource_group"}, sweepDeletedManagedHSMs)
+}
+
+func sweepDeletedKeyVaults(ctx context.Context, client *clients.Client, subscriptionId commonids.SubscriptionId, options Options) error {
+	resp, err := client.KeyVault.VaultsClient.ListDeletedComplete(ctx, subscriptionId)
+	if err != nil {
+		return fmt.Errorf("listing the soft-deleted Key Vaults within %s: %+v", subscriptionId, err)
+	}
+
+	ids := make([]vaults.DeletedVaultId, 0)
+	for _, item := range resp.Items {
+		if item.Id == nil || item.Name == nil || item.Properties == nil {
+			continue
+		}
+		props := item.Properties
+
+		if !isTestResource(*item.Name, props.VaultId) || !options.InRegion(pointer.From(props.Location)) || !options.OldEnough(parseTime(props.DeletionDate)) {
+			continue
+		}
+		if pointer.From(props.PurgeProtectionEnabled) {
+			log.Printf("[DEBUG] Skipping the soft-deleted Key Vault %q since Purge Protection is enabled", *item.Name)
+			continue
+		}
+
+		id, err := vaults.ParseDeletedVaultIDInsensitively(*item.Id)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, *id)
+	}
+
+	return sweep(ctx, options, "soft-deleted Key Vault", ids, func(ctx context.Context, id vaults.DeletedVaultId) error {
+		return client.KeyVault.VaultsClient.PurgeDeletedThenPoll(ctx, id)
+	})
+}
+
+func sweepDeletedManagedHSMs(ctx context.Context, client *clients.Client, subscriptionId commonids.SubscriptionId, options Options) error {
+	resp, err := client.ManagedHSMs.ManagedHsmClient.ListDeletedComplete(ctx, subscriptionId)
+	if err != nil {
+		return fmt.Errorf("listing the soft-deleted Managed HSMs within %s: %+v", subscriptionId, err)
+	}
+
+	ids := make([]managedhsms.DeletedManagedHSMId, 0)
+	for _, item := range resp.Items {
+		if item.Id == nil || item.Name == nil || item.Properties == nil {
+			continue
+		}
+		props := item.Properties
+
+		if !isTestResource(*item.Name, props.MhsmId) || !options.InRegion(pointer.From(props.Location)) || !options.OldEnough(parseTime(props.DeletionDate)) {
+			continue
+		}
+		if pointer.From(props.PurgeProtectionEnabled) {
+			log.Printf("[DEBUG] Skipping the soft-deleted Managed HSM %q since Purge Protection is enabled", *item.Name)
+			continue
+		}
+
+		id, err := managedhsms.ParseDeletedManagedHSMIDInsensitively(*item.Id)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, *id)
+	}
+
+	return sweep(ctx, options, "soft-deleted Managed HSM", ids, func(ctx context.Context, id managedhsms.DeletedManagedHSMId) error {
+		return client.ManagedHSMs.ManagedHsmClient.PurgeDeletedThenPoll(ctx, id)
+	})
+}
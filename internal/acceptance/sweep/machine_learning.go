@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func init() {
+	AddSweeper("azurerm_machine_learning_workspace", nil, sweepMachineLearningWorkspaces)
+}
+
+// sweepMachineLearningWorkspaces deletes and purges the Machine Learning Workspaces created by the Acceptance Tests,
+// since soft-deleted Workspaces can't be listed - and as such can't be purged once their Resource Group is deleted
+func sweepMachineLearningWorkspaces(ctx context.Context, client *clients.Client, subscriptionId commonids.SubscriptionId, options Options) error {
+	resp, err := client.MachineLearning.Workspaces.ListBySubscriptionComplete(ctx, subscriptionId, workspaces.DefaultListBySubscriptionOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing the Machine Learning Workspaces within %s: %+v", subscriptionId, err)
+	}
+
+	ids := make([]workspaces.WorkspaceId, 0)
+	for _, item := range resp.Items {
+		if item.Id == nil || item.Name == nil {
+			continue
+		}
+
+		if !isTestResource(*item.Name, item.Id) || !options.InRegion(pointer.From(item.Location)) {
+			continue
+		}
+		var createdAt *string
+		if item.SystemData != nil {
+			createdAt = &item.SystemData.CreatedAt
+		}
+		if !options.OldEnough(parseTime(createdAt)) {
+			continue
+		}
+
+		id, err := workspaces.ParseWorkspaceIDInsensitively(*item.Id)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, *id)
+	}
+
+	return sweep(ctx, options, "Machine Learning Workspace", ids, func(ctx context.Context, id workspaces.WorkspaceId) error {
+		return client.MachineLearning.Workspaces.DeleteThenPoll(ctx, id, workspaces.DeleteOperationOptions{
+			ForceToPurge: pointer.To(true),
+		})
+	})
+}
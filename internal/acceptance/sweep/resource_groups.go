@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// resourceGroupPrefixes are the prefixes of the Resource Groups created by the Acceptance Tests
+var resourceGroupPrefixes = []string{
+	"acctestrg",
+	"acctest-rg",
+}
+
+func init() {
+	// Machine Learning Workspaces are soft-deleted when their Resource Group is deleted, so are purged first
+	AddSweeper("azurerm_resource_group", []string{"azurerm_machine_learning_workspace"}, sweepResourceGroups)
+}
+
+func sweepResourceGroups(ctx context.Context, client *clients.Client, subscriptionId commonids.SubscriptionId, options Options) error {
+	resp, err := client.Resource.ResourceGroupsClient.ListComplete(ctx, subscriptionId, resourcegroups.DefaultListOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing Resource Groups within %s: %+v", subscriptionId, err)
+	}
+
+	ids := make([]commonids.ResourceGroupId, 0)
+	for _, item := range resp.Items {
+		if item.Name == nil || !isTestResourceGroup(*item.Name) || !options.InRegion(item.Location) {
+			continue
+		}
+
+		// Resource Groups don't expose when they were created, so this is determined from the name
+		if !options.OldEnough(timeFromTestName(*item.Name)) {
+			continue
+		}
+
+		// Resource Groups which are already being deleted are skipped
+		if item.Properties != nil && item.Properties.ProvisioningState != nil && strings.EqualFold(*item.Properties.ProvisioningState, "Deleting") {
+			continue
+		}
+
+		ids = append(ids, commonids.NewResourceGroupID(subscriptionId.SubscriptionId, *item.Name))
+	}
+
+	return sweep(ctx, options, "Resource Group", ids, func(ctx context.Context, id commonids.ResourceGroupId) error {
+		return client.Resource.ResourceGroupsClient.DeleteThenPoll(ctx, id, resourcegroups.DefaultDeleteOperationOptions())
+	})
+}
+
+func isTestResourceGroup(name string) bool {
+	for _, prefix := range resourceGroupPrefixes {
+		if strings.HasPrefix(strings.ToLower(name), prefix) {
+			return true
+		}
+	}
+	return false
+}
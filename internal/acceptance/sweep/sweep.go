@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package sweep contains Sweepers which remove the infrastructure leaked by failed Acceptance Tests, such as
+// Resource Groups which weren't deleted and soft-deleted resources which weren't purged.
+//
+// The Sweepers are run using `go test ./internal/acceptance/sweep -v -sweep=westeurope`, where the `-sweep` flag
+// contains a comma-separated list of Azure Regions (or `all` for every Region) and `-sweep-run` can be used to
+// run specific Sweepers.
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+const (
+	// EnvDryRun is the Environment Variable which, when set to `true`, logs the resources which would be
+	// deleted/purged by the Sweepers without doing so
+	EnvDryRun = "ARM_SWEEP_DRY_RUN"
+
+	// EnvMinimumAge is the Environment Variable containing how old (e.g. `6h`) a resource must be before it's
+	// swept, defaulting to 24 hours - so that resources used by Acceptance Tests which are running aren't swept
+	EnvMinimumAge = "ARM_SWEEP_MINIMUM_AGE"
+
+	// AllRegions is the value of the `-sweep` flag used to sweep resources regardless of their Region
+	AllRegions = "all"
+)
+
+const (
+	defaultMinimumAge = 24 * time.Hour
+
+	// maxConcurrentDeletions is the maximum number of resources which are deleted/purged at once
+	maxConcurrentDeletions = 10
+
+	// sweeperTimeout is the maximum duration of a single Sweeper
+	sweeperTimeout = 3 * time.Hour
+)
+
+// testNamePrefix is the prefix used for the names of resources created by the Acceptance Tests
+const testNamePrefix = "acctest"
+
+// Options configures which resources are swept and how
+type Options struct {
+	// Region is the Azure Region which resources are swept from, or `all`
+	Region string
+
+	// DryRun specifies that the resources which would be swept are logged rather than deleted/purged
+	DryRun bool
+
+	// MinimumAge is how old a resource must be before it's swept
+	MinimumAge time.Duration
+}
+
+// OptionsFromEnvironment returns the Options for the specified Region, configured using Environment Variables
+func OptionsFromEnvironment(region string) (*Options, error) {
+	options := Options{
+		Region:     region,
+		MinimumAge: defaultMinimumAge,
+	}
+
+	if v := os.Getenv(EnvDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `%s`: %+v", EnvDryRun, err)
+		}
+		options.DryRun = dryRun
+	}
+
+	if v := os.Getenv(EnvMinimumAge); v != "" {
+		minimumAge, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `%s`: %+v", EnvMinimumAge, err)
+		}
+		options.MinimumAge = minimumAge
+	}
+
+	return &options, nil
+}
+
+// InRegion returns whether a resource in the specified location should be swept
+func (o Options) InRegion(input string) bool {
+	return strings.EqualFold(o.Region, AllRegions) || location.Normalize(input) == location.Normalize(o.Region)
+}
+
+// OldEnough returns whether a resource created (or deleted) at the specified time should be swept, where
+// resources without a known time are only swept when the minimum age is zero
+func (o Options) OldEnough(at *time.Time) bool {
+	if o.MinimumAge <= 0 {
+		return true
+	}
+	return at != nil && time.Since(*at) >= o.MinimumAge
+}
+
+// SweeperFunc removes the leaked resources matching the Options within the Subscription
+type SweeperFunc func(ctx context.Context, client *clients.Client, subscriptionId commonids.SubscriptionId, options Options) error
+
+// AddSweeper registers a Sweeper with the specified name, which is run after the Sweepers it depends on
+func AddSweeper(name string, dependencies []string, f SweeperFunc) {
+	resource.AddTestSweepers(name, &resource.Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
+		F: func(region string) error {
+			options, err := OptionsFromEnvironment(region)
+			if err != nil {
+				return err
+			}
+
+			client, err := testclient.Build()
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), sweeperTimeout)
+			defer cancel()
+
+			subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+			if err := f(ctx, client, subscriptionId, *options); err != nil {
+				return fmt.Errorf("running the Sweeper %q: %+v", name, err)
+			}
+
+			return nil
+		},
+	})
+}
+
+// resourceGroupRegex matches the name of the Resource Group within a Resource ID
+var resourceGroupRegex = regexp.MustCompile(`(?i)/resourceGroups/([^/]+)`)
+
+// isTestResource returns whether the resource was created by the Acceptance Tests - either since its name was
+// generated by the Acceptance Tests, or since it is (or was, for soft-deleted resources) within a Resource Group
+// created by the Acceptance Tests
+func isTestResource(name string, resourceId *string) bool {
+	if strings.HasPrefix(strings.ToLower(name), testNamePrefix) {
+		return true
+	}
+
+	if resourceId != nil {
+		if match := resourceGroupRegex.FindStringSubmatch(*resourceId); len(match) == 2 {
+			return isTestResourceGroup(match[1])
+		}
+	}
+
+	return false
+}
+
+// randTimeIntRegex matches the value generated by `acceptance.RandTimeInt()`, which begins with the time the test
+// started in the format `YYMMddHHmmss`
+var randTimeIntRegex = regexp.MustCompile(`\d{18}`)
+
+// timeFromTestName returns the time encoded into the name of a resource by `acceptance.RandTimeInt()`, if any
+func timeFromTestName(name string) *time.Time {
+	match := randTimeIntRegex.FindString(name)
+	if match == "" {
+		return nil
+	}
+
+	// RandTimeInt uses the local time of the machine running the tests, which is assumed to be the same here
+	t, err := time.ParseInLocation("060102150405", match[0:12], time.Local)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// parseTime parses a date returned by the Azure API, returning nil if it's unset or invalid
+func parseTime(input *string) *time.Time {
+	if input == nil || *input == "" {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, *input)
+	if err != nil {
+		log.Printf("[DEBUG] parsing the date %q: %+v", *input, err)
+		return nil
+	}
+	return &t
+}
+
+// sweep runs the specified function for each of the Resource IDs (unless this is a dry run), returning an error
+// containing each of the failures
+func sweep[T interface{ ID() string }](ctx context.Context, options Options, description string, ids []T, f func(ctx context.Context, id T) error) error {
+	if len(ids) == 0 {
+		log.Printf("[INFO] No %s to sweep", description)
+		return nil
+	}
+
+	if options.DryRun {
+		for _, id := range ids {
+			log.Printf("[INFO] Dry Run: would sweep the %s %q", description, id.ID())
+		}
+		return nil
+	}
+
+	log.Printf("[INFO] Sweeping %d %s..", len(ids), description)
+
+	var (
+		wg        sync.WaitGroup
+		errorLock sync.Mutex
+		errors    []string
+	)
+	semaphore := make(chan struct{}, maxConcurrentDeletions)
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id T) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			if err := f(ctx, id); err != nil {
+				errorLock.Lock()
+				errors = append(errors, fmt.Sprintf("%s: %+v", id.ID(), err))
+				errorLock.Unlock()
+				return
+			}
+			log.Printf("[INFO] Swept the %s %q", description, id.ID())
+		}(id)
+	}
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return fmt.Errorf("sweeping %d of %d %s:\n%s", len(errors), len(ids), description, strings.Join(errors, "\n"))
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestMain runs the Sweepers when the `-sweep` flag is specified, otherwise the tests within this package
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestIsTestResource(t *testing.T) {
+	testData := []struct {
+		name       string
+		resourceId *string
+		expected   bool
+	}{
+		{
+			name:     "acctestkv-abc12",
+			expected: true,
+		},
+		{
+			name:     "AccTestRG-230102150405001234",
+			expected: true,
+		},
+		{
+			name:     "production",
+			expected: false,
+		},
+		{
+			name:       "vault230102150405001234",
+			resourceId: pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/acctestRG-230102150405001234/providers/Microsoft.KeyVault/vaults/vault230102150405001234"),
+			expected:   true,
+		},
+		{
+			name:       "vault1",
+			resourceId: pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/production/providers/Microsoft.KeyVault/vaults/vault1"),
+			expected:   false,
+		},
+	}
+
+	for _, v := range testData {
+		if actual := isTestResource(v.name, v.resourceId); actual != v.expected {
+			t.Fatalf("expected %q to be a test resource: %t but got %t", v.name, v.expected, actual)
+		}
+	}
+}
+
+func TestTimeFromTestName(t *testing.T) {
+	actual := timeFromTestName("acctestRG-aks-230102150405001234")
+	if actual == nil {
+		t.Fatalf("expected a time but didn't get one")
+	}
+	expected := time.Date(2023, 1, 2, 15, 4, 5, 0, time.Local)
+	if !actual.Equal(expected) {
+		t.Fatalf("expected %s but got %s", expected, actual)
+	}
+
+	if v := timeFromTestName("acctestRG-12345"); v != nil {
+		t.Fatalf("expected no time but got %s", v)
+	}
+}
+
+func TestOptionsOldEnough(t *testing.T) {
+	options := Options{
+		MinimumAge: time.Hour,
+	}
+
+	if !options.OldEnough(pointer.To(time.Now().Add(-2 * time.Hour))) {
+		t.Fatalf("expected a resource created 2 hours ago to be old enough")
+	}
+	if options.OldEnough(pointer.To(time.Now().Add(-30 * time.Minute))) {
+		t.Fatalf("expected a resource created 30 minutes ago not to be old enough")
+	}
+	if options.OldEnough(nil) {
+		t.Fatalf("expected a resource without a known time not to be old enough")
+	}
+
+	options.MinimumAge = 0
+	if !options.OldEnough(nil) {
+		t.Fatalf("expected a resource without a known time to be old enough when there's no minimum age")
+	}
+}
+
+func TestOptionsInRegion(t *testing.T) {
+	options := Options{
+		Region: "westeurope",
+	}
+	if !options.InRegion("West Europe") {
+		t.Fatalf("expected `West Europe` to be in the region `westeurope`")
+	}
+	if options.InRegion("eastus") {
+		t.Fatalf("expected `eastus` not to be in the region `westeurope`")
+	}
+
+	options.Region = AllRegions
+	if !options.InRegion("eastus") {
+		t.Fatalf("expected `eastus` to be in the region `all`")
+	}
+}
+
+func TestOptionsFromEnvironment(t *testing.T) {
+	t.Setenv(EnvDryRun, "true")
+	t.Setenv(EnvMinimumAge, "6h")
+
+	options, err := OptionsFromEnvironment("westeurope")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !options.DryRun {
+		t.Fatalf("expected a dry run")
+	}
+	if options.MinimumAge != 6*time.Hour {
+		t.Fatalf("expected the minimum age to be 6h but got %s", options.MinimumAge)
+	}
+
+	t.Setenv(EnvMinimumAge, "invalid")
+	if _, err := OptionsFromEnvironment("westeurope"); err == nil {
+		t.Fatalf("expected an error for an invalid minimum age")
+	}
+}
@@ -4,16 +4,22 @@
 package acceptance
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
 )
 
 func (td TestData) DataSourceTest(t *testing.T, steps []TestStep) {
@@ -42,6 +48,20 @@ func (td TestData) DataSourceTestInSequence(t *testing.T, steps []TestStep) {
 }
 
 func (td TestData) ResourceTest(t *testing.T, testResource types.TestResource, steps []TestStep) {
+	td.runAcceptanceTest(t, td.resourceTestCase(t, testResource, steps))
+}
+
+// ResourceTestWriteOnly runs the specified steps in the same manner as ResourceTest, for tests which use Write-Only
+// arguments - since these are only supported from Terraform 1.11, these tests are skipped for older versions.
+func (td TestData) ResourceTestWriteOnly(t *testing.T, testResource types.TestResource, steps []TestStep) {
+	testCase := td.resourceTestCase(t, testResource, steps)
+	testCase.TerraformVersionChecks = []tfversion.TerraformVersionCheck{
+		tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+	}
+	td.runAcceptanceTest(t, testCase)
+}
+
+func (td TestData) resourceTestCase(t *testing.T, testResource types.TestResource, steps []TestStep) resource.TestCase {
 	// Testing framework as of 1.6.0 no longer auto-refreshes state, so adding it back in here for all steps that update
 	// the config rather than having to modify 1000's of tests individually to add a refresh-only step
 	var refreshStep = TestStep{
@@ -59,7 +79,7 @@ func (td TestData) ResourceTest(t *testing.T, testResource types.TestResource, s
 	}
 	steps = newSteps
 
-	testCase := resource.TestCase{
+	return resource.TestCase{
 		PreCheck: func() { PreCheck(t) },
 		CheckDestroy: func(s *terraform.State) error {
 			client, err := testclient.Build()
@@ -70,7 +90,6 @@ func (td TestData) ResourceTest(t *testing.T, testResource types.TestResource, s
 		},
 		Steps: steps,
 	}
-	td.runAcceptanceTest(t, testCase)
 }
 
 // ResourceTestIgnoreCheckDestroyed skips the check to confirm the resource test has been destroyed.
@@ -125,18 +144,44 @@ func RunTestsInSequence(t *testing.T, tests map[string]map[string]func(t *testin
 
 func (td TestData) runAcceptanceTest(t *testing.T, testCase resource.TestCase) {
 	testCase.ExternalProviders = td.externalProviders()
-	testCase.ProviderFactories = td.providers()
+	td.configureProviders(&testCase)
+
+	// the recorder is shared by all clients within this process, as such tests which are being recorded or
+	// replayed must run sequentially
+	if common.RecordingModeFromEnvironment() != "" {
+		resource.Test(t, testCase)
+		return
+	}
 
 	resource.ParallelTest(t, testCase)
 }
 
 func (td TestData) runAcceptanceSequentialTest(t *testing.T, testCase resource.TestCase) {
 	testCase.ExternalProviders = td.externalProviders()
-	testCase.ProviderFactories = td.providers()
+	td.configureProviders(&testCase)
 
 	resource.Test(t, testCase)
 }
 
+// configureProviders configures the Provider Factories used for this Test Case - when 4.0 Beta is enabled Resources
+// served natively by the Plugin Framework are only available when the Plugin SDK and Plugin Framework Providers
+// are muxed together.
+func (td TestData) configureProviders(testCase *resource.TestCase) {
+	if !features.FourPointOhBeta() {
+		testCase.ProviderFactories = td.providers()
+		return
+	}
+
+	for _, r := range provider.SupportedTypedFrameworkResources() {
+		if r.ResourceType() == td.ResourceType {
+			testCase.ProtoV5ProviderFactories = framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm", "azurerm-alt")
+			return
+		}
+	}
+
+	testCase.ProviderFactories = td.providers()
+}
+
 func (td TestData) providers() map[string]func() (*schema.Provider, error) {
 	return map[string]func() (*schema.Provider, error){
 		"azurerm": func() (*schema.Provider, error) { //nolint:unparam
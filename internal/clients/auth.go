@@ -8,12 +8,14 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/claims"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients/graph"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 )
@@ -142,3 +144,34 @@ func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, sub
 
 	return &account, nil
 }
+
+// NewRecordedResourceManagerAccount returns the ResourceManagerAccount saved in the Cassette when replaying, since
+// this can't be obtained without authenticating - otherwise the account is built and saved into the Cassette
+func NewRecordedResourceManagerAccount(ctx context.Context, recorder *common.Recorder, config auth.Credentials, subscriptionId string, registeredResourceProviders resourceproviders.ResourceProviders) (*ResourceManagerAccount, error) {
+	account := &ResourceManagerAccount{
+		Environment:                 config.Environment,
+		RegisteredResourceProviders: registeredResourceProviders,
+	}
+
+	if recorder.Mode() == common.RecordingModeRecord {
+		var err error
+		account, err = NewResourceManagerAccount(ctx, config, subscriptionId, registeredResourceProviders)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	account.ClientId = recorder.Variable("account_client_id", func() string { return account.ClientId })
+	account.ObjectId = recorder.Variable("account_object_id", func() string { return account.ObjectId })
+	account.SubscriptionId = recorder.Variable("account_subscription_id", func() string { return account.SubscriptionId })
+	account.TenantId = recorder.Variable("account_tenant_id", func() string { return account.TenantId })
+	account.AuthenticatedAsAServicePrincipal = recorder.Variable("account_authenticated_as_service_principal", func() string {
+		return strconv.FormatBool(account.AuthenticatedAsAServicePrincipal)
+	}) == "true"
+
+	if account.SubscriptionId == "" || account.TenantId == "" {
+		return nil, fmt.Errorf("unable to configure ResourceManagerAccount: the subscription ID and tenant ID were not found in the cassette")
+	}
+
+	return account, nil
+}
@@ -17,6 +17,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tracing"
 )
 
 type ClientBuilder struct {
@@ -28,10 +31,14 @@ type ClientBuilder struct {
 	DisableTerraformPartnerID   bool
 	MetadataHost                string
 	PartnerID                   string
+	RateLimit                   *common.RateLimitOptions
 	RegisteredResourceProviders resourceproviders.ResourceProviders
+	Retry                       *common.RetryOptions
 	StorageUseAzureAD           bool
 	SubscriptionID              string
+	TagsConfig                  tags.Config
 	TerraformVersion            string
+	TimeoutsConfig              timeouts.Defaults
 }
 
 const azureStackEnvironmentError = `
@@ -48,28 +55,37 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	// point folks towards the separate Azure Stack Provider when using Azure Stack
 	if builder.AuthConfig.Environment.IsAzureStack() {
-		return nil, fmt.Errorf(azureStackEnvironmentError)
+		return nil, fmt.Errorf("%s", azureStackEnvironmentError)
 	}
 
 	var resourceManagerAuth, storageAuth, synapseAuth, batchManagementAuth, keyVaultAuth auth.Authorizer
 
-	resourceManagerAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager)
+	newAuthorizer := auth.NewAuthorizerFromCredentials
+	recorder := common.ActiveRecorder()
+	if recorder != nil && recorder.Mode() == common.RecordingModeReplay {
+		// no requests are sent to Azure when replaying, so there's no need to authenticate
+		newAuthorizer = func(_ context.Context, _ auth.Credentials, _ environments.Api) (auth.Authorizer, error) {
+			return common.ReplayAuthorizer{}, nil
+		}
+	}
+
+	resourceManagerAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Resource Manager API: %+v", err)
 	}
 
-	storageAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage)
+	storageAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Storage API: %+v", err)
 	}
 
-	keyVaultAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.KeyVault)
+	keyVaultAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.KeyVault)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Key Vault API: %+v", err)
 	}
 
 	if builder.AuthConfig.Environment.Synapse.Available() {
-		synapseAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Synapse)
+		synapseAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Synapse)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Synapse API: %+v", err)
 		}
@@ -78,7 +94,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	if builder.AuthConfig.Environment.Batch.Available() {
-		batchManagementAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Batch)
+		batchManagementAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Batch)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Batch Management API: %+v", err)
 		}
@@ -88,7 +104,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	// Helper for obtaining endpoint-specific tokens
 	authorizerFunc := common.ApiAuthorizerFunc(func(api environments.Api) (auth.Authorizer, error) {
-		authorizer, err := auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
+		authorizer, err := newAuthorizer(ctx, *builder.AuthConfig, api)
 		if err != nil {
 			return nil, fmt.Errorf("building custom authorizer for API %q: %+v", api.Name(), err)
 		}
@@ -96,14 +112,19 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return authorizer, nil
 	})
 
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, builder.SubscriptionID, builder.RegisteredResourceProviders)
+	var account *ResourceManagerAccount
+	if recorder != nil {
+		account, err = NewRecordedResourceManagerAccount(ctx, recorder, *builder.AuthConfig, builder.SubscriptionID, builder.RegisteredResourceProviders)
+	} else {
+		account, err = NewResourceManagerAccount(ctx, *builder.AuthConfig, builder.SubscriptionID, builder.RegisteredResourceProviders)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("building account: %+v", err)
 	}
 
 	var managedHSMAuth auth.Authorizer
 	if builder.AuthConfig.Environment.ManagedHSM.Available() {
-		managedHSMAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ManagedHSM)
+		managedHSMAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ManagedHSM)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Managed HSM API: %+v", err)
 		}
@@ -117,7 +138,9 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	client := Client{
-		Account: account,
+		Account:        account,
+		TagsConfig:     builder.TagsConfig,
+		TimeoutsConfig: builder.TimeoutsConfig,
 	}
 
 	o := &common.ClientOptions{
@@ -152,9 +175,19 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		SkipProviderReg:             len(builder.RegisteredResourceProviders) == 0,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
 
+		Retry:     builder.Retry,
+		RateLimit: builder.RateLimit,
+
+		// the cached registration state of the Resource Providers is stale, so is refreshed on the next run
+		OnMissingSubscriptionRegistration: func() {
+			resourceproviders.InvalidateDiskCache(*resourceManagerEndpoint, account.SubscriptionId)
+		},
+
 		ResourceManagerEndpoint: *resourceManagerEndpoint,
 	}
 
+	tracing.SetCorrelationRequestID(o.CorrelationRequestID())
+
 	if err := client.Build(ctx, o); err != nil {
 		return nil, fmt.Errorf("building Client: %+v", err)
 	}
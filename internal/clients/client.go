@@ -152,6 +152,8 @@ import (
 	voiceServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/voiceservices/client"
 	web "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/client"
 	workloads "github.com/hashicorp/terraform-provider-azurerm/internal/services/workloads/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
 type Client struct {
@@ -163,6 +165,12 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// TagsConfig contains the `default_tags` and `ignore_tags` defined in the Provider block
+	TagsConfig tags.Config
+
+	// TimeoutsConfig contains the `default_timeouts` defined in the Provider block
+	TimeoutsConfig timeouts.Defaults
+
 	AadB2c                            *aadb2c_v2021_04_01_preview.Client
 	Advisor                           *advisor.Client
 	AnalysisServices                  *analysisservices_v2017_08_01.Client
@@ -291,6 +299,14 @@ type Client struct {
 	Workloads                         *workloads_v2023_04_01.Client
 }
 
+// DefaultSubscriptionId returns the Subscription ID which the Provider is configured to use
+func (client *Client) DefaultSubscriptionId() string {
+	if client.Account == nil {
+		return ""
+	}
+	return client.Account.SubscriptionId
+}
+
 // NOTE: it should be possible for this method to become Private once the top level Client's removed
 
 func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error {
@@ -9,7 +9,6 @@ import (
 	"strings"
 
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
@@ -57,6 +56,16 @@ type ClientOptions struct {
 	ResourceManagerAuthorizer autorest.Authorizer
 	SynapseAuthorizer         autorest.Authorizer
 
+	// Retry is the Retry Policy applied to requests, when nil only the retries within the SDKs are performed
+	Retry *RetryOptions
+
+	// RateLimit is the client-side Rate Limit applied to requests sent to the Subscription, when nil no Rate Limit is applied
+	RateLimit *RateLimitOptions
+
+	// OnMissingSubscriptionRegistration (when set) is called when a request fails since the Resource Provider isn't
+	// registered in the Subscription
+	OnMissingSubscriptionRegistration func()
+
 	// TODO: Remove when all go-autorest clients are gone
 	SkipProviderReg bool
 }
@@ -66,16 +75,37 @@ func (o ClientOptions) Configure(c client.BaseClient, authorizer auth.Authorizer
 	c.SetAuthorizer(authorizer)
 	c.SetUserAgent(userAgent(c.GetUserAgent(), o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID))
 
-	if !o.DisableCorrelationRequestID {
-		id := o.CustomCorrelationRequestID
-		if id == "" {
-			id = correlationRequestID()
-		}
+	if id := o.CorrelationRequestID(); id != "" {
 		c.AppendRequestMiddleware(correlationRequestIDMiddleware(id))
 	}
 
+	if o.RateLimit != nil {
+		c.AppendRequestMiddleware(rateLimiterMiddleware(rateLimiterForSubscription(o.SubscriptionId, *o.RateLimit)))
+	}
+
+	if o.Retry != nil {
+		c.AppendRequestMiddleware(rewindableRequestMiddleware())
+	}
+
 	c.AppendRequestMiddleware(requestLoggerMiddleware("AzureRM"))
+
+	// requests are redirected to the recorder last, so that the original request is logged
+	if RecordingModeFromEnvironment() != "" {
+		c.AppendRequestMiddleware(recorderMiddleware())
+	}
+
 	c.AppendResponseMiddleware(responseLoggerMiddleware("AzureRM"))
+	c.AppendResponseMiddleware(tracingMiddleware())
+
+	if o.OnMissingSubscriptionRegistration != nil {
+		c.AppendResponseMiddleware(missingSubscriptionRegistrationMiddleware(o.OnMissingSubscriptionRegistration))
+	}
+
+	// the Retry Policy is applied last, since any requests which are retried are re-sent using the client - and as
+	// such the response to each attempt is logged and traced
+	if o.Retry != nil {
+		c.AppendResponseMiddleware(retryPolicyMiddleware(*o.Retry, c))
+	}
 }
 
 // ConfigureClient sets up an autorest.Client using an autorest.Authorizer
@@ -83,17 +113,37 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	c.UserAgent = userAgent(c.UserAgent, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = buildSender("AzureRM")
+	if o.RateLimit != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, withRateLimiter(rateLimiterForSubscription(o.SubscriptionId, *o.RateLimit)))
+	}
+	if o.Retry != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, withRetryPolicy(*o.Retry))
+	}
+	c.Sender = autorest.DecorateSender(c.Sender, withTracing())
+	if o.OnMissingSubscriptionRegistration != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, withMissingSubscriptionRegistrationHandler(o.OnMissingSubscriptionRegistration))
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
-	if !o.DisableCorrelationRequestID {
-		id := o.CustomCorrelationRequestID
-		if id == "" {
-			id = correlationRequestID()
-		}
+	if id := o.CorrelationRequestID(); id != "" {
 		c.RequestInspector = withCorrelationRequestID(id)
 	}
 }
 
+// CorrelationRequestID returns the value sent in the `x-ms-correlation-request-id` header, or an empty string when
+// the Correlation Request ID is disabled
+func (o ClientOptions) CorrelationRequestID() string {
+	if o.DisableCorrelationRequestID {
+		return ""
+	}
+
+	if o.CustomCorrelationRequestID != "" {
+		return o.CustomCorrelationRequestID
+	}
+
+	return correlationRequestID()
+}
+
 func userAgent(userAgent, tfVersion, partnerID string, disableTerraformPartnerID bool) string {
 	tfUserAgent := fmt.Sprintf("HashiCorp Terraform/%s (+https://www.terraform.io)", tfVersion)
 
@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// EnvLogResourceProviders is the Environment Variable containing a comma-separated list of Resource Providers
+// (e.g. `Microsoft.Storage,Microsoft.KeyVault`) which requests are logged for - when unset all requests are logged
+const EnvLogResourceProviders = "ARM_LOG_RESOURCE_PROVIDERS"
+
+const redactedValue = "REDACTED"
+
+// sensitiveKeys contains the (lower-cased) fragments of JSON keys, form fields, query string parameters and
+// header names whose values are redacted prior to logging
+var sensitiveKeys = []string{
+	"accesskey",
+	"accountkey",
+	"authorization",
+	"connectionstring",
+	"password",
+	"primarykey",
+	"primarymasterkey",
+	"primaryreadonlymasterkey",
+	"sastoken",
+	"secondarykey",
+	"secondarymasterkey",
+	"secondaryreadonlymasterkey",
+	"secret",
+	"sharedkey",
+	"subscription-key",
+}
+
+// sensitiveQueryParameters contains query string parameters which are redacted, in addition to sensitiveKeys
+var sensitiveQueryParameters = []string{
+	// the signature of a Shared Access Signature
+	"sig",
+}
+
+type httpLogEntry struct {
+	Type                 string            `json:"type"`
+	Method               string            `json:"method"`
+	URL                  string            `json:"url"`
+	StatusCode           int               `json:"status_code,omitempty"`
+	CorrelationRequestID string            `json:"correlation_request_id,omitempty"`
+	RequestID            string            `json:"request_id,omitempty"`
+	LatencyMs            *int64            `json:"latency_ms,omitempty"`
+	Headers              map[string]string `json:"headers,omitempty"`
+	Body                 interface{}       `json:"body,omitempty"`
+	Error                string            `json:"error,omitempty"`
+}
+
+type requestStartTimeKey struct{}
+
+// withRequestStartTime returns a copy of the request with the current time, used to calculate the latency
+func withRequestStartTime(request *http.Request) *http.Request {
+	return request.WithContext(context.WithValue(request.Context(), requestStartTimeKey{}, time.Now()))
+}
+
+func requestStartTime(request *http.Request) (time.Time, bool) {
+	start, ok := request.Context().Value(requestStartTimeKey{}).(time.Time)
+	return start, ok
+}
+
+func requestLatency(request *http.Request) *int64 {
+	start, ok := requestStartTime(request)
+	if !ok {
+		return nil
+	}
+	latency := time.Since(start).Milliseconds()
+	return &latency
+}
+
+// shouldLogRequest determines whether the request is for one of the Resource Providers which logging is
+// restricted to, as specified in the `ARM_LOG_RESOURCE_PROVIDERS` Environment Variable
+func shouldLogRequest(request *http.Request, resourceProviders []string) bool {
+	if len(resourceProviders) == 0 {
+		return true
+	}
+
+	segments := strings.Split(strings.ToLower(request.URL.Path), "/")
+	for i, segment := range segments {
+		if segment != "providers" || i+1 >= len(segments) {
+			continue
+		}
+		for _, rp := range resourceProviders {
+			if strings.EqualFold(segments[i+1], rp) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// logResourceProviders returns the Resource Providers which logging is restricted to
+func logResourceProviders() []string {
+	output := make([]string, 0)
+	for _, v := range strings.Split(os.Getenv(EnvLogResourceProviders), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			output = append(output, v)
+		}
+	}
+	return output
+}
+
+func newRequestLogEntry(request *http.Request) httpLogEntry {
+	var body []byte
+	body, request.Body = readAndRestoreBody(request.Body)
+
+	return httpLogEntry{
+		Type:                 "request",
+		Method:               request.Method,
+		URL:                  redactURL(request.URL),
+		CorrelationRequestID: request.Header.Get(HeaderCorrelationRequestID),
+		Headers:              redactHeaders(request.Header),
+		Body:                 redactBody(request.URL, request.Header.Get("Content-Type"), body),
+	}
+}
+
+func newResponseLogEntry(request *http.Request, response *http.Response) httpLogEntry {
+	var body []byte
+	body, response.Body = readAndRestoreBody(response.Body)
+
+	correlationRequestId := response.Header.Get(HeaderCorrelationRequestID)
+	if correlationRequestId == "" {
+		correlationRequestId = request.Header.Get(HeaderCorrelationRequestID)
+	}
+
+	return httpLogEntry{
+		Type:                 "response",
+		Method:               request.Method,
+		URL:                  redactURL(request.URL),
+		StatusCode:           response.StatusCode,
+		CorrelationRequestID: correlationRequestId,
+		RequestID:            response.Header.Get("x-ms-request-id"),
+		LatencyMs:            requestLatency(request),
+		Headers:              redactHeaders(response.Header),
+		Body:                 redactBody(request.URL, response.Header.Get("Content-Type"), body),
+	}
+}
+
+func (e httpLogEntry) String() string {
+	// URLs and bodies are more readable without escaping characters such as `&`
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(e); err != nil {
+		return e.Method + " " + e.URL
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+func readAndRestoreBody(body io.ReadCloser) ([]byte, io.ReadCloser) {
+	if body == nil || body == http.NoBody {
+		return nil, body
+	}
+
+	contents, err := io.ReadAll(body)
+	body.Close()
+	if err != nil {
+		return nil, io.NopCloser(bytes.NewReader(contents))
+	}
+	return contents, io.NopCloser(bytes.NewReader(contents))
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, v := range sensitiveKeys {
+		if strings.Contains(key, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactURL(input *url.URL) string {
+	if input == nil {
+		return ""
+	}
+
+	query := input.Query()
+	if len(query) == 0 {
+		return input.String()
+	}
+
+	for k := range query {
+		redact := isSensitive(k)
+		for _, v := range sensitiveQueryParameters {
+			redact = redact || strings.EqualFold(k, v)
+		}
+		if redact {
+			query.Set(k, redactedValue)
+		}
+	}
+
+	output := *input
+	output.RawQuery = query.Encode()
+	return output.String()
+}
+
+func redactHeaders(input http.Header) map[string]string {
+	output := make(map[string]string, len(input))
+	for k := range input {
+		if isSensitive(k) {
+			output[k] = redactedValue
+			continue
+		}
+		output[k] = strings.Join(input.Values(k), ", ")
+	}
+	return output
+}
+
+// redactBody returns the body with the values of any sensitive keys redacted, JSON bodies are returned as
+// a json.RawMessage so that they're nested within the log entry
+func redactBody(requestUrl *url.URL, contentType string, body []byte) interface{} {
+	if len(body) == 0 {
+		return nil
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal(body, &parsed); err == nil {
+		var redacted interface{}
+		if requestUrl != nil && isKeysOperation(requestUrl.Path) {
+			// the requests/responses for listing or regenerating keys exist to return the keys themselves
+			redacted = redactJSONStrings(parsed)
+		} else {
+			// the `value` of a Key Vault Secret is the secret itself
+			isKeyVaultSecret := requestUrl != nil && strings.Contains(strings.ToLower(requestUrl.Path), "/secrets/")
+			redacted = redactJSON(parsed, isKeyVaultSecret)
+		}
+		out, err := json.Marshal(redacted)
+		if err == nil {
+			return json.RawMessage(out)
+		}
+	}
+
+	if strings.HasPrefix(strings.ToLower(contentType), "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			for k := range values {
+				if isSensitive(k) {
+					values.Set(k, redactedValue)
+				}
+			}
+			return values.Encode()
+		}
+	}
+
+	return string(body)
+}
+
+// isKeysOperation returns whether the specified path is for an operation which lists or regenerates keys, for
+// example `.../storageAccounts/account1/listKeys` or `.../namespaces/namespace1/regenerateKey`
+func isKeysOperation(path string) bool {
+	operation := strings.ToLower(path[strings.LastIndex(path, "/")+1:])
+	return (strings.HasPrefix(operation, "list") && strings.HasSuffix(operation, "keys")) || strings.HasPrefix(operation, "regeneratekey")
+}
+
+// redactJSONStrings redacts all string values other than the name of a key, for use where the body exists to
+// return the keys
+func redactJSONStrings(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, ok := value.(string); ok && !strings.EqualFold(key, "keyName") {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactJSONStrings(value)
+		}
+		return v
+
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJSONStrings(value)
+		}
+		return v
+	}
+
+	return input
+}
+
+func redactJSON(input interface{}, isKeyVaultSecret bool) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		// the `value` of a named key (e.g. within the `keys` returned for a Storage Account) is the key itself
+		_, isNamedKey := v["keyName"]
+		for key, value := range v {
+			if isSensitive(key) || ((isKeyVaultSecret || isNamedKey) && key == "value") {
+				if _, ok := value.(string); ok {
+					v[key] = redactedValue
+					continue
+				}
+			}
+			v[key] = redactJSON(value, isKeyVaultSecret)
+		}
+		return v
+
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJSON(value, isKeyVaultSecret)
+		}
+		return v
+	}
+
+	return input
+}
@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	testData := []struct {
+		name        string
+		url         string
+		contentType string
+		input       string
+		expected    string
+	}{
+		{
+			name:     "empty",
+			url:      "https://management.azure.com/subscriptions/1234",
+			input:    "",
+			expected: "null",
+		},
+		{
+			name:     "nested sensitive keys",
+			url:      "https://management.azure.com/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Sql/servers/server1",
+			input:    `{"properties":{"administratorLogin":"admin","administratorLoginPassword":"P@ssw0rd","publicNetworkAccess":"Enabled"}}`,
+			expected: `{"properties":{"administratorLogin":"admin","administratorLoginPassword":"REDACTED","publicNetworkAccess":"Enabled"}}`,
+		},
+		{
+			name:     "keys within a list",
+			url:      "https://management.azure.com/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/listKeys",
+			input:    `{"keys":[{"keyName":"key1","value":"abc"}],"primaryKey":"def","connectionString":"ghi"}`,
+			expected: `{"connectionString":"REDACTED","keys":[{"keyName":"key1","value":"REDACTED"}],"primaryKey":"REDACTED"}`,
+		},
+		{
+			name:     "named keys within any response",
+			url:      "https://management.azure.com/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			input:    `{"keys":[{"keyName":"key1","permissions":"FULL","value":"abc"}]}`,
+			expected: `{"keys":[{"keyName":"key1","permissions":"FULL","value":"REDACTED"}]}`,
+		},
+		{
+			name:     "regenerating a key",
+			url:      "https://management.azure.com/subscriptions/1234/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/authorizationRules/rule1/regenerateKeys",
+			input:    `{"keyName":"PrimaryKey","primaryConnectionString":"abc","secondaryKey":"def","keyVersion":"ghi","enabled":true}`,
+			expected: `{"enabled":true,"keyName":"PrimaryKey","keyVersion":"REDACTED","primaryConnectionString":"REDACTED","secondaryKey":"REDACTED"}`,
+		},
+		{
+			name:     "non-string sensitive values are retained",
+			url:      "https://management.azure.com/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1",
+			input:    `{"disablePasswordAuthentication":true}`,
+			expected: `{"disablePasswordAuthentication":true}`,
+		},
+		{
+			name:     "key vault secret value",
+			url:      "https://vault1.vault.azure.net/secrets/secret1/abc123",
+			input:    `{"id":"https://vault1.vault.azure.net/secrets/secret1/abc123","value":"s3cr3t"}`,
+			expected: `{"id":"https://vault1.vault.azure.net/secrets/secret1/abc123","value":"REDACTED"}`,
+		},
+		{
+			name:        "form encoded",
+			url:         "https://login.microsoftonline.com/tenant/oauth2/token",
+			contentType: "application/x-www-form-urlencoded",
+			input:       "client_id=abc&client_secret=def",
+			expected:    `"client_id=abc&client_secret=REDACTED"`,
+		},
+		{
+			name:     "plain text",
+			url:      "https://account1.blob.core.windows.net/container1/blob1",
+			input:    "hello world",
+			expected: `"hello world"`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		u, err := url.Parse(v.url)
+		if err != nil {
+			t.Fatalf("parsing url: %+v", err)
+		}
+
+		var out bytes.Buffer
+		encoder := json.NewEncoder(&out)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(redactBody(u, v.contentType, []byte(v.input))); err != nil {
+			t.Fatalf("marshalling: %+v", err)
+		}
+
+		if actual := strings.TrimSpace(out.String()); actual != v.expected {
+			t.Fatalf("expected %s but got %s", v.expected, actual)
+		}
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	u, err := url.Parse("https://account1.blob.core.windows.net/container1?sv=2022-11-02&sig=abc123&comp=list")
+	if err != nil {
+		t.Fatalf("parsing url: %+v", err)
+	}
+
+	expected := "https://account1.blob.core.windows.net/container1?comp=list&sig=REDACTED&sv=2022-11-02"
+	if actual := redactURL(u); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Authorization", "Bearer abc123")
+	headers.Set("Ocp-Apim-Subscription-Key", "def456")
+	headers.Set("Content-Type", "application/json")
+
+	actual := redactHeaders(headers)
+	if actual["Authorization"] != redactedValue {
+		t.Fatalf("expected the Authorization header to be redacted but got %q", actual["Authorization"])
+	}
+	if actual["Ocp-Apim-Subscription-Key"] != redactedValue {
+		t.Fatalf("expected the Ocp-Apim-Subscription-Key header to be redacted but got %q", actual["Ocp-Apim-Subscription-Key"])
+	}
+	if actual["Content-Type"] != "application/json" {
+		t.Fatalf("expected the Content-Type header to be retained but got %q", actual["Content-Type"])
+	}
+
+	// the original headers must not be modified
+	if headers.Get("Authorization") != "Bearer abc123" {
+		t.Fatalf("expected the Authorization header on the request to be unchanged but got %q", headers.Get("Authorization"))
+	}
+}
+
+func TestShouldLogRequest(t *testing.T) {
+	testData := []struct {
+		url               string
+		resourceProviders []string
+		expected          bool
+	}{
+		{
+			url:      "https://management.azure.com/subscriptions/1234/resourceGroups/group1",
+			expected: true,
+		},
+		{
+			url:               "https://management.azure.com/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			resourceProviders: []string{"microsoft.storage"},
+			expected:          true,
+		},
+		{
+			url:               "https://management.azure.com/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/roleAssignments/1234",
+			resourceProviders: []string{"Microsoft.Authorization"},
+			expected:          true,
+		},
+		{
+			url:               "https://management.azure.com/subscriptions/1234/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+			resourceProviders: []string{"Microsoft.Storage", "Microsoft.Sql"},
+			expected:          false,
+		},
+		{
+			url:               "https://management.azure.com/subscriptions/1234/resourceGroups/group1",
+			resourceProviders: []string{"Microsoft.Storage"},
+			expected:          false,
+		},
+	}
+
+	for _, v := range testData {
+		req, err := http.NewRequest(http.MethodGet, v.url, nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		if actual := shouldLogRequest(req, v.resourceProviders); actual != v.expected {
+			t.Fatalf("expected %t for %q with %+v but got %t", v.expected, v.url, v.resourceProviders, actual)
+		}
+	}
+}
+
+func TestRequestLogging_Autorest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("x-ms-request-id", "request-1234")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"properties":{"primaryKey":"def456"}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	sender := buildSender("AzureRM")
+
+	req, err := http.NewRequest(http.MethodPut, server.URL+"/subscriptions/1234/providers/Microsoft.Example/things/thing1", strings.NewReader(`{"properties":{"password":"abc123"}}`))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	req.Header.Set(HeaderCorrelationRequestID, "correlation-1234")
+	req.Header.Set("Authorization", "Bearer token")
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	// the response body must still be readable by the caller
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading response body: %+v", err)
+	}
+	if !strings.Contains(string(body), "def456") {
+		t.Fatalf("expected the response body to be unmodified but got %q", string(body))
+	}
+
+	entries := make(map[string]httpLogEntry)
+	for _, line := range strings.Split(buf.String(), "\n") {
+		for _, prefix := range []string{"[DEBUG] AzureRM Request: ", "[DEBUG] AzureRM Response: "} {
+			if idx := strings.Index(line, prefix); idx != -1 {
+				var entry httpLogEntry
+				if err := json.Unmarshal([]byte(line[idx+len(prefix):]), &entry); err != nil {
+					t.Fatalf("parsing log line %q: %+v", line, err)
+				}
+				entries[entry.Type] = entry
+			}
+		}
+	}
+
+	request, ok := entries["request"]
+	if !ok {
+		t.Fatalf("expected a request to be logged but got %q", buf.String())
+	}
+	if request.Method != http.MethodPut || request.CorrelationRequestID != "correlation-1234" {
+		t.Fatalf("expected the request method and correlation request ID to be logged but got %+v", request)
+	}
+	if request.Headers["Authorization"] != redactedValue {
+		t.Fatalf("expected the Authorization header to be redacted but got %q", request.Headers["Authorization"])
+	}
+
+	response, ok := entries["response"]
+	if !ok {
+		t.Fatalf("expected a response to be logged but got %q", buf.String())
+	}
+	if response.StatusCode != http.StatusOK || response.RequestID != "request-1234" || response.LatencyMs == nil {
+		t.Fatalf("expected the status code, request ID and latency to be logged but got %+v", response)
+	}
+
+	if strings.Contains(buf.String(), "abc123") || strings.Contains(buf.String(), "def456") || strings.Contains(buf.String(), "Bearer token") {
+		t.Fatalf("expected secrets to be redacted from the logs but got %q", buf.String())
+	}
+}
@@ -4,13 +4,45 @@
 package common
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"log"
 	"net/http"
-	"net/http/httputil"
+	"net/url"
+	"strings"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// isMissingSubscriptionRegistration determines whether the request failed since the Resource Provider isn't registered
+// in the Subscription, which is returned as a `MissingSubscriptionRegistration` error
+func isMissingSubscriptionRegistration(response *http.Response) bool {
+	if response == nil || response.StatusCode != http.StatusConflict {
+		return false
+	}
+
+	var body []byte
+	body, response.Body = readAndRestoreBody(response.Body)
+
+	var parsed struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return false
+	}
+
+	return strings.EqualFold(parsed.Error.Code, "MissingSubscriptionRegistration")
+}
+
 func correlationRequestIDMiddleware(id string) client.RequestMiddleware {
 	return func(request *http.Request) (*http.Request, error) {
 		// ensure the `X-Correlation-ID` field is set
@@ -20,40 +52,249 @@ func correlationRequestIDMiddleware(id string) client.RequestMiddleware {
 }
 
 func requestLoggerMiddleware(providerName string) client.RequestMiddleware {
+	resourceProviders := logResourceProviders()
 	return func(request *http.Request) (*http.Request, error) {
-		// strip the authorization header prior to printing
-		authHeaderName := "Authorization"
-		auth := request.Header.Get(authHeaderName)
-		if auth != "" {
-			request.Header.Del(authHeaderName)
+		request = withRequestStartTime(request)
+		if shouldLogRequest(request, resourceProviders) {
+			log.Printf("[DEBUG] %s Request: %s", providerName, newRequestLogEntry(request))
 		}
+		return request, nil
+	}
+}
 
-		// dump request to wire format
-		if dump, err := httputil.DumpRequestOut(request, true); err == nil {
-			log.Printf("[DEBUG] %s Request: \n%s\n", providerName, dump)
-		} else {
-			// fallback to basic message
-			log.Printf("[DEBUG] %s Request: %s to %s\n", providerName, request.Method, request.URL)
+func responseLoggerMiddleware(providerName string) client.ResponseMiddleware {
+	resourceProviders := logResourceProviders()
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		if shouldLogRequest(request, resourceProviders) {
+			log.Printf("[DEBUG] %s Response: %s", providerName, newResponseLogEntry(request, response))
 		}
+		return response, nil
+	}
+}
 
-		// add the auth header back
-		if auth != "" {
-			request.Header.Add(authHeaderName, auth)
+// tracingMiddleware records a span for the request once the response has been received, starting from when the
+// request was sent (see requestLoggerMiddleware) so that the span includes any retries
+func tracingMiddleware() client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		options := httpSpanOptions(request)
+		if start, ok := requestStartTime(request); ok {
+			options = append(options, trace.WithTimestamp(start))
 		}
 
-		return request, nil
+		_, span := tracing.StartSpan(request.Context(), httpSpanName(request), options...)
+		endHTTPSpan(span, response, nil)
+		return response, nil
 	}
 }
 
-func responseLoggerMiddleware(providerName string) client.ResponseMiddleware {
+// missingSubscriptionRegistrationMiddleware calls the specified function when the request failed since the Resource
+// Provider isn't registered in the Subscription
+func missingSubscriptionRegistrationMiddleware(f func()) client.ResponseMiddleware {
 	return func(request *http.Request, response *http.Response) (*http.Response, error) {
-		// dump response to wire format
-		if dump, err2 := httputil.DumpResponse(response, true); err2 == nil {
-			log.Printf("[DEBUG] %s Response for %s: \n%s\n", providerName, request.URL, dump)
-		} else {
-			// fallback to basic message
-			log.Printf("[DEBUG] %s Response: %s for %s\n", providerName, response.Status, request.URL)
+		if isMissingSubscriptionRegistration(response) {
+			f()
 		}
 		return response, nil
 	}
 }
+
+// recorderMiddleware redirects requests to the local recorder server, retaining the original scheme and host
+func recorderMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		host, err := recorderServer()
+		if err != nil {
+			return nil, err
+		}
+
+		request.Header.Set(headerRecorderOriginalHost, fmt.Sprintf("%s://%s", request.URL.Scheme, request.URL.Host))
+		request.URL.Scheme = "http"
+		request.URL.Host = host
+		request.Host = host
+		return request, nil
+	}
+}
+
+func rateLimiterMiddleware(limiter *rateLimiter) client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		if err := limiter.Wait(request.Context()); err != nil {
+			return nil, fmt.Errorf("waiting for the client-side rate limit: %+v", err)
+		}
+		return request, nil
+	}
+}
+
+func rewindableRequestMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		// buffer the request body so that the request can be re-sent by the Retry Policy
+		if request.Body == nil || request.Body == http.NoBody {
+			return request, nil
+		}
+
+		body, err := io.ReadAll(request.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading request body: %+v", err)
+		}
+		request.Body = io.NopCloser(bytes.NewReader(body))
+		request.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+
+		return request, nil
+	}
+}
+
+// retryPolicyMiddleware applies the Retry Policy to requests sent using the go-azure-sdk client `c`, where requests are
+// re-sent using the client so that these are authorized, rate limited, logged, recorded and traced in the same way as
+// the original request (and retried by the SDK itself).
+func retryPolicyMiddleware(options RetryOptions, c client.BaseClient) client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		// requests re-sent by the Retry Policy are retried by the original request
+		if isRetryPolicyRequest(request.Context()) {
+			return response, nil
+		}
+
+		return options.retry(request, response, func() (*http.Response, error) {
+			retryRequest := request.Clone(context.WithValue(request.Context(), retryPolicyRequestKey{}, true))
+
+			// the request middlewares are run again, so any redirect to the recorder is reverted
+			if originalHost := retryRequest.Header.Get(headerRecorderOriginalHost); originalHost != "" {
+				u, err := url.Parse(originalHost)
+				if err != nil {
+					return nil, fmt.Errorf("parsing the original host %q: %+v", originalHost, err)
+				}
+				retryRequest.URL.Scheme = u.Scheme
+				retryRequest.URL.Host = u.Host
+				retryRequest.Host = u.Host
+				retryRequest.Header.Del(headerRecorderOriginalHost)
+			}
+
+			if request.GetBody != nil {
+				body, err := request.GetBody()
+				if err != nil {
+					return nil, fmt.Errorf("rewinding request body: %+v", err)
+				}
+				retryRequest.Body = body
+			}
+
+			resp, err := c.Execute(retryRequest.Context(), &client.Request{
+				Client:  c,
+				Request: retryRequest,
+				// the status code of the response is checked by the original request
+				ValidStatusFunc: func(*http.Response, *odata.OData) bool {
+					return true
+				},
+			})
+			if resp == nil {
+				return nil, err
+			}
+			return resp.Response, err
+		})
+	}
+}
+
+type retryPolicyRequestKey struct{}
+
+// isRetryPolicyRequest returns whether the request is being re-sent by the Retry Policy
+func isRetryPolicyRequest(ctx context.Context) bool {
+	v, ok := ctx.Value(retryPolicyRequestKey{}).(bool)
+	return ok && v
+}
+
+func withRateLimiter(limiter *rateLimiter) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if err := limiter.Wait(r.Context()); err != nil {
+				return nil, fmt.Errorf("waiting for the client-side rate limit: %+v", err)
+			}
+			return s.Do(r)
+		})
+	}
+}
+
+func withRetryPolicy(options RetryOptions) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			send := func() (*http.Response, error) {
+				if err := rr.Prepare(); err != nil {
+					return nil, fmt.Errorf("rewinding request body: %+v", err)
+				}
+				return s.Do(rr.Request())
+			}
+
+			resp, err := send()
+			if err != nil {
+				return resp, err
+			}
+
+			return options.retry(r, resp, send)
+		})
+	}
+}
+
+func withTracing() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			ctx, span := tracing.StartSpan(r.Context(), httpSpanName(r), httpSpanOptions(r)...)
+			resp, err := s.Do(r.WithContext(ctx))
+			endHTTPSpan(span, resp, err)
+			return resp, err
+		})
+	}
+}
+
+func withMissingSubscriptionRegistrationHandler(f func()) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			resp, err := s.Do(r)
+			if isMissingSubscriptionRegistration(resp) {
+				f()
+			}
+			return resp, err
+		})
+	}
+}
+
+// buildSender returns an autorest.Sender which logs requests and responses in the same manner as the
+// middlewares used for go-azure-sdk clients, and which records/replays requests when enabled
+func buildSender(providerName string) autorest.Sender {
+	var transport http.RoundTripper = &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+	}
+	if RecordingModeFromEnvironment() != "" {
+		transport = recorderTransport{base: transport}
+	}
+
+	return autorest.DecorateSender(&http.Client{
+		Transport: transport,
+	}, withRequestLogging(providerName))
+}
+
+func withRequestLogging(providerName string) autorest.SendDecorator {
+	resourceProviders := logResourceProviders()
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if !shouldLogRequest(r, resourceProviders) {
+				return s.Do(r)
+			}
+
+			r = withRequestStartTime(r)
+			log.Printf("[DEBUG] %s Request: %s", providerName, newRequestLogEntry(r))
+
+			resp, err := s.Do(r)
+			if resp != nil {
+				log.Printf("[DEBUG] %s Response: %s", providerName, newResponseLogEntry(r, resp))
+			} else if err != nil {
+				entry := httpLogEntry{
+					Type:      "response",
+					Method:    r.Method,
+					URL:       redactURL(r.URL),
+					LatencyMs: requestLatency(r),
+					Error:     err.Error(),
+				}
+				log.Printf("[DEBUG] %s Response: %s", providerName, entry)
+			}
+			return resp, err
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIsMissingSubscriptionRegistration(t *testing.T) {
+	testData := []struct {
+		statusCode int
+		body       string
+		expected   bool
+	}{
+		{
+			statusCode: http.StatusOK,
+			body:       `{}`,
+			expected:   false,
+		},
+		{
+			statusCode: http.StatusConflict,
+			body:       `{"error":{"code":"Conflict","message":"Another operation is in progress"}}`,
+			expected:   false,
+		},
+		{
+			statusCode: http.StatusConflict,
+			body:       `{"error":{"code":"MissingSubscriptionRegistration","message":"The subscription is not registered to use namespace 'Microsoft.Compute'."}}`,
+			expected:   true,
+		},
+		{
+			statusCode: http.StatusConflict,
+			body:       `not json`,
+			expected:   false,
+		},
+	}
+
+	for _, v := range testData {
+		response := &http.Response{
+			StatusCode: v.statusCode,
+			Body:       io.NopCloser(strings.NewReader(v.body)),
+		}
+
+		if actual := isMissingSubscriptionRegistration(response); actual != v.expected {
+			t.Fatalf("expected %t but got %t for %d %q", v.expected, actual, v.statusCode, v.body)
+		}
+
+		// the body must remain readable for the caller
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			t.Fatalf("reading the body: %+v", err)
+		}
+		if string(body) != v.body {
+			t.Fatalf("expected the body to be %q but got %q", v.body, string(body))
+		}
+	}
+
+	if isMissingSubscriptionRegistration(nil) {
+		t.Fatalf("expected a nil response not to be a MissingSubscriptionRegistration error")
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimitOptions defines the client-side Rate Limit applied to requests sent to a Subscription
+type RateLimitOptions struct {
+	// RequestsPerSecond is the sustained number of requests which can be sent per second
+	RequestsPerSecond float64
+
+	// Burst is the number of requests which can be sent at once, defaults to RequestsPerSecond (rounded up)
+	Burst int
+}
+
+// subscriptionRateLimiters contains a rate limiter for each Subscription, which is shared between every
+// client (and Provider instance) within this process that sends requests to that Subscription
+var subscriptionRateLimiters = struct {
+	sync.Mutex
+	limiters map[string]*rateLimiter
+}{
+	limiters: map[string]*rateLimiter{},
+}
+
+// rateLimiterForSubscription returns the rate limiter for the specified Subscription - when multiple Provider
+// instances configure a Rate Limit for the same Subscription the most restrictive Rate Limit is used
+func rateLimiterForSubscription(subscriptionId string, options RateLimitOptions) *rateLimiter {
+	subscriptionRateLimiters.Lock()
+	defer subscriptionRateLimiters.Unlock()
+
+	key := strings.ToLower(subscriptionId)
+	if limiter, ok := subscriptionRateLimiters.limiters[key]; ok {
+		limiter.restrict(options)
+		return limiter
+	}
+
+	limiter := newRateLimiter(options)
+	subscriptionRateLimiters.limiters[key] = limiter
+	return limiter
+}
+
+// rateLimiter is a token bucket which is refilled at requestsPerSecond, up to a maximum of burst tokens
+type rateLimiter struct {
+	mu sync.Mutex
+
+	requestsPerSecond float64
+	burst             float64
+	tokens            float64
+	last              time.Time
+}
+
+func newRateLimiter(options RateLimitOptions) *rateLimiter {
+	burst := float64(options.Burst)
+	if burst < 1 {
+		burst = math.Max(1, math.Ceil(options.RequestsPerSecond))
+	}
+
+	return &rateLimiter{
+		requestsPerSecond: options.RequestsPerSecond,
+		burst:             burst,
+		tokens:            burst,
+		last:              time.Now(),
+	}
+}
+
+// restrict lowers the rate and burst of the limiter should those in options be lower
+func (l *rateLimiter) restrict(options RateLimitOptions) {
+	other := newRateLimiter(options)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.requestsPerSecond = math.Min(l.requestsPerSecond, other.requestsPerSecond)
+	l.burst = math.Min(l.burst, other.burst)
+	l.tokens = math.Min(l.tokens, l.burst)
+}
+
+// Wait blocks until a request can be sent, or the context is cancelled
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.requestsPerSecond)
+	l.last = now
+
+	// reserve a token, waiting for it to become available when the bucket is empty
+	l.tokens--
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.requestsPerSecond * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		// return the reserved token since the request won't be sent
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
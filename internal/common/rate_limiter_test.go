@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Wait(t *testing.T) {
+	limiter := newRateLimiter(RateLimitOptions{
+		RequestsPerSecond: 20,
+		Burst:             2,
+	})
+
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatalf("waiting for the rate limiter: %+v", err)
+		}
+	}
+
+	// the first 2 requests use the burst, the remaining 4 are sent at 20 requests per second
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Fatalf("expected the requests to be rate limited, but they completed in %s", elapsed)
+	}
+}
+
+func TestRateLimiter_WaitCancelled(t *testing.T) {
+	limiter := newRateLimiter(RateLimitOptions{
+		RequestsPerSecond: 0.1,
+	})
+
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("waiting for the rate limiter: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := limiter.Wait(ctx); err == nil {
+		t.Fatal("expected an error when the context is cancelled whilst waiting")
+	}
+}
+
+func TestRateLimiter_SharedPerSubscription(t *testing.T) {
+	first := rateLimiterForSubscription("00000000-0000-0000-0000-000000000001", RateLimitOptions{RequestsPerSecond: 10})
+	second := rateLimiterForSubscription("00000000-0000-0000-0000-000000000001", RateLimitOptions{RequestsPerSecond: 5, Burst: 2})
+	other := rateLimiterForSubscription("00000000-0000-0000-0000-000000000002", RateLimitOptions{RequestsPerSecond: 10})
+
+	if first != second {
+		t.Fatal("expected the same rate limiter to be used for the same subscription")
+	}
+	if first == other {
+		t.Fatal("expected a different rate limiter to be used for a different subscription")
+	}
+	if first.requestsPerSecond != 5 || first.burst != 2 {
+		t.Fatalf("expected the most restrictive rate limit to be used but got %f requests per second with a burst of %f", first.requestsPerSecond, first.burst)
+	}
+}
+
+func TestRateLimiter_Autorest(t *testing.T) {
+	server, bodies := retryTestServer(t, []retryTestResponse{
+		{statusCode: http.StatusConflict, headers: map[string]string{"Retry-After": "0"}},
+	})
+
+	retry := testRetryOptions()
+	retry.StatusCodeRules = map[int]int{
+		http.StatusConflict: 2,
+	}
+	options := ClientOptions{
+		SubscriptionId: "00000000-0000-0000-0000-000000000003",
+		Retry:          &retry,
+		RateLimit: &RateLimitOptions{
+			RequestsPerSecond: 5,
+			Burst:             1,
+		},
+	}
+
+	// the retry is also subject to the rate limit
+	start := time.Now()
+	resp := sendAutorestRequest(t, options, server.URL)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the final status code to be 200 but got %d", resp.StatusCode)
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Fatalf("expected the requests to be rate limited, but they completed in %s", elapsed)
+	}
+	if len(bodies()) != 2 {
+		t.Fatalf("expected 2 requests but got %d", len(bodies()))
+	}
+}
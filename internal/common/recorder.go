@@ -0,0 +1,417 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"golang.org/x/oauth2"
+)
+
+// EnvRecordingMode is the Environment Variable used to enable recording or replaying HTTP traffic
+const EnvRecordingMode = "ARM_TEST_RECORDING_MODE"
+
+type RecordingMode string
+
+const (
+	// RecordingModeRecord sends requests to Azure, saving each request/response into the Cassette
+	RecordingModeRecord RecordingMode = "record"
+
+	// RecordingModeReplay returns the responses from the Cassette rather than sending requests to Azure
+	RecordingModeReplay RecordingMode = "replay"
+)
+
+// headerRecorderOriginalHost is used to retain the original scheme and host of requests from go-azure-sdk clients,
+// which are redirected to the local recorder server
+const headerRecorderOriginalHost = "X-Azurerm-Recorder-Original-Host"
+
+// RecordingModeFromEnvironment returns the RecordingMode specified in the `ARM_TEST_RECORDING_MODE` Environment
+// Variable, or an empty string when HTTP traffic should neither be recorded nor replayed
+func RecordingModeFromEnvironment() RecordingMode {
+	switch mode := RecordingMode(strings.ToLower(os.Getenv(EnvRecordingMode))); mode {
+	case RecordingModeRecord, RecordingModeReplay:
+		return mode
+	}
+	return ""
+}
+
+// Cassette is the on-disk representation of the HTTP traffic recorded for a test
+type Cassette struct {
+	// Variables contains any values which need to be consistent between recording and replaying,
+	// such as the random values used within a test and details of the authenticated account
+	Variables map[string]string `json:"variables"`
+
+	Interactions []Interaction `json:"interactions"`
+}
+
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedBody contains either a JSON body (stored as JSON to make the Cassette easier to review) or any other body
+type RecordedBody struct {
+	Body     json.RawMessage `json:"body,omitempty"`
+	BodyText string          `json:"body_text,omitempty"`
+}
+
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	RecordedBody
+}
+
+type RecordedResponse struct {
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	RecordedBody
+}
+
+// Recorder records HTTP traffic into, or replays HTTP traffic from, a Cassette on disk
+type Recorder struct {
+	mode RecordingMode
+	path string
+
+	mu       sync.Mutex
+	cassette Cassette
+
+	// replayed is a map of the interaction key (method and url) to the number of matching interactions replayed
+	replayed map[string]int
+}
+
+// NewRecorder returns a Recorder using the Cassette at path - which is loaded when replaying
+func NewRecorder(mode RecordingMode, path string) (*Recorder, error) {
+	r := &Recorder{
+		mode: mode,
+		path: path,
+		cassette: Cassette{
+			Variables:    map[string]string{},
+			Interactions: []Interaction{},
+		},
+		replayed: map[string]int{},
+	}
+
+	if mode == RecordingModeReplay {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading cassette %q: %+v", path, err)
+		}
+		if err := json.Unmarshal(contents, &r.cassette); err != nil {
+			return nil, fmt.Errorf("parsing cassette %q: %+v", path, err)
+		}
+		if r.cassette.Variables == nil {
+			r.cassette.Variables = map[string]string{}
+		}
+	}
+
+	return r, nil
+}
+
+func (r *Recorder) Mode() RecordingMode {
+	return r.mode
+}
+
+// Variable returns the value of the named variable from the Cassette when replaying, otherwise the value
+// returned from generate is saved into the Cassette and returned
+func (r *Recorder) Variable(name string, generate func() string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if v, ok := r.cassette.Variables[name]; ok {
+		return v
+	}
+
+	if r.mode == RecordingModeReplay {
+		log.Printf("[WARN] variable %q was not found in the cassette %q", name, r.path)
+		return ""
+	}
+
+	v := generate()
+	r.cassette.Variables[name] = v
+	return v
+}
+
+// Stop saves the Cassette to disk when recording
+func (r *Recorder) Stop() error {
+	if r.mode != RecordingModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	contents, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling cassette: %+v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("creating directory for cassette %q: %+v", r.path, err)
+	}
+
+	if err := os.WriteFile(r.path, contents, 0o644); err != nil {
+		return fmt.Errorf("writing cassette %q: %+v", r.path, err)
+	}
+
+	return nil
+}
+
+// roundTrip records the request sent using transport when recording, or returns the matching response
+// from the Cassette when replaying
+func (r *Recorder) roundTrip(req *http.Request, transport http.RoundTripper) (*http.Response, error) {
+	var body []byte
+	body, req.Body = readAndRestoreBody(req.Body)
+
+	recordedRequest := RecordedRequest{
+		Method:       req.Method,
+		URL:          redactURL(req.URL),
+		RecordedBody: scrubBody(req.URL, req.Header.Get("Content-Type"), body),
+	}
+
+	if r.mode == RecordingModeReplay {
+		return r.replay(req, recordedRequest)
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	var respBody []byte
+	respBody, resp.Body = readAndRestoreBody(resp.Body)
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recordedRequest,
+		Response: RecordedResponse{
+			StatusCode:   resp.StatusCode,
+			Headers:      scrubHeaders(resp.Header),
+			RecordedBody: scrubBody(req.URL, resp.Header.Get("Content-Type"), respBody),
+		},
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recordedRequest RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// requests are matched on their method and URL, where requests with the same method and URL (for example
+	// when polling a long-running operation) are replayed in the order in which they were recorded
+	key := fmt.Sprintf("%s %s", recordedRequest.Method, recordedRequest.URL)
+	skip := r.replayed[key]
+	for _, interaction := range r.cassette.Interactions {
+		if interaction.Request.Method != recordedRequest.Method || interaction.Request.URL != recordedRequest.URL {
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+
+		r.replayed[key]++
+		return replayResponse(req, interaction.Response), nil
+	}
+
+	return nil, fmt.Errorf("no interaction was recorded in the cassette %q for %s", r.path, key)
+}
+
+func replayResponse(req *http.Request, recorded RecordedResponse) *http.Response {
+	body := recorded.bytes()
+	resp := &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+	for k, v := range recorded.Headers {
+		resp.Header[k] = v
+	}
+	resp.Header.Del("Content-Length")
+
+	// there's no need to wait between polling or retrying requests which are being replayed
+	for _, header := range []string{"Retry-After", "Retry-After-Ms", "X-Ms-Retry-After-Ms"} {
+		if resp.Header.Get(header) != "" {
+			resp.Header.Set(header, "0")
+		}
+	}
+
+	return resp
+}
+
+// scrubBody returns the body with the values of any sensitive keys redacted, using the same rules as are used when
+// logging - as such the `value` of a Key Vault Secret and the keys returned when listing/regenerating keys are redacted
+func scrubBody(requestUrl *url.URL, contentType string, body []byte) RecordedBody {
+	switch v := redactBody(requestUrl, contentType, body).(type) {
+	case json.RawMessage:
+		return RecordedBody{
+			Body: v,
+		}
+	case string:
+		return RecordedBody{
+			BodyText: v,
+		}
+	}
+
+	return RecordedBody{}
+}
+
+// scrubHeaders returns the headers with any sensitive headers removed, and with any sensitive query string parameters
+// redacted from URLs - for example the signature of a SAS Token within the `Location` or `Azure-AsyncOperation` headers
+func scrubHeaders(input http.Header) map[string][]string {
+	output := make(map[string][]string)
+	for k, v := range input {
+		if isSensitive(k) {
+			continue
+		}
+
+		values := make([]string, 0, len(v))
+		for _, value := range v {
+			if u, err := url.Parse(value); err == nil && u.Scheme != "" && u.Host != "" && u.RawQuery != "" {
+				value = redactURL(u)
+			}
+			values = append(values, value)
+		}
+		output[k] = values
+	}
+
+	return output
+}
+
+func (b RecordedBody) bytes() []byte {
+	if len(b.Body) > 0 {
+		return b.Body
+	}
+	return []byte(b.BodyText)
+}
+
+// ReplayAuthorizer is an auth.Authorizer which returns a static access token, for use when replaying requests
+type ReplayAuthorizer struct{}
+
+var _ auth.Authorizer = ReplayAuthorizer{}
+
+func (ReplayAuthorizer) Token(_ context.Context, _ *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{
+		AccessToken: "replayed",
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(time.Hour),
+	}, nil
+}
+
+func (ReplayAuthorizer) AuxiliaryTokens(_ context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	return nil, nil
+}
+
+var (
+	activeRecorderLock sync.Mutex
+	activeRecorder     *Recorder
+)
+
+// SetActiveRecorder sets the Recorder used for HTTP traffic sent by all clients within this process - as such
+// tests which are recorded or replayed must run sequentially
+func SetActiveRecorder(r *Recorder) {
+	activeRecorderLock.Lock()
+	defer activeRecorderLock.Unlock()
+	activeRecorder = r
+}
+
+// ActiveRecorder returns the Recorder used for HTTP traffic, if any
+func ActiveRecorder() *Recorder {
+	activeRecorderLock.Lock()
+	defer activeRecorderLock.Unlock()
+	return activeRecorder
+}
+
+// recorderTransport is a http.RoundTripper which sends requests via the active Recorder
+type recorderTransport struct {
+	base http.RoundTripper
+}
+
+func (t recorderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := ActiveRecorder()
+	if r == nil {
+		return nil, fmt.Errorf("`%s` is set to %q but there's no active recording for the request to %s", EnvRecordingMode, RecordingModeFromEnvironment(), req.URL)
+	}
+	return r.roundTrip(req, t.base)
+}
+
+var (
+	recorderServerOnce sync.Once
+	recorderServerHost string
+	recorderServerErr  error
+)
+
+// recorderServer returns the host of a local server which sends requests via the active Recorder. Since the
+// transport used by go-azure-sdk clients can't be replaced, requests are instead redirected to this server.
+func recorderServer() (string, error) {
+	recorderServerOnce.Do(func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			recorderServerErr = fmt.Errorf("starting the recorder server: %+v", err)
+			return
+		}
+		recorderServerHost = listener.Addr().String()
+
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+		handler := recorderTransport{base: transport}
+
+		go func() {
+			_ = http.Serve(listener, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				original, err := url.Parse(req.Header.Get(headerRecorderOriginalHost))
+				if err != nil || original.Host == "" {
+					http.Error(w, fmt.Sprintf("the %s header was missing or invalid", headerRecorderOriginalHost), http.StatusBadRequest)
+					return
+				}
+
+				outbound := req.Clone(req.Context())
+				outbound.RequestURI = ""
+				outbound.URL.Scheme = original.Scheme
+				outbound.URL.Host = original.Host
+				outbound.Host = original.Host
+				outbound.Header.Del(headerRecorderOriginalHost)
+
+				resp, err := handler.RoundTrip(outbound)
+				if err != nil {
+					// a `501 Not Implemented` is returned since this isn't retried by go-azure-sdk
+					http.Error(w, err.Error(), http.StatusNotImplemented)
+					return
+				}
+				defer resp.Body.Close()
+
+				for k, v := range resp.Header {
+					w.Header()[k] = v
+				}
+				w.WriteHeader(resp.StatusCode)
+				_, _ = io.Copy(w, resp.Body)
+			}))
+		}()
+	})
+
+	return recorderServerHost, recorderServerErr
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPut:
+			w.Header().Set("Retry-After", "10")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"properties":{"provisioningState":"Creating","primaryKey":"def456"}}`))
+		default:
+			state := "Creating"
+			if atomic.AddInt32(&polls, 1) > 1 {
+				state = "Succeeded"
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, `{"properties":{"provisioningState":%q}}`, state)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv(EnvRecordingMode, string(RecordingModeRecord))
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	uri := server.URL + "/subscriptions/1234/providers/Microsoft.Example/things/thing1"
+
+	states := func(t *testing.T, mode RecordingMode) []string {
+		recorder, err := NewRecorder(mode, path)
+		if err != nil {
+			t.Fatalf("building recorder: %+v", err)
+		}
+		SetActiveRecorder(recorder)
+		defer SetActiveRecorder(nil)
+
+		if v := recorder.Variable("random_integer", func() string { return "1234" }); v != "1234" {
+			t.Fatalf("expected the variable `random_integer` to be %q but got %q", "1234", v)
+		}
+
+		sender := buildSender("AzureRM")
+		output := make([]string, 0)
+		for _, method := range []string{http.MethodPut, http.MethodGet, http.MethodGet} {
+			req, err := http.NewRequest(method, uri, strings.NewReader(`{"properties":{"password":"abc123"}}`))
+			if err != nil {
+				t.Fatalf("building request: %+v", err)
+			}
+			resp, err := sender.Do(req)
+			if err != nil {
+				t.Fatalf("sending request: %+v", err)
+			}
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				t.Fatalf("reading response body: %+v", err)
+			}
+
+			var parsed struct {
+				Properties struct {
+					ProvisioningState string `json:"provisioningState"`
+				} `json:"properties"`
+			}
+			if err := json.Unmarshal(body, &parsed); err != nil {
+				t.Fatalf("parsing response body %q: %+v", string(body), err)
+			}
+			output = append(output, parsed.Properties.ProvisioningState)
+
+			if method == http.MethodPut && mode == RecordingModeReplay && resp.Header.Get("Retry-After") != "0" {
+				t.Fatalf("expected the Retry-After header to be replayed as 0 but got %q", resp.Header.Get("Retry-After"))
+			}
+		}
+
+		if err := recorder.Stop(); err != nil {
+			t.Fatalf("stopping recorder: %+v", err)
+		}
+		return output
+	}
+
+	expected := "Creating,Creating,Succeeded"
+	if actual := strings.Join(states(t, RecordingModeRecord), ","); actual != expected {
+		t.Fatalf("expected the recorded states to be %q but got %q", expected, actual)
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading cassette: %+v", err)
+	}
+	if strings.Contains(string(contents), "abc123") || strings.Contains(string(contents), "def456") {
+		t.Fatalf("expected secrets to be scrubbed from the cassette but got %s", string(contents))
+	}
+
+	// the server is stopped to ensure that requests are replayed from the cassette
+	server.Close()
+
+	t.Setenv(EnvRecordingMode, string(RecordingModeReplay))
+	if actual := strings.Join(states(t, RecordingModeReplay), ","); actual != expected {
+		t.Fatalf("expected the replayed states to be %q but got %q", expected, actual)
+	}
+}
+
+func TestRecorder_ScrubsSecretsAndKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(r.URL.Path, "/secrets/"):
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"value":"s3cr3t-value","id":"https://vault1.vault.azure.net/secrets/secret1/abc"}`))
+		case strings.HasSuffix(r.URL.Path, "/listKeys"):
+			w.Header().Set("Location", "https://account1.blob.core.windows.net/container1/blob1?sv=2023-01-03&sig=s1gn4ture")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"key1":"k3y-one","key2":"k3y-two"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recorder, err := NewRecorder(RecordingModeRecord, path)
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+
+	requests := map[string]string{
+		http.MethodGet:  server.URL + "/secrets/secret1",
+		http.MethodPost: server.URL + "/subscriptions/1234/resourceGroups/group1/providers/Microsoft.CognitiveServices/accounts/account1/listKeys",
+	}
+	for method, uri := range requests {
+		req, err := http.NewRequest(method, uri, nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+		resp, err := recorder.roundTrip(req, http.DefaultTransport)
+		if err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if err := recorder.Stop(); err != nil {
+		t.Fatalf("stopping recorder: %+v", err)
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading cassette: %+v", err)
+	}
+	for _, secret := range []string{"s3cr3t-value", "k3y-one", "k3y-two", "s1gn4ture"} {
+		if strings.Contains(string(contents), secret) {
+			t.Fatalf("expected %q to be scrubbed from the cassette but got %s", secret, string(contents))
+		}
+	}
+}
+
+func TestRecorder_ReplayMissingInteraction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	if err := os.WriteFile(path, []byte(`{"variables":{},"interactions":[]}`), 0o644); err != nil {
+		t.Fatalf("writing cassette: %+v", err)
+	}
+
+	recorder, err := NewRecorder(RecordingModeReplay, path)
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/1234", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	if _, err := recorder.roundTrip(req, http.DefaultTransport); err == nil {
+		t.Fatalf("expected an error for a request which wasn't recorded")
+	}
+}
+
+func TestRecorder_Server(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(r.URL.Path))
+	}))
+	defer server.Close()
+
+	recorder, err := NewRecorder(RecordingModeRecord, filepath.Join(t.TempDir(), "cassette.json"))
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+	SetActiveRecorder(recorder)
+	defer SetActiveRecorder(nil)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/subscriptions/1234", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	if req, err = recorderMiddleware()(req); err != nil {
+		t.Fatalf("redirecting request: %+v", err)
+	}
+	if "http://"+req.URL.Host == server.URL {
+		t.Fatalf("expected the request to be redirected to the recorder server")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 but got %d", resp.StatusCode)
+	}
+	if len(recorder.cassette.Interactions) != 1 || recorder.cassette.Interactions[0].Request.URL != server.URL+"/subscriptions/1234" {
+		t.Fatalf("expected the request to the original URL to be recorded but got %+v", recorder.cassette.Interactions)
+	}
+}
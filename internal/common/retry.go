@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryOptions defines the Retry Policy applied by the Provider to requests which complete with an HTTP Status Code
+// matching one of the Status Code Rules.
+//
+// NOTE: this policy is applied in addition to the retries performed within the underlying SDKs, as such it's applied
+// to the final response returned from the SDK - which allows for retrying Status Codes which the SDKs don't retry
+// (such as a `409 Conflict`). Status Codes which the SDKs always retry themselves (see IsRetriedBySdk) can't be
+// configured, since these are already retried until the request times out.
+type RetryOptions struct {
+	// MaxAttempts is the maximum number of times a request is sent, including the initial request, for any
+	// Status Code Rule which doesn't specify the maximum number of attempts
+	MaxAttempts int
+
+	// MinBackoff is the delay before the first retry, which is doubled for each subsequent retry
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between retries
+	MaxBackoff time.Duration
+
+	// HonourRetryAfter specifies whether the delay returned in the `Retry-After` header of a response
+	// should be used rather than the computed backoff
+	HonourRetryAfter bool
+
+	// StatusCodeRules is a map of HTTP Status Code to the maximum number of attempts for a request which
+	// completes with that Status Code, where `0` uses MaxAttempts
+	StatusCodeRules map[int]int
+}
+
+// DefaultRetryOptions returns the RetryOptions used for any values which aren't specified in the Provider block
+func DefaultRetryOptions() RetryOptions {
+	return RetryOptions{
+		MaxAttempts:      3,
+		MinBackoff:       1 * time.Second,
+		MaxBackoff:       60 * time.Second,
+		HonourRetryAfter: true,
+		StatusCodeRules:  map[int]int{},
+	}
+}
+
+// maxAttemptsFor returns the maximum number of attempts for a request which completes with the specified Status Code
+func (o RetryOptions) maxAttemptsFor(statusCode int) int {
+	v, ok := o.StatusCodeRules[statusCode]
+	if !ok {
+		return 1
+	}
+	if v == 0 {
+		return o.MaxAttempts
+	}
+	return v
+}
+
+// backoff returns the delay before the next attempt, where attempt is the number of attempts made so far
+func (o RetryOptions) backoff(attempt int, resp *http.Response) time.Duration {
+	if o.HonourRetryAfter {
+		if delay, ok := retryAfter(resp); ok {
+			return delay
+		}
+	}
+
+	delay := float64(o.MinBackoff) * math.Pow(2, float64(attempt-1))
+	if delay > float64(o.MaxBackoff) {
+		return o.MaxBackoff
+	}
+	return time.Duration(delay)
+}
+
+// retry re-sends the request using send for as long as the Retry Policy allows, returning the final response
+func (o RetryOptions) retry(req *http.Request, resp *http.Response, send func() (*http.Response, error)) (*http.Response, error) {
+	for attempt := 1; resp != nil && attempt < o.maxAttemptsFor(resp.StatusCode); attempt++ {
+		delay := o.backoff(attempt, resp)
+		log.Printf("[DEBUG] AzureRM: retrying request to %s after %s as the response had the Status Code %d (attempt %d)", req.URL, delay, resp.StatusCode, attempt+1)
+
+		// drain the previous response so that the connection can be reused
+		if resp.Body != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, fmt.Errorf("waiting to retry request: %+v", req.Context().Err())
+		case <-timer.C:
+		}
+
+		var err error
+		resp, err = send()
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// IsRetriedBySdk returns whether the underlying SDKs always retry a request which completes with the specified Status
+// Code - go-azure-sdk retries these until the request times out (honouring the `Retry-After` header) and autorest
+// retries a subset of these, as such these can't be configured in the Retry Policy.
+func IsRetriedBySdk(statusCode int) bool {
+	switch statusCode {
+	case http.StatusRequestTimeout, http.StatusFailedDependency, http.StatusTooManyRequests:
+		return true
+	}
+
+	return statusCode >= http.StatusInternalServerError && statusCode != http.StatusNotImplemented
+}
+
+// ValidateRetryStatusCode validates that the value is an HTTP Status Code which can be configured in a Retry Policy
+func ValidateRetryStatusCode(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be int", k))
+		return
+	}
+
+	if v < 400 || v > 599 {
+		errors = append(errors, fmt.Errorf("expected %q to be in the range (400 - 599), got %d", k, v))
+		return
+	}
+
+	if IsRetriedBySdk(v) {
+		errors = append(errors, fmt.Errorf("%q cannot be %d since this HTTP Status Code is always retried by the underlying Azure SDKs until the operation times out - the HTTP Status Codes 408, 424, 429 and 5xx (except 501) cannot be configured", k, v))
+	}
+
+	return
+}
+
+// retryAfter parses the delay from the `Retry-After` header (or the `retry-after-ms` header returned by some
+// Azure APIs) of the response, which can either be a number of seconds or a HTTP Date
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	for _, header := range []string{"Retry-After-Ms", "X-Ms-Retry-After-Ms"} {
+		if v := resp.Header.Get(header); v != "" {
+			if ms, err := strconv.ParseInt(v, 10, 64); err == nil && ms >= 0 {
+				return time.Duration(ms) * time.Millisecond, true
+			}
+		}
+	}
+
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(v, 10, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		delay := time.Until(t)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
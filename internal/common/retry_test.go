@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+type retryTestResponse struct {
+	statusCode int
+	headers    map[string]string
+}
+
+// retryTestServer returns a httptest.Server which returns each of the responses in turn, followed
+// by a `200 OK` - along with a function returning the request bodies which were received
+func retryTestServer(t *testing.T, responses []retryTestResponse) (*httptest.Server, func() []string) {
+	var lock sync.Mutex
+	bodies := make([]string, 0)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading request body: %+v", err)
+		}
+		bodies = append(bodies, string(body))
+
+		if i := len(bodies) - 1; i < len(responses) {
+			for k, v := range responses[i].headers {
+				w.Header().Set(k, v)
+			}
+			w.WriteHeader(responses[i].statusCode)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]string{}, bodies...)
+	}
+}
+
+func testRetryOptions() RetryOptions {
+	options := DefaultRetryOptions()
+	options.MinBackoff = 10 * time.Millisecond
+	options.MaxBackoff = 50 * time.Millisecond
+	return options
+}
+
+func sendAutorestRequest(t *testing.T, options ClientOptions, url string) *http.Response {
+	c := autorest.NewClientWithUserAgent("")
+	options.ConfigureClient(&c, nil)
+
+	req, err := http.NewRequest(http.MethodPut, url, strings.NewReader(`{"hello":"world"}`))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	resp, err := c.Sender.Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	t.Cleanup(func() {
+		resp.Body.Close()
+	})
+
+	return resp
+}
+
+func TestRetryPolicy_AutorestRetriesWithRetryAfter(t *testing.T) {
+	server, bodies := retryTestServer(t, []retryTestResponse{
+		{statusCode: http.StatusConflict, headers: map[string]string{"Retry-After": "1"}},
+		{statusCode: http.StatusConflict, headers: map[string]string{"Retry-After": "1"}},
+	})
+
+	options := testRetryOptions()
+	options.StatusCodeRules = map[int]int{
+		http.StatusConflict: 3,
+	}
+	start := time.Now()
+	resp := sendAutorestRequest(t, ClientOptions{Retry: &options}, server.URL)
+	elapsed := time.Since(start)
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the final status code to be 200 but got %d", resp.StatusCode)
+	}
+	if elapsed < 2*time.Second {
+		t.Fatalf("expected the `Retry-After` header to be honoured, but the requests completed in %s", elapsed)
+	}
+
+	received := bodies()
+	if len(received) != 3 {
+		t.Fatalf("expected 3 requests but got %d", len(received))
+	}
+	for i, body := range received {
+		if body != `{"hello":"world"}` {
+			t.Fatalf("expected request %d to contain the request body but got %q", i, body)
+		}
+	}
+}
+
+func TestRetryPolicy_AutorestIgnoresRetryAfter(t *testing.T) {
+	server, bodies := retryTestServer(t, []retryTestResponse{
+		{statusCode: http.StatusConflict, headers: map[string]string{"Retry-After": "10"}},
+	})
+
+	options := testRetryOptions()
+	options.HonourRetryAfter = false
+	options.StatusCodeRules = map[int]int{
+		http.StatusConflict: 2,
+	}
+	start := time.Now()
+	resp := sendAutorestRequest(t, ClientOptions{Retry: &options}, server.URL)
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the final status code to be 200 but got %d", resp.StatusCode)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the `Retry-After` header to be ignored, but the requests took %s", elapsed)
+	}
+	if len(bodies()) != 2 {
+		t.Fatalf("expected 2 requests but got %d", len(bodies()))
+	}
+}
+
+func TestRetryPolicy_AutorestMaxAttempts(t *testing.T) {
+	server, bodies := retryTestServer(t, []retryTestResponse{
+		{statusCode: http.StatusConflict},
+		{statusCode: http.StatusConflict},
+		{statusCode: http.StatusConflict},
+	})
+
+	// a rule without a maximum number of attempts uses MaxAttempts
+	options := testRetryOptions()
+	options.MaxAttempts = 2
+	options.StatusCodeRules = map[int]int{
+		http.StatusConflict: 0,
+	}
+	resp := sendAutorestRequest(t, ClientOptions{Retry: &options}, server.URL)
+
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected the final status code to be 409 but got %d", resp.StatusCode)
+	}
+	if len(bodies()) != 2 {
+		t.Fatalf("expected 2 requests but got %d", len(bodies()))
+	}
+}
+
+func TestRetryPolicy_AutorestStatusCodeRules(t *testing.T) {
+	server, bodies := retryTestServer(t, []retryTestResponse{
+		{statusCode: http.StatusConflict, headers: map[string]string{"Retry-After": "0"}},
+		{statusCode: http.StatusConflict, headers: map[string]string{"Retry-After": "0"}},
+	})
+
+	// only the status codes with a rule are retried
+	options := testRetryOptions()
+	options.StatusCodeRules = map[int]int{
+		http.StatusPreconditionFailed: 3,
+	}
+	resp := sendAutorestRequest(t, ClientOptions{Retry: &options}, server.URL)
+
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected the final status code to be 409 but got %d", resp.StatusCode)
+	}
+	if len(bodies()) != 1 {
+		t.Fatalf("expected 1 request but got %d", len(bodies()))
+	}
+}
+
+func TestRetryPolicy_GoAzureSdkStatusCodeRules(t *testing.T) {
+	server, bodies := retryTestServer(t, []retryTestResponse{
+		{statusCode: http.StatusConflict, headers: map[string]string{"Retry-After": "0"}},
+		{statusCode: http.StatusConflict, headers: map[string]string{"Retry-After": "0"}},
+	})
+
+	// the SDK doesn't retry a `409 Conflict`, so these retries are performed by the Retry Policy
+	options := testRetryOptions()
+	options.StatusCodeRules = map[int]int{
+		http.StatusConflict: 3,
+	}
+
+	c := client.NewClient(server.URL, "Example", "2020-01-01")
+
+	// requests re-sent by the Retry Policy are sent using the client, so go through the same middlewares
+	middlewareCalls := 0
+	c.AppendRequestMiddleware(func(r *http.Request) (*http.Request, error) {
+		middlewareCalls++
+		return r, nil
+	})
+	ClientOptions{Retry: &options, DisableCorrelationRequestID: true}.Configure(c, nil)
+
+	resp, err := sendGoAzureSdkRequest(t, c)
+	if err != nil {
+		t.Fatalf("executing request: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the final status code to be 200 but got %d", resp.StatusCode)
+	}
+
+	received := bodies()
+	if len(received) != 3 {
+		t.Fatalf("expected 3 requests but got %d", len(received))
+	}
+	if middlewareCalls != 3 {
+		t.Fatalf("expected the request middlewares to be called 3 times but got %d", middlewareCalls)
+	}
+	for i, body := range received {
+		if body != `{"hello":"world"}` {
+			t.Fatalf("expected request %d to contain the request body but got %q", i, body)
+		}
+	}
+}
+
+func TestRetryPolicy_GoAzureSdkDoesNotMultiplyAttempts(t *testing.T) {
+	responses := make([]retryTestResponse, 0)
+	for i := 0; i < 30; i++ {
+		responses = append(responses, retryTestResponse{statusCode: http.StatusTooManyRequests, headers: map[string]string{"Retry-After": "0"}})
+	}
+	server, bodies := retryTestServer(t, responses)
+
+	// the SDK retries a `429 Too Many Requests` itself, which can't be configured in the Retry Policy
+	options := testRetryOptions()
+	c := client.NewClient(server.URL, "Example", "2020-01-01")
+	ClientOptions{Retry: &options, DisableCorrelationRequestID: true}.Configure(c, nil)
+
+	if _, err := sendGoAzureSdkRequest(t, c); err == nil {
+		t.Fatalf("expected an error since the request was rate limited")
+	}
+
+	// the initial request and 16 retries performed by the SDK
+	if len(bodies()) != 17 {
+		t.Fatalf("expected 17 requests but got %d", len(bodies()))
+	}
+}
+
+func sendGoAzureSdkRequest(t *testing.T, c *client.Client) (*client.Response, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	t.Cleanup(cancel)
+
+	req, err := c.NewRequest(ctx, client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodPut,
+		Path:                "/example",
+	})
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	if err := req.Marshal(map[string]string{"hello": "world"}); err != nil {
+		t.Fatalf("marshalling request: %+v", err)
+	}
+
+	return c.Execute(ctx, req)
+}
+
+func TestValidateRetryStatusCode(t *testing.T) {
+	testData := []struct {
+		statusCode int
+		valid      bool
+	}{
+		{statusCode: 399, valid: false},
+		{statusCode: http.StatusBadRequest, valid: true},
+		{statusCode: http.StatusNotFound, valid: true},
+		{statusCode: http.StatusRequestTimeout, valid: false},
+		{statusCode: http.StatusConflict, valid: true},
+		{statusCode: http.StatusPreconditionFailed, valid: true},
+		{statusCode: http.StatusFailedDependency, valid: false},
+		{statusCode: http.StatusTooManyRequests, valid: false},
+		{statusCode: http.StatusInternalServerError, valid: false},
+		{statusCode: http.StatusNotImplemented, valid: true},
+		{statusCode: http.StatusBadGateway, valid: false},
+		{statusCode: http.StatusServiceUnavailable, valid: false},
+		{statusCode: http.StatusGatewayTimeout, valid: false},
+		{statusCode: 599, valid: false},
+		{statusCode: 600, valid: false},
+	}
+
+	for _, v := range testData {
+		_, errors := ValidateRetryStatusCode(v.statusCode, "status_code")
+		if valid := len(errors) == 0; valid != v.valid {
+			t.Fatalf("expected the status code %d to be valid %t but got %t: %+v", v.statusCode, v.valid, valid, errors)
+		}
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	options := RetryOptions{
+		MinBackoff:       1 * time.Second,
+		MaxBackoff:       5 * time.Second,
+		HonourRetryAfter: true,
+	}
+
+	testData := []struct {
+		attempt  int
+		headers  map[string]string
+		expected time.Duration
+	}{
+		{attempt: 1, expected: 1 * time.Second},
+		{attempt: 2, expected: 2 * time.Second},
+		{attempt: 3, expected: 4 * time.Second},
+		{attempt: 4, expected: 5 * time.Second},
+		{attempt: 1, headers: map[string]string{"Retry-After": "30"}, expected: 30 * time.Second},
+		{attempt: 1, headers: map[string]string{"Retry-After": "invalid"}, expected: 1 * time.Second},
+		{attempt: 1, headers: map[string]string{"Retry-After": "30", "Retry-After-Ms": "250"}, expected: 250 * time.Millisecond},
+		{attempt: 1, headers: map[string]string{"X-Ms-Retry-After-Ms": "500"}, expected: 500 * time.Millisecond},
+		{attempt: 1, headers: map[string]string{"Retry-After": time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat)}, expected: 0},
+	}
+
+	for _, v := range testData {
+		resp := &http.Response{
+			Header: http.Header{},
+		}
+		for k, h := range v.headers {
+			resp.Header.Set(k, h)
+		}
+
+		if actual := options.backoff(v.attempt, resp); actual != v.expected {
+			t.Fatalf("expected a backoff of %s for attempt %d with the headers %+v but got %s", v.expected, v.attempt, v.headers, actual)
+		}
+	}
+}
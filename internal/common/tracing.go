@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	attributeHTTPMethod               = attribute.Key("http.request.method")
+	attributeHTTPStatusCode           = attribute.Key("http.response.status_code")
+	attributeURL                      = attribute.Key("url.full")
+	attributeServerAddress            = attribute.Key("server.address")
+	attributeRequestID                = attribute.Key("azurerm.request_id")
+	attributeLongRunningOperationPoll = attribute.Key("azurerm.long_running_operation.poll")
+)
+
+// longRunningOperationStatusSegments contains the (lower-cased) path segments used by the URIs which the status of
+// a Long Running Operation is polled from
+var longRunningOperationStatusSegments = []string{
+	"asyncoperations",
+	"azureasyncoperation",
+	"operationresults",
+	"operationsstatus",
+	"operationstatuses",
+}
+
+// isLongRunningOperationPoll determines whether the request is polling the status of a Long Running Operation, based
+// on the URIs returned in the `Azure-AsyncOperation` and `Location` headers (e.g. `.../operationStatuses/{id}`)
+func isLongRunningOperationPoll(request *http.Request) bool {
+	if request.Method != http.MethodGet {
+		return false
+	}
+
+	for _, segment := range strings.Split(strings.ToLower(request.URL.Path), "/") {
+		for _, v := range longRunningOperationStatusSegments {
+			if segment == v {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func httpSpanName(request *http.Request) string {
+	if isLongRunningOperationPoll(request) {
+		return "poll long-running operation"
+	}
+	return request.Method
+}
+
+func httpSpanOptions(request *http.Request) []trace.SpanStartOption {
+	attributes := []attribute.KeyValue{
+		attributeHTTPMethod.String(request.Method),
+		attributeURL.String(redactURL(request.URL)),
+		attributeServerAddress.String(request.URL.Hostname()),
+		attributeLongRunningOperationPoll.Bool(isLongRunningOperationPoll(request)),
+	}
+	if id := request.Header.Get(HeaderCorrelationRequestID); id != "" {
+		attributes = append(attributes, tracing.AttributeCorrelationRequestID.String(id))
+	}
+
+	return []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	}
+}
+
+// endHTTPSpan records the outcome of the request against the span and then ends it
+func endHTTPSpan(span trace.Span, response *http.Response, err error) {
+	if response != nil {
+		span.SetAttributes(
+			attributeHTTPStatusCode.Int(response.StatusCode),
+			attributeRequestID.String(response.Header.Get("x-ms-request-id")),
+		)
+		if err == nil && response.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, fmt.Sprintf("unexpected status %d", response.StatusCode))
+		}
+	}
+
+	tracing.End(span, err)
+}
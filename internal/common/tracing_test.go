@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestIsLongRunningOperationPoll(t *testing.T) {
+	testData := []struct {
+		method   string
+		url      string
+		expected bool
+	}{
+		{
+			method:   http.MethodGet,
+			url:      "https://management.azure.com/subscriptions/1234/providers/Microsoft.Network/locations/westeurope/operations/abc",
+			expected: false,
+		},
+		{
+			method:   http.MethodGet,
+			url:      "https://management.azure.com/subscriptions/1234/providers/Microsoft.Compute/locations/westeurope/operationStatuses/abc",
+			expected: true,
+		},
+		{
+			method:   http.MethodGet,
+			url:      "https://management.azure.com/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Web/sites/site1/operationResults/abc",
+			expected: true,
+		},
+		{
+			method:   http.MethodGet,
+			url:      "https://management.azure.com/subscriptions/1234/providers/Microsoft.Sql/locations/westeurope/serverAzureAsyncOperation/abc",
+			expected: false,
+		},
+		{
+			method:   http.MethodGet,
+			url:      "https://management.azure.com/subscriptions/1234/providers/Microsoft.Sql/locations/westeurope/asyncOperations/abc",
+			expected: true,
+		},
+		{
+			method:   http.MethodPut,
+			url:      "https://management.azure.com/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Web/sites/site1/operationResults/abc",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		request := httptest.NewRequest(v.method, v.url, nil)
+		if actual := isLongRunningOperationPoll(request); actual != v.expected {
+			t.Fatalf("expected %t but got %t for %s %s", v.expected, actual, v.method, v.url)
+		}
+	}
+}
+
+func TestTracingMiddleware(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	defer otel.SetTracerProvider(previous)
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	request := httptest.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/1234/providers/Microsoft.Compute/locations/westeurope/operationStatuses/abc?api-version=2024-03-01&sig=secret", nil)
+	request.Header.Set(HeaderCorrelationRequestID, "00000000-0000-0000-0000-000000000001")
+	request = withRequestStartTime(request)
+
+	response := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Header: http.Header{
+			"X-Ms-Request-Id": []string{"abc123"},
+		},
+	}
+	if _, err := tracingMiddleware()(request, response); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span but got %d", len(spans))
+	}
+	span := spans[0]
+
+	if span.Name() != "poll long-running operation" {
+		t.Fatalf("expected the span name `poll long-running operation` but got %q", span.Name())
+	}
+	if span.Status().Code != codes.Error {
+		t.Fatalf("expected the span status to be an error for a 404")
+	}
+
+	start, _ := requestStartTime(request)
+	if !span.StartTime().Equal(start) {
+		t.Fatalf("expected the span to start at %s but got %s", start, span.StartTime())
+	}
+
+	attributes := make(map[string]interface{})
+	for _, v := range span.Attributes() {
+		attributes[string(v.Key)] = v.Value.AsInterface()
+	}
+	expected := map[string]interface{}{
+		string(attributeHTTPMethod):                   http.MethodGet,
+		string(attributeHTTPStatusCode):               int64(http.StatusNotFound),
+		string(attributeURL):                          "https://management.azure.com/subscriptions/1234/providers/Microsoft.Compute/locations/westeurope/operationStatuses/abc?api-version=2024-03-01&sig=REDACTED",
+		string(attributeRequestID):                    "abc123",
+		string(attributeLongRunningOperationPoll):     true,
+		string(tracing.AttributeCorrelationRequestID): "00000000-0000-0000-0000-000000000001",
+	}
+	for k, v := range expected {
+		if attributes[k] != v {
+			t.Fatalf("expected the attribute %q to be %v but got %v", k, v, attributes[k])
+		}
+	}
+}
+
+func TestWithTracing(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	defer otel.SetTracerProvider(previous)
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sender := autorest.DecorateSender(server.Client(), withTracing())
+	request, _ := http.NewRequest(http.MethodPut, server.URL+"/subscriptions/1234/resourceGroups/group1", nil)
+	response, err := sender.Do(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	response.Body.Close()
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span but got %d", len(spans))
+	}
+	if spans[0].Name() != http.MethodPut {
+		t.Fatalf("expected the span name %q but got %q", http.MethodPut, spans[0].Name())
+	}
+	if spans[0].Status().Code == codes.Error {
+		t.Fatalf("expected the span not to be an error")
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computequota
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdkhacks"
+)
+
+// cachedUsages contains the Compute usage and limits within a Subscription and Location, keyed by
+// `{subscriptionId}/{location}` - and is retrieved once per run, prior to any resources being provisioned
+var cachedUsages = make(map[string][]sdkhacks.Usage)
+
+// plannedCores contains the vCPUs requested by each resource being planned within a Subscription and Location,
+// keyed by `{subscriptionId}/{location}`, then the key of the resource and finally the name of the quota
+var plannedCores = make(map[string]map[string]map[string]int64)
+
+var cacheLock = &sync.Mutex{}
+
+// ClearCache removes all of the cached usages and planned vCPUs
+func ClearCache() {
+	cacheLock.Lock()
+	cachedUsages = make(map[string][]sdkhacks.Usage)
+	plannedCores = make(map[string]map[string]map[string]int64)
+	cacheLock.Unlock()
+}
+
+// usagesForLocation returns the Compute usage and limits within the specified Subscription and Location,
+// retrieving these from the API the first time they're requested and caching them thereafter.
+func usagesForLocation(ctx context.Context, client *sdkhacks.UsagesClient, subscriptionId commonids.SubscriptionId, loc string) ([]sdkhacks.Usage, error) {
+	key := fmt.Sprintf("%s/%s", subscriptionId.SubscriptionId, location.Normalize(loc))
+
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	if v, ok := cachedUsages[key]; ok {
+		return v, nil
+	}
+
+	resp, err := client.List(ctx, subscriptionId, loc)
+	if err != nil {
+		return nil, fmt.Errorf("listing the Compute usages in %q: %+v", location.Normalize(loc), err)
+	}
+
+	cachedUsages[key] = pointer.From(resp.Model)
+	return cachedUsages[key], nil
+}
+
+// recordPlannedCores stores the vCPUs requested by the resource identified by resourceKey and returns the total
+// vCPUs requested for each quota by all of the resources planned within the Subscription and Location so far.
+func recordPlannedCores(subscriptionId string, loc string, resourceKey string, requested map[string]int64) map[string]int64 {
+	key := fmt.Sprintf("%s/%s", subscriptionId, location.Normalize(loc))
+
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	if _, ok := plannedCores[key]; !ok {
+		plannedCores[key] = make(map[string]map[string]int64)
+	}
+	plannedCores[key][resourceKey] = requested
+
+	total := make(map[string]int64)
+	for _, resource := range plannedCores[key] {
+		for quota, cores := range resource {
+			total[quota] += cores
+		}
+	}
+	return total
+}
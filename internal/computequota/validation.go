@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computequota
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceskus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdkhacks"
+)
+
+const (
+	// totalRegionalCoresQuota is the name of the quota for the total number of (regular priority) vCPUs within a Location
+	totalRegionalCoresQuota = "cores"
+
+	// lowPriorityCoresQuota is the name of the quota for the total number of Spot/Low Priority vCPUs within a Location
+	lowPriorityCoresQuota = "lowPriorityCores"
+)
+
+type Input struct {
+	// ResourceKey uniquely identifies the resource requesting the vCPUs, such that a resource which is planned more
+	// than once is only counted once - for example the Resource ID, or the Resource Type/Resource Group/Name
+	ResourceKey string
+
+	// Location is the Azure Region where the resource will be provisioned
+	Location string
+
+	// Size is the Virtual Machine Size of each instance, for example `Standard_D2s_v3`
+	Size string
+
+	// Instances is the number of instances of this Size being requested
+	Instances int64
+
+	// Spot specifies whether these are Spot/Low Priority instances, which count towards a separate quota
+	Spot bool
+
+	// PreviousSize is the Size of each existing instance when the resource is being updated
+	PreviousSize string
+
+	// PreviousInstances is the number of existing instances when the resource is being updated
+	PreviousInstances int64
+}
+
+type Clients struct {
+	SkusClient   *skus.SkusClient
+	UsagesClient *sdkhacks.UsagesClient
+}
+
+// ValidateDuringPlan checks that enough vCPU quota is available to this Subscription within the Location (both for the
+// VM Family and the total regional vCPUs) for the changes being planned when the `check_during_plan` feature within the
+// `compute_quota` block is enabled - such that a shortfall is surfaced during the plan, rather than an apply failing
+// part-way through once other resources have already been provisioned.
+//
+// The vCPUs requested by every Virtual Machine, Virtual Machine Scale Set and Node Pool planned within the same Location
+// are summed, so the check accounts for all of the planned changes rather than each resource in isolation. Depending on
+// the `fail_on_shortfall` feature, a shortfall is either returned as an error, or logged as a warning.
+//
+// NOTE: this is best-effort - if the Resource SKUs or Compute usages can't be retrieved, we'll fall back to the API
+// validating this during the apply.
+func ValidateDuringPlan(ctx context.Context, userFeatures features.UserFeatures, clients Clients, subscriptionId string, input Input) error {
+	if !userFeatures.ComputeQuota.CheckDuringPlan || input.Location == "" || input.Size == "" {
+		return nil
+	}
+
+	requested, err := requestedCores(ctx, clients.SkusClient, subscriptionId, input)
+	if err != nil {
+		log.Printf("[DEBUG] %+v - the Compute quota check for %q will be unavailable", err, input.ResourceKey)
+		return nil
+	}
+
+	total := recordPlannedCores(subscriptionId, input.Location, input.ResourceKey, requested)
+
+	usages, err := usagesForLocation(ctx, clients.UsagesClient, commonids.NewSubscriptionID(subscriptionId), input.Location)
+	if err != nil {
+		log.Printf("[DEBUG] %+v - the Compute quota check for %q will be unavailable", err, input.ResourceKey)
+		return nil
+	}
+
+	if err := validateQuota(usages, location.Normalize(input.Location), requested, total); err != nil {
+		if !userFeatures.ComputeQuota.FailOnShortfall {
+			log.Printf("[WARN] %s: %+v", input.ResourceKey, err)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// requestedCores returns the additional vCPUs requested by the resource for each quota (keyed by the quota name)
+func requestedCores(ctx context.Context, client *skus.SkusClient, subscriptionId string, input Input) (map[string]int64, error) {
+	capacity, err := resourceskus.VirtualMachineCapacityForSize(ctx, client, subscriptionId, input.Location, input.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	var previous *resourceskus.VirtualMachineCapacity
+	if input.PreviousSize != "" && input.PreviousInstances > 0 {
+		previous, err = resourceskus.VirtualMachineCapacityForSize(ctx, client, subscriptionId, input.Location, input.PreviousSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return calculateRequestedCores(*capacity, input.Instances, previous, input.PreviousInstances, input.Spot), nil
+}
+
+func calculateRequestedCores(capacity resourceskus.VirtualMachineCapacity, instances int64, previous *resourceskus.VirtualMachineCapacity, previousInstances int64, spot bool) map[string]int64 {
+	cores := capacity.VCPUs * instances
+	previousCores := int64(0)
+	sameFamily := false
+	if previous != nil {
+		previousCores = previous.VCPUs * previousInstances
+		sameFamily = strings.EqualFold(previous.Family, capacity.Family)
+	}
+
+	requested := make(map[string]int64)
+	if spot {
+		// Spot/Low Priority instances only count towards the regional Low Priority quota
+		requested[lowPriorityCoresQuota] = atLeastZero(cores - previousCores)
+		return requested
+	}
+
+	requested[totalRegionalCoresQuota] = atLeastZero(cores - previousCores)
+
+	// when the Size changes to a different VM Family, all of the vCPUs are requested from the new VM Family
+	if sameFamily {
+		requested[capacity.Family] = atLeastZero(cores - previousCores)
+	} else {
+		requested[capacity.Family] = cores
+	}
+
+	return requested
+}
+
+// validateQuota checks that the total vCPUs planned for each of the quotas requested by this resource fit within the
+// remaining quota, returning an error describing the shortfall for each quota which doesn't.
+func validateQuota(usages []sdkhacks.Usage, loc string, requested map[string]int64, total map[string]int64) error {
+	quotas := make([]string, 0)
+	for quota, cores := range requested {
+		if cores > 0 {
+			quotas = append(quotas, quota)
+		}
+	}
+	sort.Strings(quotas)
+
+	shortfalls := make([]string, 0)
+	for _, quota := range quotas {
+		usage := findUsage(usages, quota)
+		if usage == nil {
+			continue
+		}
+
+		available := usage.Limit - usage.CurrentValue
+		if total[quota] <= available {
+			continue
+		}
+
+		name := pointer.From(usage.Name.LocalizedValue)
+		if name == "" {
+			name = quota
+		}
+		shortfalls = append(shortfalls, fmt.Sprintf("%q: %d vCPUs are requested by the planned changes (%d by this resource) but only %d of the limit of %d vCPUs are available - a shortfall of %d vCPUs", name, total[quota], requested[quota], atLeastZero(available), usage.Limit, total[quota]-atLeastZero(available)))
+	}
+
+	if len(shortfalls) > 0 {
+		return fmt.Errorf("insufficient Compute quota in the location %q for this Subscription - request a quota increase or reduce the number of vCPUs requested:\n\n%s", loc, strings.Join(shortfalls, "\n"))
+	}
+
+	return nil
+}
+
+func findUsage(usages []sdkhacks.Usage, quota string) *sdkhacks.Usage {
+	for _, v := range usages {
+		if strings.EqualFold(pointer.From(v.Name.Value), quota) {
+			return pointer.To(v)
+		}
+	}
+	return nil
+}
+
+func atLeastZero(input int64) int64 {
+	if input < 0 {
+		return 0
+	}
+	return input
+}
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computequota
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceskus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdkhacks"
+)
+
+func TestValidateDuringPlanDisabled(t *testing.T) {
+	// the clients are nil, so this would panic if the API was called
+	err := ValidateDuringPlan(context.TODO(), features.Default(), Clients{}, "00000000-0000-0000-0000-000000000000", Input{
+		ResourceKey: "azurerm_linux_virtual_machine/example",
+		Location:    "West Europe",
+		Size:        "Standard_D2s_v3",
+		Instances:   1,
+	})
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+}
+
+func TestCalculateRequestedCores(t *testing.T) {
+	dsv3 := resourceskus.VirtualMachineCapacity{Family: "standardDSv3Family", VCPUs: 2}
+	dsv3Large := resourceskus.VirtualMachineCapacity{Family: "standardDSv3Family", VCPUs: 8}
+	esv5 := resourceskus.VirtualMachineCapacity{Family: "standardESv5Family", VCPUs: 4}
+
+	testCases := []struct {
+		name              string
+		capacity          resourceskus.VirtualMachineCapacity
+		instances         int64
+		previous          *resourceskus.VirtualMachineCapacity
+		previousInstances int64
+		spot              bool
+		expected          map[string]int64
+	}{
+		{
+			name:      "new",
+			capacity:  dsv3,
+			instances: 3,
+			expected: map[string]int64{
+				"cores":              6,
+				"standardDSv3Family": 6,
+			},
+		},
+		{
+			name:      "new spot",
+			capacity:  dsv3,
+			instances: 3,
+			spot:      true,
+			expected: map[string]int64{
+				"lowPriorityCores": 6,
+			},
+		},
+		{
+			name:              "scaling out",
+			capacity:          dsv3,
+			instances:         5,
+			previous:          pointer.To(dsv3),
+			previousInstances: 3,
+			expected: map[string]int64{
+				"cores":              4,
+				"standardDSv3Family": 4,
+			},
+		},
+		{
+			name:              "scaling in",
+			capacity:          dsv3,
+			instances:         1,
+			previous:          pointer.To(dsv3),
+			previousInstances: 3,
+			expected: map[string]int64{
+				"cores":              0,
+				"standardDSv3Family": 0,
+			},
+		},
+		{
+			name:              "resizing within the same family",
+			capacity:          dsv3Large,
+			instances:         1,
+			previous:          pointer.To(dsv3),
+			previousInstances: 1,
+			expected: map[string]int64{
+				"cores":              6,
+				"standardDSv3Family": 6,
+			},
+		},
+		{
+			name:              "resizing to a different family",
+			capacity:          esv5,
+			instances:         2,
+			previous:          pointer.To(dsv3),
+			previousInstances: 2,
+			expected: map[string]int64{
+				"cores":              4,
+				"standardESv5Family": 8,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q..", testCase.name)
+
+		actual := calculateRequestedCores(testCase.capacity, testCase.instances, testCase.previous, testCase.previousInstances, testCase.spot)
+		if !reflect.DeepEqual(actual, testCase.expected) {
+			t.Fatalf("expected %+v but got %+v", testCase.expected, actual)
+		}
+	}
+}
+
+func TestRecordPlannedCores(t *testing.T) {
+	ClearCache()
+	defer ClearCache()
+
+	subscriptionId := "00000000-0000-0000-0000-000000000000"
+
+	recordPlannedCores(subscriptionId, "West Europe", "first", map[string]int64{"cores": 4, "standardDSv3Family": 4})
+	recordPlannedCores(subscriptionId, "North Europe", "other", map[string]int64{"cores": 16, "standardDSv3Family": 16})
+
+	// planning the same resource again replaces the vCPUs previously requested, rather than adding to them
+	recordPlannedCores(subscriptionId, "westeurope", "first", map[string]int64{"cores": 2, "standardDSv3Family": 2})
+
+	actual := recordPlannedCores(subscriptionId, "westeurope", "second", map[string]int64{"cores": 8, "standardESv5Family": 8})
+	expected := map[string]int64{
+		"cores":              10,
+		"standardDSv3Family": 2,
+		"standardESv5Family": 8,
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestValidateQuota(t *testing.T) {
+	usages := []sdkhacks.Usage{
+		{
+			CurrentValue: 10,
+			Limit:        20,
+			Name: sdkhacks.UsageName{
+				LocalizedValue: pointer.To("Total Regional vCPUs"),
+				Value:          pointer.To("cores"),
+			},
+		},
+		{
+			CurrentValue: 4,
+			Limit:        10,
+			Name: sdkhacks.UsageName{
+				LocalizedValue: pointer.To("Standard DSv3 Family vCPUs"),
+				Value:          pointer.To("standardDSv3Family"),
+			},
+		},
+		{
+			CurrentValue: 0,
+			Limit:        0,
+			Name: sdkhacks.UsageName{
+				LocalizedValue: pointer.To("Standard NCASv3_T4 Family vCPUs"),
+				Value:          pointer.To("Standard NCASv3_T4 Family"),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		requested map[string]int64
+		total     map[string]int64
+		valid     bool
+	}{
+		{
+			name:      "within quota",
+			requested: map[string]int64{"cores": 4, "standardDSv3Family": 4},
+			total:     map[string]int64{"cores": 4, "standardDSv3Family": 4},
+			valid:     true,
+		},
+		{
+			name:      "exactly the remaining quota",
+			requested: map[string]int64{"cores": 6, "standardDSv3Family": 6},
+			total:     map[string]int64{"cores": 6, "standardDSv3Family": 6},
+			valid:     true,
+		},
+		{
+			name:      "family quota exceeded",
+			requested: map[string]int64{"cores": 8, "standardDSv3Family": 8},
+			total:     map[string]int64{"cores": 8, "standardDSv3Family": 8},
+			valid:     false,
+		},
+		{
+			name:      "family quota exceeded by the other planned changes",
+			requested: map[string]int64{"cores": 2, "standardDSv3Family": 2},
+			total:     map[string]int64{"cores": 8, "standardDSv3Family": 8},
+			valid:     false,
+		},
+		{
+			name:      "regional quota exceeded",
+			requested: map[string]int64{"cores": 4, "standardDSv3Family": 4},
+			total:     map[string]int64{"cores": 12, "standardDSv3Family": 4},
+			valid:     false,
+		},
+		{
+			name:      "no quota for the family",
+			requested: map[string]int64{"cores": 4, "Standard NCASv3_T4 Family": 4},
+			total:     map[string]int64{"cores": 4, "Standard NCASv3_T4 Family": 4},
+			valid:     false,
+		},
+		{
+			name:      "shortfall on another quota not requested by this resource",
+			requested: map[string]int64{"cores": 0, "standardDSv3Family": 0},
+			total:     map[string]int64{"cores": 40, "standardDSv3Family": 40},
+			valid:     true,
+		},
+		{
+			name:      "unknown quota",
+			requested: map[string]int64{"lowPriorityCores": 100},
+			total:     map[string]int64{"lowPriorityCores": 100},
+			valid:     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q..", testCase.name)
+
+		err := validateQuota(usages, "westeurope", testCase.requested, testCase.total)
+		if testCase.valid && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if !testCase.valid && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
@@ -75,5 +75,12 @@ func Default() UserFeatures {
 			VMBackupStopProtectionAndRetainDataOnDestroy: false,
 			PurgeProtectedItemsFromVaultOnDestroy:        false,
 		},
+		NameAvailability: NameAvailabilityFeatures{
+			CheckDuringPlan: false,
+		},
+		ComputeQuota: ComputeQuotaFeatures{
+			CheckDuringPlan: false,
+			FailOnShortfall: true,
+		},
 	}
 }
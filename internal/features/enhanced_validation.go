@@ -11,7 +11,9 @@ import (
 // EnhancedValidationEnabled returns whether the feature for Enhanced Validation is enabled.
 //
 // This functionality calls out to the Azure MetaData Service to cache the list of supported
-// Azure Locations for the specified Endpoint - and then uses that to provide enhanced validation
+// Azure Locations for the specified Endpoint - and then uses that to provide enhanced validation.
+// The Compute Resource SKUs available within each Subscription/Location are also cached on first
+// use, to validate the SKUs/Sizes and Availability Zones of Compute resources during the plan.
 //
 // This is enabled by default as of version 2.20 of the Azure Provider, and can be disabled by
 // setting the Environment Variable `ARM_PROVIDER_ENHANCED_VALIDATION` to `false`.
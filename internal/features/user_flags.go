@@ -20,6 +20,8 @@ type UserFeatures struct {
 	PostgresqlFlexibleServer PostgresqlFlexibleServerFeatures
 	MachineLearning          MachineLearningFeatures
 	RecoveryService          RecoveryServiceFeatures
+	NameAvailability         NameAvailabilityFeatures
+	ComputeQuota             ComputeQuotaFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -104,3 +106,12 @@ type RecoveryServiceFeatures struct {
 	VMBackupStopProtectionAndRetainDataOnDestroy bool
 	PurgeProtectedItemsFromVaultOnDestroy        bool
 }
+
+type NameAvailabilityFeatures struct {
+	CheckDuringPlan bool
+}
+
+type ComputeQuotaFeatures struct {
+	CheckDuringPlan bool
+	FailOnShortfall bool
+}
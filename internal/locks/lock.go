@@ -3,19 +3,39 @@
 
 package locks
 
+import (
+	"context"
+	"sort"
+)
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = newMutexKV()
 
+// NOTE: ByID, ByName and MultipleByName wait indefinitely for the lock - the WithContext variants should be
+// preferred, which stop waiting once the context (e.g. bound by the Resource's timeout) is done.
+
 func ByID(id string) {
 	armMutexKV.Lock(id)
 }
 
+// ByIDWithContext locks the specified ID, returning an error if the lock can't be acquired before the context is done
+func ByIDWithContext(ctx context.Context, id string) error {
+	return armMutexKV.LockWithContext(ctx, id)
+}
+
 // handle the case of using the same name for different kinds of resources
 func ByName(name string, resourceType string) {
 	updatedName := resourceType + "." + name
 	armMutexKV.Lock(updatedName)
 }
 
+// ByNameWithContext locks the specified name for the Resource Type, returning an error if the lock can't be acquired
+// before the context is done
+func ByNameWithContext(ctx context.Context, name string, resourceType string) error {
+	updatedName := resourceType + "." + name
+	return armMutexKV.LockWithContext(ctx, updatedName)
+}
+
 func MultipleByName(names *[]string, resourceType string) {
 	newSlice := removeDuplicatesFromStringArray(*names)
 
@@ -24,6 +44,27 @@ func MultipleByName(names *[]string, resourceType string) {
 	}
 }
 
+// MultipleByNameWithContext locks each of the specified names for the Resource Type, returning an error if the locks
+// can't be acquired before the context is done - in which case any locks which were acquired are released.
+//
+// The names are locked in a consistent (sorted) order, so that concurrent callers locking an overlapping set of names
+// can't deadlock.
+func MultipleByNameWithContext(ctx context.Context, names *[]string, resourceType string) error {
+	newSlice := removeDuplicatesFromStringArray(*names)
+	sort.Strings(newSlice)
+
+	for i, name := range newSlice {
+		if err := ByNameWithContext(ctx, name, resourceType); err != nil {
+			for _, locked := range newSlice[:i] {
+				UnlockByName(locked, resourceType)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func UnlockByID(id string) {
 	armMutexKV.Unlock(id)
 }
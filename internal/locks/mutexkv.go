@@ -4,48 +4,218 @@
 package locks
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"runtime"
+	"strings"
 	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+const attributeLockKey = attribute.Key("azurerm.lock.key")
+
+// contentionLogInterval is how often a caller waiting for a contended lock logs who is holding it
+var contentionLogInterval = time.Minute
+
+// lockHolder contains details of who is holding the lock for a key, which are logged when the lock is contended
+type lockHolder struct {
+	// caller is the function which acquired the lock, e.g. `network.resourceSubnetCreate`
+	caller string
+
+	// owner is the context which the lock was acquired with, used to determine the other keys locked by the
+	// same operation - this is nil for locks acquired without a context
+	owner context.Context
+
+	since time.Time
+}
+
+func (h lockHolder) String() string {
+	return fmt.Sprintf("%s (for %s)", h.caller, time.Since(h.since).Round(time.Second))
+}
+
+// lockOrder is a pair of keys which have been locked by the same operation, in the order they were locked
+type lockOrder struct {
+	first  string
+	second string
+}
+
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]chan struct{}
+
+	// holders contains details of who is currently holding the lock for each key
+	holders map[string]lockHolder
+
+	// orders contains the function which first locked each pair of keys in that order, used to detect
+	// operations which lock the same keys in an inconsistent order (which can deadlock)
+	orders map[lockOrder]string
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
+	// the background context is never cancelled, so this waits until the lock is acquired
+	_ = m.lockWithContext(context.Background(), key, callerName())
+}
+
+// LockWithContext locks the mutex for the given key, waiting until either the lock is acquired or the context is
+// cancelled/its deadline is exceeded. Caller is responsible for calling Unlock for the same key when this succeeds
+func (m *mutexKV) LockWithContext(ctx context.Context, key string) error {
+	return m.lockWithContext(ctx, key, callerName())
+}
+
+func (m *mutexKV) lockWithContext(ctx context.Context, key string, caller string) error {
 	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
+	m.checkOrder(ctx, key, caller)
+
+	// the time spent waiting for the lock is recorded, so that contention can be identified
+	_, span := tracing.StartSpan(ctx, "wait for lock", trace.WithAttributes(attributeLockKey.String(key)))
+	mutex := m.get(key)
+
+	select {
+	case mutex <- struct{}{}:
+	default:
+		if err := m.waitForLock(ctx, key, mutex); err != nil {
+			tracing.End(span, err)
+			return err
+		}
+	}
+	span.End()
+
+	var owner context.Context
+	if ctx.Done() != nil {
+		owner = ctx
+	}
+	m.lock.Lock()
+	m.holders[key] = lockHolder{
+		caller: caller,
+		owner:  owner,
+		since:  time.Now(),
+	}
+	m.lock.Unlock()
+
 	log.Printf("[DEBUG] Locked %q", key)
+	return nil
+}
+
+// waitForLock waits for the contended lock for the given key, periodically logging who is holding it
+func (m *mutexKV) waitForLock(ctx context.Context, key string, mutex chan struct{}) error {
+	log.Printf("[DEBUG] Waiting for the lock on %q, which is held by %s", key, m.holder(key))
+
+	ticker := time.NewTicker(contentionLogInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case mutex <- struct{}{}:
+			return nil
+
+		case <-ticker.C:
+			log.Printf("[WARN] Still waiting for the lock on %q, which is held by %s", key, m.holder(key))
+
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for the lock on %q, which is held by %s: %+v", key, m.holder(key), ctx.Err())
+		}
+	}
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
+	mutex := m.get(key)
+
+	m.lock.Lock()
+	delete(m.holders, key)
+	m.lock.Unlock()
+
+	select {
+	case <-mutex:
+	default:
+		panic(fmt.Sprintf("unlock of unlocked key %q", key))
+	}
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
+// checkOrder logs a warning when the given key is being locked whilst holding another key (with the same context),
+// when these keys have previously been locked in the opposite order - since two operations doing this concurrently
+// will deadlock.
+func (m *mutexKV) checkOrder(ctx context.Context, key string, caller string) {
+	if ctx.Done() == nil {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for heldKey, holder := range m.holders {
+		if holder.owner != ctx || heldKey == key {
+			continue
+		}
+
+		if previous, ok := m.orders[lockOrder{first: key, second: heldKey}]; ok {
+			log.Printf("[WARN] %s is locking %q whilst holding %q, however %s locked these in the opposite order - which can deadlock", caller, key, heldKey, previous)
+		}
+
+		order := lockOrder{first: heldKey, second: key}
+		if _, ok := m.orders[order]; !ok {
+			m.orders[order] = caller
+		}
+	}
+}
+
+// holder returns a description of who is holding the lock for the given key
+func (m *mutexKV) holder(key string) string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if v, ok := m.holders[key]; ok {
+		return v.String()
+	}
+	return "an unknown caller"
+}
+
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+func (m *mutexKV) get(key string) chan struct{} {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = make(chan struct{}, 1)
 		m.store[key] = mutex
 	}
 	return mutex
 }
 
+// callerName returns the name of the function outside of this package which is acquiring the lock
+func callerName() string {
+	pcs := make([]uintptr, 10)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		name := frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+		if !strings.HasPrefix(name, "locks.") {
+			return name
+		}
+		if !more {
+			return "an unknown caller"
+		}
+	}
+}
+
 // newMutexKV returns a properly initialized mutexKV
 func newMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store:   make(map[string]chan struct{}),
+		holders: make(map[string]lockHolder),
+		orders:  make(map[lockOrder]string),
 	}
 }
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLockWithContextTimeout(t *testing.T) {
+	m := newMutexKV()
+	m.Lock("key1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := m.LockWithContext(ctx, "key1")
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if !strings.Contains(err.Error(), "which is held by") {
+		t.Fatalf("expected the error to contain the holder of the lock but got: %+v", err)
+	}
+
+	m.Unlock("key1")
+	if err := m.LockWithContext(context.Background(), "key1"); err != nil {
+		t.Fatalf("unexpected error acquiring the released lock: %+v", err)
+	}
+	m.Unlock("key1")
+}
+
+func TestLockWithContextWaits(t *testing.T) {
+	m := newMutexKV()
+	m.Lock("key1")
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		m.Unlock("key1")
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := m.LockWithContext(ctx, "key1"); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	m.Unlock("key1")
+}
+
+func TestUnlockOfUnlockedKey(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("expected a panic when unlocking a key which isn't locked")
+		}
+	}()
+
+	newMutexKV().Unlock("key1")
+}
+
+func TestLockOrderingIsDetected(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	m := newMutexKV()
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	defer cancel1()
+	if err := m.LockWithContext(ctx1, "first"); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if err := m.LockWithContext(ctx1, "second"); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	m.Unlock("second")
+	m.Unlock("first")
+
+	if strings.Contains(buf.String(), "opposite order") {
+		t.Fatalf("expected no warning for a consistent order but got: %s", buf.String())
+	}
+
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	if err := m.LockWithContext(ctx2, "second"); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if err := m.LockWithContext(ctx2, "first"); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	m.Unlock("first")
+	m.Unlock("second")
+
+	if !strings.Contains(buf.String(), `locking "first" whilst holding "second"`) {
+		t.Fatalf("expected a warning for an inconsistent order but got: %s", buf.String())
+	}
+}
+
+func TestMultipleByNameWithContextReleasesOnError(t *testing.T) {
+	ByName("b", "azurerm_example")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	names := []string{"c", "a", "b"}
+	if err := MultipleByNameWithContext(ctx, &names, "azurerm_example"); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	UnlockByName("b", "azurerm_example")
+
+	// `a` was locked prior to `b`, so should have been released - `c` is locked after `b` so was never locked
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel2()
+	if err := MultipleByNameWithContext(ctx2, &names, "azurerm_example"); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	UnlockMultipleByName(&names, "azurerm_example")
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nameavailability
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// Result is the outcome of checking whether a name is available for a globally unique resource
+type Result struct {
+	Available bool
+
+	// Message is an optional explanation returned by the API when the name isn't available
+	Message string
+}
+
+// CheckFunc checks whether the specified name is available, using the relevant `checkNameAvailability` API
+type CheckFunc func(ctx context.Context, name string) (*Result, error)
+
+var cachedResults = make(map[string]Result)
+
+var cacheLock = &sync.Mutex{}
+
+// ValidateDuringPlan checks that the `name` of a new resource is available when the `check_during_plan` feature
+// within the `name_availability` block is enabled, allowing a name which is already taken to fail the plan rather
+// than part-way through an apply.
+//
+// Results are cached for the lifetime of the provider process, so that each name is checked at most once per run.
+func ValidateDuringPlan(ctx context.Context, diff *pluginsdk.ResourceDiff, userFeatures features.UserFeatures, resourceType string, check CheckFunc) error {
+	if !userFeatures.NameAvailability.CheckDuringPlan {
+		return nil
+	}
+
+	// an existing resource already owns its name - unless the name is changing, which forces a new resource
+	if diff.Id() != "" && !diff.HasChange("name") {
+		return nil
+	}
+
+	// the name may not be known until apply, for example when it's generated by another resource
+	if !diff.NewValueKnown("name") {
+		return nil
+	}
+
+	name := diff.Get("name").(string)
+	if name == "" {
+		return nil
+	}
+
+	result, err := cachedCheck(ctx, resourceType, name, check)
+	if err != nil {
+		return fmt.Errorf("checking whether the name %q is available for the %s: %+v", name, resourceType, err)
+	}
+
+	if !result.Available {
+		if result.Message != "" {
+			return fmt.Errorf("the name %q is not available for the %s since it needs to be globally unique: %s", name, resourceType, result.Message)
+		}
+		return fmt.Errorf("the name %q is not available for the %s since it needs to be globally unique", name, resourceType)
+	}
+
+	return nil
+}
+
+// ClearCache removes all of the cached results
+func ClearCache() {
+	cacheLock.Lock()
+	cachedResults = make(map[string]Result)
+	cacheLock.Unlock()
+}
+
+func cachedCheck(ctx context.Context, resourceType string, name string, check CheckFunc) (*Result, error) {
+	key := fmt.Sprintf("%s/%s", strings.ToLower(resourceType), strings.ToLower(name))
+
+	cacheLock.Lock()
+	cached, ok := cachedResults[key]
+	cacheLock.Unlock()
+	if ok {
+		return &cached, nil
+	}
+
+	result, err := check(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, fmt.Errorf("the result was nil")
+	}
+
+	cacheLock.Lock()
+	cachedResults[key] = *result
+	cacheLock.Unlock()
+
+	return result, nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nameavailability
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestCachedCheck(t *testing.T) {
+	ClearCache()
+	defer ClearCache()
+
+	calls := 0
+	check := func(ctx context.Context, name string) (*Result, error) {
+		calls++
+		return &Result{
+			Available: name != "taken",
+		}, nil
+	}
+
+	testData := []struct {
+		ResourceType  string
+		Name          string
+		Available     bool
+		ExpectedCalls int
+	}{
+		{
+			ResourceType:  "Storage Account",
+			Name:          "taken",
+			Available:     false,
+			ExpectedCalls: 1,
+		},
+		{
+			// cached
+			ResourceType:  "Storage Account",
+			Name:          "taken",
+			Available:     false,
+			ExpectedCalls: 1,
+		},
+		{
+			// names are cached case-insensitively
+			ResourceType:  "Storage Account",
+			Name:          "TAKEN",
+			Available:     false,
+			ExpectedCalls: 1,
+		},
+		{
+			// names are cached per resource type
+			ResourceType:  "Key Vault",
+			Name:          "taken",
+			Available:     false,
+			ExpectedCalls: 2,
+		},
+		{
+			ResourceType:  "Storage Account",
+			Name:          "available",
+			Available:     true,
+			ExpectedCalls: 3,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q for %q", v.Name, v.ResourceType)
+
+		result, err := cachedCheck(context.TODO(), v.ResourceType, v.Name, check)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if result.Available != v.Available {
+			t.Fatalf("expected Available to be %t but got %t", v.Available, result.Available)
+		}
+		if calls != v.ExpectedCalls {
+			t.Fatalf("expected %d calls to the API but got %d", v.ExpectedCalls, calls)
+		}
+	}
+}
+
+func TestCachedCheckDoesNotCacheErrors(t *testing.T) {
+	ClearCache()
+	defer ClearCache()
+
+	calls := 0
+	check := func(ctx context.Context, name string) (*Result, error) {
+		calls++
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := cachedCheck(context.TODO(), "Storage Account", "example", check); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected 2 calls to the API but got %d", calls)
+	}
+}
@@ -372,6 +372,41 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"name_availability": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"check_during_plan": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		"compute_quota": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"check_during_plan": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"fail_on_shortfall": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
 	}
 
 	// this is a temporary hack to enable us to gradually add provider blocks to test configurations
@@ -624,5 +659,28 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["name_availability"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			nameAvailabilityRaw := items[0].(map[string]interface{})
+			if v, ok := nameAvailabilityRaw["check_during_plan"]; ok {
+				featuresMap.NameAvailability.CheckDuringPlan = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["compute_quota"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			computeQuotaRaw := items[0].(map[string]interface{})
+			if v, ok := computeQuotaRaw["check_during_plan"]; ok {
+				featuresMap.ComputeQuota.CheckDuringPlan = v.(bool)
+			}
+			if v, ok := computeQuotaRaw["fail_on_shortfall"]; ok {
+				featuresMap.ComputeQuota.FailOnShortfall = v.(bool)
+			}
+		}
+	}
+
 	return featuresMap
 }
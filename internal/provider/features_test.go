@@ -88,6 +88,13 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: false,
 					PurgeProtectedItemsFromVaultOnDestroy:        false,
 				},
+				NameAvailability: features.NameAvailabilityFeatures{
+					CheckDuringPlan: false,
+				},
+				ComputeQuota: features.ComputeQuotaFeatures{
+					CheckDuringPlan: false,
+					FailOnShortfall: true,
+				},
 			},
 		},
 		{
@@ -193,6 +200,17 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_protected_items_from_vault_on_destroy":          true,
 						},
 					},
+					"name_availability": []interface{}{
+						map[string]interface{}{
+							"check_during_plan": true,
+						},
+					},
+					"compute_quota": []interface{}{
+						map[string]interface{}{
+							"check_during_plan": true,
+							"fail_on_shortfall": true,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -263,6 +281,13 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: true,
 					PurgeProtectedItemsFromVaultOnDestroy:        true,
 				},
+				NameAvailability: features.NameAvailabilityFeatures{
+					CheckDuringPlan: true,
+				},
+				ComputeQuota: features.ComputeQuotaFeatures{
+					CheckDuringPlan: true,
+					FailOnShortfall: true,
+				},
 			},
 		},
 		{
@@ -368,6 +393,17 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_protected_items_from_vault_on_destroy":          false,
 						},
 					},
+					"name_availability": []interface{}{
+						map[string]interface{}{
+							"check_during_plan": false,
+						},
+					},
+					"compute_quota": []interface{}{
+						map[string]interface{}{
+							"check_during_plan": false,
+							"fail_on_shortfall": false,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -438,6 +474,13 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: false,
 					PurgeProtectedItemsFromVaultOnDestroy:        false,
 				},
+				NameAvailability: features.NameAvailabilityFeatures{
+					CheckDuringPlan: false,
+				},
+				ComputeQuota: features.ComputeQuotaFeatures{
+					CheckDuringPlan: false,
+					FailOnShortfall: false,
+				},
 			},
 		},
 	}
@@ -1678,3 +1721,138 @@ func TestExpandFeaturesRecoveryService(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesNameAvailability(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name_availability": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				NameAvailability: features.NameAvailabilityFeatures{
+					CheckDuringPlan: false,
+				},
+			},
+		},
+		{
+			Name: "Check During Plan Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name_availability": []interface{}{
+						map[string]interface{}{
+							"check_during_plan": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				NameAvailability: features.NameAvailabilityFeatures{
+					CheckDuringPlan: true,
+				},
+			},
+		},
+		{
+			Name: "Check During Plan Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name_availability": []interface{}{
+						map[string]interface{}{
+							"check_during_plan": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				NameAvailability: features.NameAvailabilityFeatures{
+					CheckDuringPlan: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.NameAvailability, testCase.Expected.NameAvailability) {
+			t.Fatalf("Expected %+v but got %+v", result.NameAvailability, testCase.Expected.NameAvailability)
+		}
+	}
+}
+
+func TestExpandFeaturesComputeQuota(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"compute_quota": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				ComputeQuota: features.ComputeQuotaFeatures{
+					CheckDuringPlan: false,
+					FailOnShortfall: true,
+				},
+			},
+		},
+		{
+			Name: "Check During Plan Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"compute_quota": []interface{}{
+						map[string]interface{}{
+							"check_during_plan": true,
+							"fail_on_shortfall": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ComputeQuota: features.ComputeQuotaFeatures{
+					CheckDuringPlan: true,
+					FailOnShortfall: true,
+				},
+			},
+		},
+		{
+			Name: "Check During Plan Enabled and Warn on Shortfall",
+			Input: []interface{}{
+				map[string]interface{}{
+					"compute_quota": []interface{}{
+						map[string]interface{}{
+							"check_during_plan": true,
+							"fail_on_shortfall": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ComputeQuota: features.ComputeQuotaFeatures{
+					CheckDuringPlan: true,
+					FailOnShortfall: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.ComputeQuota, testCase.Expected.ComputeQuota) {
+			t.Fatalf("Expected %+v but got %+v", result.ComputeQuota, testCase.Expected.ComputeQuota)
+		}
+	}
+}
@@ -12,10 +12,14 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	providerfeatures "github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
 type ProviderConfig struct {
@@ -462,9 +466,166 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 			f.RecoveryService.VMBackupStopProtectionAndRetainDataOnDestroy = false
 			f.RecoveryService.PurgeProtectedItemsFromVaultOnDestroy = false
 		}
+
+		if !features.NameAvailability.IsNull() && !features.NameAvailability.IsUnknown() {
+			var feature []NameAvailability
+			d := features.NameAvailability.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			f.NameAvailability.CheckDuringPlan = false
+			if !feature[0].CheckDuringPlan.IsNull() && !feature[0].CheckDuringPlan.IsUnknown() {
+				f.NameAvailability.CheckDuringPlan = feature[0].CheckDuringPlan.ValueBool()
+			}
+		} else {
+			f.NameAvailability.CheckDuringPlan = false
+		}
+
+		if !features.ComputeQuota.IsNull() && !features.ComputeQuota.IsUnknown() {
+			var feature []ComputeQuota
+			d := features.ComputeQuota.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			f.ComputeQuota.CheckDuringPlan = false
+			if !feature[0].CheckDuringPlan.IsNull() && !feature[0].CheckDuringPlan.IsUnknown() {
+				f.ComputeQuota.CheckDuringPlan = feature[0].CheckDuringPlan.ValueBool()
+			}
+
+			f.ComputeQuota.FailOnShortfall = true
+			if !feature[0].FailOnShortfall.IsNull() && !feature[0].FailOnShortfall.IsUnknown() {
+				f.ComputeQuota.FailOnShortfall = feature[0].FailOnShortfall.ValueBool()
+			}
+		} else {
+			f.ComputeQuota.CheckDuringPlan = false
+			f.ComputeQuota.FailOnShortfall = true
+		}
 	}
 
 	p.clientBuilder.Features = f
+
+	tagsConfig := tags.Config{}
+	if !data.DefaultTags.IsNull() && !data.DefaultTags.IsUnknown() {
+		var defaultTags []DefaultTags
+		diags.Append(data.DefaultTags.ElementsAs(ctx, &defaultTags, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(defaultTags) > 0 && !defaultTags[0].Tags.IsNull() && !defaultTags[0].Tags.IsUnknown() {
+			diags.Append(defaultTags[0].Tags.ElementsAs(ctx, &tagsConfig.DefaultTags, false)...)
+			if diags.HasError() {
+				return
+			}
+		}
+	}
+
+	if !data.IgnoreTags.IsNull() && !data.IgnoreTags.IsUnknown() {
+		var ignoreTags []IgnoreTags
+		diags.Append(data.IgnoreTags.ElementsAs(ctx, &ignoreTags, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(ignoreTags) > 0 {
+			if !ignoreTags[0].Keys.IsNull() && !ignoreTags[0].Keys.IsUnknown() {
+				diags.Append(ignoreTags[0].Keys.ElementsAs(ctx, &tagsConfig.IgnoreKeys, false)...)
+			}
+			if !ignoreTags[0].KeyPrefixes.IsNull() && !ignoreTags[0].KeyPrefixes.IsUnknown() {
+				diags.Append(ignoreTags[0].KeyPrefixes.ElementsAs(ctx, &tagsConfig.IgnoreKeyPrefixes, false)...)
+			}
+			if diags.HasError() {
+				return
+			}
+		}
+	}
+	p.clientBuilder.TagsConfig = tagsConfig
+
+	timeoutsConfig := timeouts.Defaults{}
+	if !data.DefaultTimeouts.IsNull() && !data.DefaultTimeouts.IsUnknown() {
+		var defaultTimeouts []DefaultTimeouts
+		diags.Append(data.DefaultTimeouts.ElementsAs(ctx, &defaultTimeouts, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(defaultTimeouts) > 0 {
+			timeoutsConfig.Values = expandTimeoutValues(defaultTimeouts[0].Create, defaultTimeouts[0].Read, defaultTimeouts[0].Update, defaultTimeouts[0].Delete)
+
+			if !defaultTimeouts[0].ResourceType.IsNull() && !defaultTimeouts[0].ResourceType.IsUnknown() {
+				var resourceTypes []DefaultTimeoutsResourceType
+				diags.Append(defaultTimeouts[0].ResourceType.ElementsAs(ctx, &resourceTypes, true)...)
+				if diags.HasError() {
+					return
+				}
+				for _, v := range resourceTypes {
+					timeoutsConfig.ResourceTypes = append(timeoutsConfig.ResourceTypes, timeouts.ResourceTypeDefaults{
+						Name:   v.Name.ValueString(),
+						Values: expandTimeoutValues(v.Create, v.Read, v.Update, v.Delete),
+					})
+				}
+			}
+		}
+	}
+	p.clientBuilder.TimeoutsConfig = timeoutsConfig
+
+	if !data.Retry.IsNull() && !data.Retry.IsUnknown() {
+		var retry []Retry
+		diags.Append(data.Retry.ElementsAs(ctx, &retry, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(retry) > 0 {
+			options := common.DefaultRetryOptions()
+			if !retry[0].MaxAttempts.IsNull() && !retry[0].MaxAttempts.IsUnknown() {
+				options.MaxAttempts = int(retry[0].MaxAttempts.ValueInt64())
+			}
+			if !retry[0].MinBackoffInSeconds.IsNull() && !retry[0].MinBackoffInSeconds.IsUnknown() {
+				options.MinBackoff = time.Duration(retry[0].MinBackoffInSeconds.ValueInt64()) * time.Second
+			}
+			if !retry[0].MaxBackoffInSeconds.IsNull() && !retry[0].MaxBackoffInSeconds.IsUnknown() {
+				options.MaxBackoff = time.Duration(retry[0].MaxBackoffInSeconds.ValueInt64()) * time.Second
+			}
+			if !retry[0].HonourRetryAfter.IsNull() && !retry[0].HonourRetryAfter.IsUnknown() {
+				options.HonourRetryAfter = retry[0].HonourRetryAfter.ValueBool()
+			}
+
+			if !retry[0].StatusCodeRule.IsNull() && !retry[0].StatusCodeRule.IsUnknown() {
+				var rules []RetryStatusCodeRule
+				diags.Append(retry[0].StatusCodeRule.ElementsAs(ctx, &rules, true)...)
+				if diags.HasError() {
+					return
+				}
+				for _, rule := range rules {
+					// a null `max_attempts` is `0`, which uses the `max_attempts` from the `retry` block
+					options.StatusCodeRules[int(rule.StatusCode.ValueInt64())] = int(rule.MaxAttempts.ValueInt64())
+				}
+			}
+
+			p.clientBuilder.Retry = &options
+		}
+	}
+
+	if !data.RateLimit.IsNull() && !data.RateLimit.IsUnknown() {
+		var rateLimit []RateLimit
+		diags.Append(data.RateLimit.ElementsAs(ctx, &rateLimit, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(rateLimit) > 0 {
+			p.clientBuilder.RateLimit = &common.RateLimitOptions{
+				RequestsPerSecond: rateLimit[0].RequestsPerSecond.ValueFloat64(),
+				Burst:             int(rateLimit[0].Burst.ValueInt64()),
+			}
+		}
+	}
+
 	p.clientBuilder.AuthConfig = authConfig
 	p.clientBuilder.CustomCorrelationRequestID = os.Getenv("ARM_CORRELATION_REQUEST_ID")
 	p.clientBuilder.TerraformVersion = tfVersion
@@ -522,3 +683,21 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 
 	p.Client = client
 }
+
+func expandTimeoutValues(create, read, update, delete types.String) timeouts.Values {
+	parse := func(input types.String) *time.Duration {
+		if input.IsNull() || input.IsUnknown() || input.ValueString() == "" {
+			return nil
+		}
+		// this has already been validated
+		duration, _ := time.ParseDuration(input.ValueString())
+		return &duration
+	}
+
+	return timeouts.Values{
+		Create: parse(create),
+		Read:   parse(read),
+		Update: parse(update),
+		Delete: parse(delete),
+	}
+}
@@ -200,6 +200,18 @@ func TestProviderConfig_LoadDefault(t *testing.T) {
 	if features.RecoveryService.PurgeProtectedItemsFromVaultOnDestroy {
 		t.Errorf("expected recovery_service.PurgeProtectedItemsFromVaultOnDestroy to be false")
 	}
+
+	if features.NameAvailability.CheckDuringPlan {
+		t.Errorf("expected name_availability.check_during_plan to be false")
+	}
+
+	if features.ComputeQuota.CheckDuringPlan {
+		t.Errorf("expected compute_quota.check_during_plan to be false")
+	}
+
+	if !features.ComputeQuota.FailOnShortfall {
+		t.Errorf("expected compute_quota.fail_on_shortfall to be true")
+	}
 }
 
 // TODO - helper functions to make setting up test date more easily so we can add more configuration coverage
@@ -302,6 +314,17 @@ func defaultFeaturesList() types.List {
 	})
 	recoveryServicesVaultsList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(RecoveryServiceVaultsAttributes), []attr.Value{recoveryServicesVaults})
 
+	nameAvailability, _ := basetypes.NewObjectValueFrom(context.Background(), NameAvailabilityAttributes, map[string]attr.Value{
+		"check_during_plan": basetypes.NewBoolNull(),
+	})
+	nameAvailabilityList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(NameAvailabilityAttributes), []attr.Value{nameAvailability})
+
+	computeQuota, _ := basetypes.NewObjectValueFrom(context.Background(), ComputeQuotaAttributes, map[string]attr.Value{
+		"check_during_plan": basetypes.NewBoolNull(),
+		"fail_on_shortfall": basetypes.NewBoolNull(),
+	})
+	computeQuotaList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(ComputeQuotaAttributes), []attr.Value{computeQuota})
+
 	fData, d := basetypes.NewObjectValue(FeaturesAttributes, map[string]attr.Value{
 		"api_management":             apiManagementList,
 		"app_configuration":          appConfigurationList,
@@ -319,6 +342,8 @@ func defaultFeaturesList() types.List {
 		"machine_learning":           machineLearningList,
 		"recovery_service":           recoveryServicesList,
 		"recovery_services_vaults":   recoveryServicesVaultsList,
+		"name_availability":          nameAvailabilityList,
+		"compute_quota":              computeQuotaList,
 	})
 
 	fmt.Printf("%+v", d)
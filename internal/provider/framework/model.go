@@ -35,11 +35,102 @@ type ProviderModel struct {
 	DisableTerraformPartnerId     types.Bool   `tfsdk:"disable_terraform_partner_id"`
 	StorageUseAzureAD             types.Bool   `tfsdk:"storage_use_azuread"`
 	Features                      types.List   `tfsdk:"features"`
+	DefaultTags                   types.List   `tfsdk:"default_tags"`
+	IgnoreTags                    types.List   `tfsdk:"ignore_tags"`
+	DefaultTimeouts               types.List   `tfsdk:"default_timeouts"`
+	Retry                         types.List   `tfsdk:"retry"`
+	RateLimit                     types.List   `tfsdk:"rate_limit"`
 	SkipProviderRegistration      types.Bool   `tfsdk:"skip_provider_registration"` // TODO - Remove in 5.0
 	ResourceProviderRegistrations types.String `tfsdk:"resource_provider_registrations"`
 	ResourceProvidersToRegister   types.List   `tfsdk:"resource_providers_to_register"`
 }
 
+type DefaultTags struct {
+	Tags types.Map `tfsdk:"tags"`
+}
+
+var DefaultTagsAttributes = map[string]attr.Type{
+	"tags": types.MapType{}.WithElementType(types.StringType),
+}
+
+type IgnoreTags struct {
+	Keys        types.Set `tfsdk:"keys"`
+	KeyPrefixes types.Set `tfsdk:"key_prefixes"`
+}
+
+var IgnoreTagsAttributes = map[string]attr.Type{
+	"keys":         types.SetType{}.WithElementType(types.StringType),
+	"key_prefixes": types.SetType{}.WithElementType(types.StringType),
+}
+
+type DefaultTimeouts struct {
+	Create       types.String `tfsdk:"create"`
+	Read         types.String `tfsdk:"read"`
+	Update       types.String `tfsdk:"update"`
+	Delete       types.String `tfsdk:"delete"`
+	ResourceType types.List   `tfsdk:"resource_type"`
+}
+
+var DefaultTimeoutsAttributes = map[string]attr.Type{
+	"create":        types.StringType,
+	"read":          types.StringType,
+	"update":        types.StringType,
+	"delete":        types.StringType,
+	"resource_type": types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(DefaultTimeoutsResourceTypeAttributes)),
+}
+
+type DefaultTimeoutsResourceType struct {
+	Name   types.String `tfsdk:"name"`
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+var DefaultTimeoutsResourceTypeAttributes = map[string]attr.Type{
+	"name":   types.StringType,
+	"create": types.StringType,
+	"read":   types.StringType,
+	"update": types.StringType,
+	"delete": types.StringType,
+}
+
+type Retry struct {
+	MaxAttempts         types.Int64 `tfsdk:"max_attempts"`
+	MinBackoffInSeconds types.Int64 `tfsdk:"min_backoff_in_seconds"`
+	MaxBackoffInSeconds types.Int64 `tfsdk:"max_backoff_in_seconds"`
+	HonourRetryAfter    types.Bool  `tfsdk:"honour_retry_after"`
+	StatusCodeRule      types.List  `tfsdk:"status_code_rule"`
+}
+
+var RetryAttributes = map[string]attr.Type{
+	"max_attempts":           types.Int64Type,
+	"min_backoff_in_seconds": types.Int64Type,
+	"max_backoff_in_seconds": types.Int64Type,
+	"honour_retry_after":     types.BoolType,
+	"status_code_rule":       types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RetryStatusCodeRuleAttributes)),
+}
+
+type RetryStatusCodeRule struct {
+	StatusCode  types.Int64 `tfsdk:"status_code"`
+	MaxAttempts types.Int64 `tfsdk:"max_attempts"`
+}
+
+var RetryStatusCodeRuleAttributes = map[string]attr.Type{
+	"status_code":  types.Int64Type,
+	"max_attempts": types.Int64Type,
+}
+
+type RateLimit struct {
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	Burst             types.Int64   `tfsdk:"burst"`
+}
+
+var RateLimitAttributes = map[string]attr.Type{
+	"requests_per_second": types.Float64Type,
+	"burst":               types.Int64Type,
+}
+
 type Features struct {
 	APIManagement            types.List `tfsdk:"api_management"`
 	AppConfiguration         types.List `tfsdk:"app_configuration"`
@@ -57,6 +148,8 @@ type Features struct {
 	MachineLearning          types.List `tfsdk:"machine_learning"`
 	RecoveryService          types.List `tfsdk:"recovery_service"`
 	RecoveryServicesVaults   types.List `tfsdk:"recovery_services_vaults"`
+	NameAvailability         types.List `tfsdk:"name_availability"`
+	ComputeQuota             types.List `tfsdk:"compute_quota"`
 }
 
 // FeaturesAttributes and the other block attribute vars are required for unit testing on the Load func
@@ -78,6 +171,8 @@ var FeaturesAttributes = map[string]attr.Type{
 	"machine_learning":           types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(MachineLearningAttributes)),
 	"recovery_service":           types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RecoveryServiceAttributes)),
 	"recovery_services_vaults":   types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RecoveryServiceVaultsAttributes)),
+	"name_availability":          types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(NameAvailabilityAttributes)),
+	"compute_quota":              types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(ComputeQuotaAttributes)),
 }
 
 type APIManagement struct {
@@ -245,3 +340,21 @@ type RecoveryServiceVaults struct {
 var RecoveryServiceVaultsAttributes = map[string]attr.Type{
 	"recover_soft_deleted_backup_protected_vm": types.BoolType,
 }
+
+type NameAvailability struct {
+	CheckDuringPlan types.Bool `tfsdk:"check_during_plan"`
+}
+
+var NameAvailabilityAttributes = map[string]attr.Type{
+	"check_during_plan": types.BoolType,
+}
+
+type ComputeQuota struct {
+	CheckDuringPlan types.Bool `tfsdk:"check_during_plan"`
+	FailOnShortfall types.Bool `tfsdk:"fail_on_shortfall"`
+}
+
+var ComputeQuotaAttributes = map[string]attr.Type{
+	"check_during_plan": types.BoolType,
+	"fail_on_shortfall": types.BoolType,
+}
@@ -5,19 +5,31 @@ package framework
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	pluginsdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	azurermprovider "github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	providerfunction "github.com/hashicorp/terraform-provider-azurerm/internal/provider/function"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
 type azureRmFrameworkProvider struct {
@@ -29,10 +41,21 @@ var _ provider.Provider = &azureRmFrameworkProvider{}
 
 var _ provider.ProviderWithFunctions = &azureRmFrameworkProvider{}
 
+var _ provider.ProviderWithEphemeralResources = &azureRmFrameworkProvider{}
+
+var _ provider.ProviderWithListResources = &azureRmFrameworkProvider{}
+
+var _ provider.ProviderWithActions = &azureRmFrameworkProvider{}
+
 func (p *azureRmFrameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
+		providerfunction.NewBuildResourceIDFunction,
+		providerfunction.NewCidrSubnetsForVnetFunction,
 		providerfunction.NewNormaliseResourceIDFunction,
 		providerfunction.NewParseResourceIDFunction,
+		providerfunction.NewResourceIDParentFunction,
+		providerfunction.NewStorageAccountNameFromFunction,
+		providerfunction.NewValidateResourceNameFunction,
 	}
 }
 
@@ -225,6 +248,162 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 		},
 
 		Blocks: map[string]schema.Block{
+			"default_tags": schema.ListNestedBlock{
+				Description: "A mapping of tags which should be assigned to all taggable Resources managed by this Provider.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Map{
+								frameworkhelpers.WrappedMapValidator{
+									Func:         tags.Validate,
+									Desc:         "Validate validates that the number of tags, and the length of each key and value, are within the limits supported by Azure.",
+									MarkdownDesc: "Validate validates that the number of tags, and the length of each key and value, are within the limits supported by Azure.",
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"ignore_tags": schema.ListNestedBlock{
+				Description: "Tags which should be ignored when reading the tags for all taggable Resources managed by this Provider.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+
+						"key_prefixes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "The default timeouts used for all Resources managed by this Provider, unless overridden in the `timeouts` block of the Resource.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: withTimeoutValuesAttributes(map[string]schema.Attribute{}),
+					Blocks: map[string]schema.Block{
+						"resource_type": schema.ListNestedBlock{
+							Description: "The default timeouts for the Resource Types matching `name`, which take precedence over the default timeouts for all Resources.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: withTimeoutValuesAttributes(map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Required:    true,
+										Description: "The Resource Type, which supports `*` wildcards - for example `azurerm_kubernetes_cluster*`.",
+										Validators: []validator.String{
+											frameworkhelpers.WrappedStringValidator{
+												Func:         timeouts.ValidateResourceTypePattern,
+												Desc:         "ValidateResourceTypePattern validates that the value is a Resource Type, which supports `*` wildcards.",
+												MarkdownDesc: "ValidateResourceTypePattern validates that the value is a Resource Type, which supports `*` wildcards.",
+											},
+										},
+									},
+								}),
+							},
+						},
+					},
+				},
+			},
+
+			"retry": schema.ListNestedBlock{
+				Description: "The Retry Policy applied to requests sent to Azure which fail with one of the configured HTTP Status Codes, in addition to the retries performed by the underlying SDKs.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+
+						"min_backoff_in_seconds": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+
+						"max_backoff_in_seconds": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+
+						"honour_retry_after": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"status_code_rule": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"status_code": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											frameworkhelpers.WrappedInt64Validator{
+												Func:         common.ValidateRetryStatusCode,
+												Desc:         "ValidateRetryStatusCode validates that the value is an HTTP Status Code which isn't always retried by the underlying SDKs.",
+												MarkdownDesc: "ValidateRetryStatusCode validates that the value is an HTTP Status Code which isn't always retried by the underlying SDKs.",
+											},
+										},
+									},
+
+									"max_attempts": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"rate_limit": schema.ListNestedBlock{
+				Description: "A client-side Rate Limit applied to requests sent to the Subscription, which is shared between all Provider instances using the same Subscription.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"requests_per_second": schema.Float64Attribute{
+							Required: true,
+							Validators: []validator.Float64{
+								float64validator.AtLeast(0.01),
+							},
+						},
+
+						"burst": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+
 			"features": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeBetween(1, 1),
@@ -455,6 +634,27 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 								},
 							},
 						},
+						"name_availability": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"check_during_plan": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"compute_quota": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"check_during_plan": schema.BoolAttribute{
+										Optional: true,
+									},
+									"fail_on_shortfall": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -462,6 +662,25 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 	}
 }
 
+// withTimeoutValuesAttributes returns `attributes` including a timeout for each operation, as used in the
+// `default_timeouts` block.
+func withTimeoutValuesAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	for _, operation := range []string{"create", "read", "update", "delete"} {
+		attributes[operation] = schema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("The default timeout for the %s operation, for example `30m`.", operation),
+			Validators: []validator.String{
+				frameworkhelpers.WrappedStringValidator{
+					Func:         timeouts.ValidateDuration,
+					Desc:         "ValidateDuration validates that the value is a duration greater than zero, for example `30m`.",
+					MarkdownDesc: "ValidateDuration validates that the value is a duration greater than zero, for example `30m`.",
+				},
+			},
+		}
+	}
+	return attributes
+}
+
 func (p *azureRmFrameworkProvider) Configure(ctx context.Context, request provider.ConfigureRequest, response *provider.ConfigureResponse) {
 	var data ProviderModel
 
@@ -475,11 +694,17 @@ func (p *azureRmFrameworkProvider) Configure(ctx context.Context, request provid
 
 		response.ResourceData = v
 		response.DataSourceData = v
+		response.EphemeralResourceData = v
+		response.ListResourceData = v
+		response.ActionData = v
 	} else {
 		p.Load(ctx, &data, request.TerraformVersion, &response.Diagnostics)
 
 		response.DataSourceData = &p.ProviderConfig
-		response.ResourceData = &p.ProviderConfig
+		response.ResourceData = p.ProviderConfig.Client
+		response.EphemeralResourceData = p.ProviderConfig.Client
+		response.ListResourceData = p.ProviderConfig.Client
+		response.ActionData = p.ProviderConfig.Client
 	}
 }
 
@@ -489,6 +714,75 @@ func (p *azureRmFrameworkProvider) DataSources(_ context.Context) []func() datas
 }
 
 func (p *azureRmFrameworkProvider) Resources(_ context.Context) []func() resource.Resource {
-	// We do not currently support any Native framework Resources
-	return nil
+	resources := make([]func() resource.Resource, 0)
+
+	for _, r := range azurermprovider.SupportedTypedFrameworkResources() {
+		if _, err := sdk.NewFrameworkResourceWrapper(r); err != nil {
+			panic(fmt.Errorf("creating Framework Wrapper for Resource %q: %+v", r.ResourceType(), err))
+		}
+
+		resources = append(resources, func() resource.Resource {
+			// a new Wrapper is returned each time since this holds the configured client, any errors were caught above
+			wrapper, _ := sdk.NewFrameworkResourceWrapper(r)
+			return wrapper
+		})
+	}
+
+	return resources
+}
+
+func (p *azureRmFrameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	ephemeralResources := make([]func() ephemeral.EphemeralResource, 0)
+
+	for _, service := range azurermprovider.SupportedFrameworkServices() {
+		if v, ok := service.(sdk.FrameworkServiceRegistrationWithEphemeralResources); ok {
+			ephemeralResources = append(ephemeralResources, v.EphemeralResources()...)
+		}
+	}
+
+	return ephemeralResources
+}
+
+func (p *azureRmFrameworkProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	listResources := make([]func() list.ListResource, 0)
+
+	// the managed resources for List Resources are served by the Plugin SDK Provider, as such the Plugin SDK Resources
+	// registered there are used, since these contain the fields added by the Provider (e.g. `tags_all`)
+	var pluginSdkResources map[string]*pluginsdkschema.Resource
+	if v, ok := p.V2Provider.(*pluginsdkschema.Provider); ok {
+		pluginSdkResources = v.ResourcesMap
+	}
+
+	for _, service := range azurermprovider.SupportedFrameworkServices() {
+		v, ok := service.(sdk.FrameworkServiceRegistrationWithListResources)
+		if !ok {
+			continue
+		}
+
+		for _, f := range v.ListResources() {
+			listResources = append(listResources, func() list.ListResource {
+				listResource := f()
+				if v, ok := listResource.(sdk.ListResourceWithPluginSdkResource); ok {
+					metadata := resource.MetadataResponse{}
+					v.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "azurerm"}, &metadata)
+					v.SetPluginSdkResource(pluginSdkResources[metadata.TypeName])
+				}
+				return listResource
+			})
+		}
+	}
+
+	return listResources
+}
+
+func (p *azureRmFrameworkProvider) Actions(_ context.Context) []func() action.Action {
+	actions := make([]func() action.Action, 0)
+
+	for _, service := range azurermprovider.SupportedFrameworkServices() {
+		if v, ok := service.(sdk.FrameworkServiceRegistrationWithActions); ok {
+			actions = append(actions, v.Actions()...)
+		}
+	}
+
+	return actions
 }
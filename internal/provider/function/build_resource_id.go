@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type BuildResourceIDFunction struct{}
+
+var _ function.Function = BuildResourceIDFunction{}
+
+func NewBuildResourceIDFunction() function.Function {
+	return &BuildResourceIDFunction{}
+}
+
+func (a BuildResourceIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "build_resource_id"
+}
+
+func (a BuildResourceIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "build_resource_id",
+		Description:         "Builds an Azure Resource Manager ID from a scope, a resource provider and pairs of resource type and resource name segments",
+		MarkdownDescription: "Builds an Azure Resource Manager ID from a scope, a resource provider and pairs of resource type and resource name segments",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "scope",
+				Description:         "The scope of the Resource, such as a Subscription or Resource Group ID",
+				MarkdownDescription: "The scope of the Resource, such as a Subscription or Resource Group ID",
+			},
+			function.StringParameter{
+				Name:                "resource_provider",
+				Description:         "The Resource Provider namespace, such as Microsoft.Storage",
+				MarkdownDescription: "The Resource Provider namespace, such as `Microsoft.Storage`",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "segments",
+			Description:         "Pairs of resource type and resource name segments, such as storageAccounts and account1",
+			MarkdownDescription: "Pairs of resource type and resource name segments, such as `storageAccounts` and `account1`",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (a BuildResourceIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var scope, resourceProvider string
+	var segments []string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &scope, &resourceProvider, &segments))
+
+	if response.Error != nil {
+		return
+	}
+
+	if scope != "" && !strings.HasPrefix(scope, "/") {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("the scope %q must start with a `/`", scope))
+		return
+	}
+
+	if resourceProvider == "" || strings.Contains(resourceProvider, "/") {
+		response.Error = function.NewArgumentFuncError(1, fmt.Sprintf("the resource provider %q must be a namespace, such as `Microsoft.Storage`", resourceProvider))
+		return
+	}
+
+	if len(segments) == 0 || len(segments)%2 != 0 {
+		response.Error = function.NewArgumentFuncError(2, fmt.Sprintf("expected an even number of resource type and resource name segments but got %d", len(segments)))
+		return
+	}
+
+	for i, segment := range segments {
+		if segment == "" || strings.Contains(segment, "/") {
+			response.Error = function.NewArgumentFuncError(int64(i+2), fmt.Sprintf("the segment %q must be non-empty and cannot contain a `/`", segment))
+			return
+		}
+	}
+
+	result := fmt.Sprintf("%s/providers/%s/%s", strings.TrimSuffix(scope, "/"), resourceProvider, strings.Join(segments, "/"))
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionBuildResourceID_basic(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testBuildResourceIdOutput(),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("resource_group_scoped", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/gateways/gateway1"),
+					acceptance.TestCheckOutput("subscription_scoped", "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/role1"),
+					acceptance.TestCheckOutput("tenant_scoped", "/providers/Microsoft.Management/managementGroups/group1"),
+					acceptance.TestCheckOutput("resource_scoped", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/mystorageaccount/providers/Microsoft.EventGrid/eventSubscriptions/event1"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionBuildResourceID_oddSegments(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "azurerm" {
+  features {}
+}
+
+output "test" {
+  value = provider::azurerm::build_resource_id("/subscriptions/12345678-1234-9876-4563-123456789012", "Microsoft.Storage", "storageAccounts")
+}
+`,
+				ExpectError: regexp.MustCompile("expected an even number of resource type and resource name segments"),
+			},
+		},
+	})
+}
+
+func testBuildResourceIdOutput() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "resource_group_scoped" {
+  value = provider::azurerm::build_resource_id("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1", "Microsoft.ApiManagement", "service", "service1", "gateways", "gateway1")
+}
+
+output "subscription_scoped" {
+  value = provider::azurerm::build_resource_id("/subscriptions/12345678-1234-9876-4563-123456789012/", "Microsoft.Authorization", "roleDefinitions", "role1")
+}
+
+output "tenant_scoped" {
+  value = provider::azurerm::build_resource_id("", "Microsoft.Management", "managementGroups", "group1")
+}
+
+output "resource_scoped" {
+  value = provider::azurerm::build_resource_id("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/mystorageaccount", "Microsoft.EventGrid", "eventSubscriptions", "event1")
+}
+`
+}
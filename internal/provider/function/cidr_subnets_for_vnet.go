@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// azureSubnetReservedAddressesAtStart is the number of addresses Azure reserves at the start of each
+	// subnet - the network address, the default gateway and two addresses used for Azure DNS
+	azureSubnetReservedAddressesAtStart = 4
+
+	// azureSubnetReservedAddressesAtEnd is the number of addresses Azure reserves at the end of each
+	// subnet - the broadcast address
+	azureSubnetReservedAddressesAtEnd = 1
+
+	// azureSubnetMaxIPv4PrefixLength is the smallest IPv4 subnet supported by Azure
+	azureSubnetMaxIPv4PrefixLength = 29
+
+	// azureSubnetIPv6PrefixLength is the only IPv6 subnet size supported by Azure
+	azureSubnetIPv6PrefixLength = 64
+)
+
+type CidrSubnetsForVnetFunction struct{}
+
+var _ function.Function = CidrSubnetsForVnetFunction{}
+
+var cidrSubnetResultTypes = map[string]attr.Type{
+	"cidr":                 types.StringType,
+	"first_usable_address": types.StringType,
+	"last_usable_address":  types.StringType,
+	"usable_address_count": types.NumberType,
+}
+
+func NewCidrSubnetsForVnetFunction() function.Function {
+	return &CidrSubnetsForVnetFunction{}
+}
+
+func (a CidrSubnetsForVnetFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "cidr_subnets_for_vnet"
+}
+
+func (a CidrSubnetsForVnetFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "cidr_subnets_for_vnet",
+		Description:         "Allocates consecutive subnets within a Virtual Network address space, taking into account the addresses Azure reserves within each subnet",
+		MarkdownDescription: "Allocates consecutive subnets within a Virtual Network address space, taking into account the addresses Azure reserves within each subnet",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "address_space",
+				Description:         "The address space of the Virtual Network in CIDR notation",
+				MarkdownDescription: "The address space of the Virtual Network in CIDR notation",
+			},
+		},
+		VariadicParameter: function.Int64Parameter{
+			Name:                "newbits",
+			Description:         "The number of additional prefix bits for each subnet",
+			MarkdownDescription: "The number of additional prefix bits for each subnet",
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: cidrSubnetResultTypes,
+			},
+		},
+	}
+}
+
+func (a CidrSubnetsForVnetFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var addressSpace string
+	var newBits []int64
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &addressSpace, &newBits))
+
+	if response.Error != nil {
+		return
+	}
+
+	prefix, err := netip.ParsePrefix(addressSpace)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("parsing the address space %q: %+v", addressSpace, err))
+		return
+	}
+	if prefix.Masked() != prefix {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("the address space %q must be the network address, such as %q", addressSpace, prefix.Masked().String()))
+		return
+	}
+
+	subnets, err := allocateAzureSubnets(prefix, newBits)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	output := make([]attr.Value, 0, len(subnets))
+	for _, subnet := range subnets {
+		first, last, count := azureSubnetUsableAddresses(subnet)
+		v, diags := types.ObjectValue(cidrSubnetResultTypes, map[string]attr.Value{
+			"cidr":                 types.StringValue(subnet.String()),
+			"first_usable_address": types.StringValue(first.String()),
+			"last_usable_address":  types.StringValue(last.String()),
+			"usable_address_count": types.NumberValue(new(big.Float).SetInt(count)),
+		})
+		if diags.HasError() {
+			response.Error = function.FuncErrorFromDiags(ctx, diags)
+			return
+		}
+		output = append(output, v)
+	}
+
+	result, diags := types.ListValue(types.ObjectType{AttrTypes: cidrSubnetResultTypes}, output)
+	if diags.HasError() {
+		response.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
+
+// allocateAzureSubnets allocates consecutive, non-overlapping subnets from within the prefix in the same
+// manner as Terraform's `cidrsubnets` function - whilst ensuring each subnet is a size supported by Azure.
+func allocateAzureSubnets(prefix netip.Prefix, newBits []int64) ([]netip.Prefix, error) {
+	totalBits := prefix.Addr().BitLen()
+	start := addrToInt(prefix.Addr())
+	end := new(big.Int).Add(start, prefixSize(prefix.Bits(), totalBits))
+
+	output := make([]netip.Prefix, 0, len(newBits))
+	current := new(big.Int).Set(start)
+	for i, bits := range newBits {
+		if bits < 1 {
+			return nil, fmt.Errorf("newbits %d must be greater than 0 but got %d", i, bits)
+		}
+
+		length := prefix.Bits() + int(bits)
+		if length > totalBits {
+			return nil, fmt.Errorf("newbits %d would result in a prefix length of /%d which is longer than an address (%d bits)", i, length, totalBits)
+		}
+		if prefix.Addr().Is4() && length > azureSubnetMaxIPv4PrefixLength {
+			return nil, fmt.Errorf("newbits %d would result in a /%d subnet, but Azure requires IPv4 subnets to be /%d or larger", i, length, azureSubnetMaxIPv4PrefixLength)
+		}
+		if prefix.Addr().Is6() && length != azureSubnetIPv6PrefixLength {
+			return nil, fmt.Errorf("newbits %d would result in a /%d subnet, but Azure requires IPv6 subnets to be a /%d", i, length, azureSubnetIPv6PrefixLength)
+		}
+
+		// align the start of this subnet to its size
+		size := prefixSize(length, totalBits)
+		remainder := new(big.Int).Mod(new(big.Int).Sub(current, start), size)
+		if remainder.Sign() != 0 {
+			current.Add(current, new(big.Int).Sub(size, remainder))
+		}
+
+		next := new(big.Int).Add(current, size)
+		if next.Cmp(end) > 0 {
+			return nil, fmt.Errorf("not enough remaining address space in %s to allocate a /%d subnet for newbits %d", prefix.String(), length, i)
+		}
+
+		output = append(output, netip.PrefixFrom(intToAddr(current, prefix.Addr().Is4()), length))
+		current = next
+	}
+
+	return output, nil
+}
+
+// azureSubnetUsableAddresses returns the first and last address which can be assigned within the subnet,
+// along with the number of usable addresses - excluding the addresses Azure reserves in each subnet
+func azureSubnetUsableAddresses(subnet netip.Prefix) (netip.Addr, netip.Addr, *big.Int) {
+	totalBits := subnet.Addr().BitLen()
+	size := prefixSize(subnet.Bits(), totalBits)
+	start := addrToInt(subnet.Addr())
+
+	first := new(big.Int).Add(start, big.NewInt(azureSubnetReservedAddressesAtStart))
+	last := new(big.Int).Sub(new(big.Int).Add(start, size), big.NewInt(1+azureSubnetReservedAddressesAtEnd))
+	count := new(big.Int).Sub(size, big.NewInt(azureSubnetReservedAddressesAtStart+azureSubnetReservedAddressesAtEnd))
+
+	return intToAddr(first, subnet.Addr().Is4()), intToAddr(last, subnet.Addr().Is4()), count
+}
+
+func prefixSize(bits int, totalBits int) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(totalBits-bits))
+}
+
+func addrToInt(addr netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(addr.AsSlice())
+}
+
+func intToAddr(input *big.Int, isIPv4 bool) netip.Addr {
+	length := 16
+	if isIPv4 {
+		length = 4
+	}
+	b := make([]byte, length)
+	input.FillBytes(b)
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
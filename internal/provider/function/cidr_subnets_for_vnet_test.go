@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionCidrSubnetsForVnet_basic(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testCidrSubnetsForVnetOutput("10.0.0.0/16", "8, 8, 4, 13"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("count", "4"),
+					acceptance.TestCheckOutput("first_cidr", "10.0.0.0/24"),
+					acceptance.TestCheckOutput("first_first_usable_address", "10.0.0.4"),
+					acceptance.TestCheckOutput("first_last_usable_address", "10.0.0.254"),
+					acceptance.TestCheckOutput("first_usable_address_count", "251"),
+					acceptance.TestCheckOutput("last_cidr", "10.0.32.0/29"),
+					acceptance.TestCheckOutput("last_first_usable_address", "10.0.32.4"),
+					acceptance.TestCheckOutput("last_last_usable_address", "10.0.32.6"),
+					acceptance.TestCheckOutput("last_usable_address_count", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionCidrSubnetsForVnet_ipv6(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testCidrSubnetsForVnetOutput("fd00::/48", "16, 16"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("count", "2"),
+					acceptance.TestCheckOutput("first_cidr", "fd00::/64"),
+					acceptance.TestCheckOutput("first_first_usable_address", "fd00::4"),
+					acceptance.TestCheckOutput("last_cidr", "fd00:0:0:1::/64"),
+					acceptance.TestCheckOutput("last_last_usable_address", "fd00::1:ffff:ffff:ffff:fffe"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionCidrSubnetsForVnet_subnetTooSmall(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testCidrSubnetsForVnetOutput("10.0.0.0/24", "6"),
+				ExpectError: regexp.MustCompile("Azure requires IPv4 subnets to be /29 or larger"),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionCidrSubnetsForVnet_addressSpaceExhausted(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testCidrSubnetsForVnetOutput("10.0.0.0/24", "1, 1, 1"),
+				ExpectError: regexp.MustCompile("not enough remaining address space"),
+			},
+		},
+	})
+}
+
+func testCidrSubnetsForVnetOutput(addressSpace string, newBits string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  subnets = provider::azurerm::cidr_subnets_for_vnet("%s", %s)
+  first   = local.subnets[0]
+  last    = local.subnets[length(local.subnets) - 1]
+}
+
+output "count" {
+  value = length(local.subnets)
+}
+
+output "first_cidr" {
+  value = local.first.cidr
+}
+
+output "first_first_usable_address" {
+  value = local.first.first_usable_address
+}
+
+output "first_last_usable_address" {
+  value = local.first.last_usable_address
+}
+
+output "first_usable_address_count" {
+  value = local.first.usable_address_count
+}
+
+output "last_cidr" {
+  value = local.last.cidr
+}
+
+output "last_first_usable_address" {
+  value = local.last.first_usable_address
+}
+
+output "last_last_usable_address" {
+  value = local.last.last_usable_address
+}
+
+output "last_usable_address_count" {
+  value = local.last.usable_address_count
+}
+`, addressSpace, newBits)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type ResourceIDParentFunction struct{}
+
+var _ function.Function = ResourceIDParentFunction{}
+
+func NewResourceIDParentFunction() function.Function {
+	return &ResourceIDParentFunction{}
+}
+
+func (a ResourceIDParentFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "resource_id_parent"
+}
+
+func (a ResourceIDParentFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "resource_id_parent",
+		Description:         "Returns the ID of the parent of an Azure Resource Manager ID, which is either the parent resource or the scope of the resource",
+		MarkdownDescription: "Returns the ID of the parent of an Azure Resource Manager ID, which is either the parent resource or the scope of the resource",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				Description:         "Resource ID",
+				MarkdownDescription: "Resource ID",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (a ResourceIDParentFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var id string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &id))
+
+	if response.Error != nil {
+		return
+	}
+
+	result, err := resourceIdParent(id)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
+
+// resourceIdParent returns the parent of the specified Resource ID. Resource IDs are made up of key/value
+// pairs (e.g. `resourceGroups/group1` or `providers/Microsoft.Web`) - so the parent is determined by
+// removing the last pair, along with the `providers` pair should it become the last pair.
+func resourceIdParent(id string) (string, error) {
+	if !strings.HasPrefix(id, "/") {
+		return "", fmt.Errorf("the ID %q must start with a `/`", id)
+	}
+
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	if len(segments)%2 != 0 {
+		return "", fmt.Errorf("the ID %q must be made up of pairs of segments", id)
+	}
+	for _, segment := range segments {
+		if segment == "" {
+			return "", fmt.Errorf("the ID %q contains an empty segment", id)
+		}
+	}
+
+	segments = segments[:len(segments)-2]
+	if len(segments) >= 2 && strings.EqualFold(segments[len(segments)-2], "providers") {
+		segments = segments[:len(segments)-2]
+	}
+
+	if len(segments) == 0 {
+		return "", fmt.Errorf("the ID %q doesn't have a parent", id)
+	}
+
+	return "/" + strings.Join(segments, "/"), nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionResourceIDParent_basic(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceIdParentOutput(),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("nested_resource", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1"),
+					acceptance.TestCheckOutput("top_level_resource", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1"),
+					acceptance.TestCheckOutput("resource_group", "/subscriptions/12345678-1234-9876-4563-123456789012"),
+					acceptance.TestCheckOutput("scoped_resource", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/mystorageaccount"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionResourceIDParent_noParent(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "azurerm" {
+  features {}
+}
+
+output "test" {
+  value = provider::azurerm::resource_id_parent("/subscriptions/12345678-1234-9876-4563-123456789012")
+}
+`,
+				ExpectError: regexp.MustCompile("doesn't have a parent"),
+			},
+		},
+	})
+}
+
+func testResourceIdParentOutput() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "nested_resource" {
+  value = provider::azurerm::resource_id_parent("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/gateways/gateway1")
+}
+
+output "top_level_resource" {
+  value = provider::azurerm::resource_id_parent("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1")
+}
+
+output "resource_group" {
+  value = provider::azurerm::resource_id_parent("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1")
+}
+
+output "scoped_resource" {
+  value = provider::azurerm::resource_id_parent("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/mystorageaccount/providers/Microsoft.EventGrid/eventSubscriptions/event1")
+}
+`
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// storageAccountNameMaxLength is the maximum length of a Storage Account name
+const storageAccountNameMaxLength = 24
+
+type StorageAccountNameFromFunction struct{}
+
+var _ function.Function = StorageAccountNameFromFunction{}
+
+func NewStorageAccountNameFromFunction() function.Function {
+	return &StorageAccountNameFromFunction{}
+}
+
+func (a StorageAccountNameFromFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "storage_account_name_from"
+}
+
+func (a StorageAccountNameFromFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "storage_account_name_from",
+		Description:         "Sanitises a string into a valid Storage Account name, by lower-casing it, removing any non-alphanumeric characters and truncating it to 24 characters",
+		MarkdownDescription: "Sanitises a string into a valid Storage Account name, by lower-casing it, removing any non-alphanumeric characters and truncating it to 24 characters",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "input",
+				Description:         "The string to sanitise",
+				MarkdownDescription: "The string to sanitise",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (a StorageAccountNameFromFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var input string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &input))
+
+	if response.Error != nil {
+		return
+	}
+
+	result := storageAccountNameFrom(input)
+	if len(result) < 3 {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q must contain at least 3 alphanumeric characters to be used as a Storage Account name", input))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
+
+func storageAccountNameFrom(input string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(input) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		}
+	}
+
+	result := sb.String()
+	if len(result) > storageAccountNameMaxLength {
+		result = result[:storageAccountNameMaxLength]
+	}
+
+	return result
+}
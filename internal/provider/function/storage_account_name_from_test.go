@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionStorageAccountNameFrom_basic(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testStorageAccountNameFromOutput(),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("unchanged", "examplestorage1"),
+					acceptance.TestCheckOutput("sanitised", "myappprodweu001"),
+					acceptance.TestCheckOutput("truncated", "averylongstorageaccountn"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionStorageAccountNameFrom_tooShort(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "azurerm" {
+  features {}
+}
+
+output "test" {
+  value = provider::azurerm::storage_account_name_from("a-b")
+}
+`,
+				ExpectError: regexp.MustCompile("must contain at least 3 alphanumeric characters"),
+			},
+		},
+	})
+}
+
+func testStorageAccountNameFromOutput() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "unchanged" {
+  value = provider::azurerm::storage_account_name_from("examplestorage1")
+}
+
+output "sanitised" {
+  value = provider::azurerm::storage_account_name_from("My-App_Prod.WEU-001")
+}
+
+output "truncated" {
+  value = provider::azurerm::storage_account_name_from("a-very-long-storage-account-name-for-testing")
+}
+`
+}
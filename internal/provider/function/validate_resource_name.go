@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	cosmosValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	mssqlValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	mysqlValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/validate"
+	postgresValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/validate"
+	serviceBusValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// resourceNameValidators maps the Terraform Resource Type to the function used to validate its `name`
+var resourceNameValidators = map[string]pluginsdk.SchemaValidateFunc{
+	"azurerm_container_registry":         containerValidate.ContainerRegistryName,
+	"azurerm_cosmosdb_account":           cosmosValidate.CosmosAccountName,
+	"azurerm_key_vault":                  keyVaultValidate.VaultName,
+	"azurerm_mssql_server":               mssqlValidate.ValidateMsSqlServerName,
+	"azurerm_mysql_flexible_server":      mysqlValidate.FlexibleServerName,
+	"azurerm_postgresql_flexible_server": postgresValidate.FlexibleServerName,
+	"azurerm_resource_group":             resourcegroups.ValidateName,
+	"azurerm_servicebus_namespace":       serviceBusValidate.NamespaceName,
+	"azurerm_storage_account":            storageValidate.StorageAccountName,
+}
+
+type ValidateResourceNameFunction struct{}
+
+var _ function.Function = ValidateResourceNameFunction{}
+
+func NewValidateResourceNameFunction() function.Function {
+	return &ValidateResourceNameFunction{}
+}
+
+func (a ValidateResourceNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "validate_resource_name"
+}
+
+func (a ValidateResourceNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "validate_resource_name",
+		Description:         "Validates a name against the naming rules the provider applies to the specified resource type",
+		MarkdownDescription: "Validates a name against the naming rules the provider applies to the specified resource type",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         "The Terraform Resource Type, such as azurerm_storage_account",
+				MarkdownDescription: "The Terraform Resource Type, such as `azurerm_storage_account`",
+			},
+			function.StringParameter{
+				Name:                "name",
+				Description:         "The name to validate",
+				MarkdownDescription: "The name to validate",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (a ValidateResourceNameFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var resourceType, name string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &resourceType, &name))
+
+	if response.Error != nil {
+		return
+	}
+
+	validateFunc, ok := resourceNameValidators[resourceType]
+	if !ok {
+		supported := make([]string, 0, len(resourceNameValidators))
+		for k := range resourceNameValidators {
+			supported = append(supported, k)
+		}
+		sort.Strings(supported)
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("the resource type %q is not supported, supported resource types are: %s", resourceType, strings.Join(supported, ", ")))
+		return
+	}
+
+	_, errs := validateFunc(name, "name")
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, len(errs) == 0))
+}
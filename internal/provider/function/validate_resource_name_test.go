@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionValidateResourceName_basic(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testValidateResourceNameOutput(),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("valid_storage_account", "true"),
+					acceptance.TestCheckOutput("invalid_storage_account", "false"),
+					acceptance.TestCheckOutput("valid_key_vault", "true"),
+					acceptance.TestCheckOutput("invalid_key_vault", "false"),
+					acceptance.TestCheckOutput("valid_resource_group", "true"),
+					acceptance.TestCheckOutput("invalid_resource_group", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionValidateResourceName_unsupported(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "azurerm" {
+  features {}
+}
+
+output "test" {
+  value = provider::azurerm::validate_resource_name("azurerm_not_a_resource", "example")
+}
+`,
+				ExpectError: regexp.MustCompile("is not supported"),
+			},
+		},
+	})
+}
+
+func testValidateResourceNameOutput() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "valid_storage_account" {
+  value = provider::azurerm::validate_resource_name("azurerm_storage_account", "examplestorage1")
+}
+
+output "invalid_storage_account" {
+  value = provider::azurerm::validate_resource_name("azurerm_storage_account", "Example-Storage")
+}
+
+output "valid_key_vault" {
+  value = provider::azurerm::validate_resource_name("azurerm_key_vault", "example-keyvault")
+}
+
+output "invalid_key_vault" {
+  value = provider::azurerm::validate_resource_name("azurerm_key_vault", "example_keyvault_with_a_very_long_name")
+}
+
+output "valid_resource_group" {
+  value = provider::azurerm::validate_resource_name("azurerm_resource_group", "example-resources")
+}
+
+output "invalid_resource_group" {
+  value = provider::azurerm::validate_resource_name("azurerm_resource_group", "example-resources.")
+}
+`
+}
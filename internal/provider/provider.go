@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -103,7 +104,13 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 
 		logEntry("[DEBUG] Registering Resources for %q..", service.Name())
-		for _, r := range service.Resources() {
+		typedResources := service.Resources()
+		if v, ok := service.(sdk.TypedServiceRegistrationWithFrameworkResources); ok && !features.FourPointOhBeta() {
+			// the Plugin Framework Provider is only served (muxed alongside the Plugin SDK Provider) when 4.0 Beta
+			// is enabled, until then any Resources served natively by the Plugin Framework are served from here
+			typedResources = append(typedResources, v.FrameworkResources()...)
+		}
+		for _, r := range typedResources {
 			key := r.ResourceType()
 			if existing := resources[key]; existing != nil {
 				panic(fmt.Sprintf("An existing Resource exists for %q", key))
@@ -135,10 +142,17 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				panic(fmt.Sprintf("An existing Resource exists for %q", k))
 			}
 
+			// Typed Resources are traced by the ResourceWrapper
+			sdk.WrapResourceWithTracing(k, v)
 			resources[k] = v
 		}
 	}
 
+	// finally configure each taggable Resource to support the Provider-level `default_tags` and `ignore_tags`
+	for _, r := range resources {
+		sdk.WrapResourceWithProviderTags(r)
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -321,6 +335,16 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"default_tags": schemaDefaultTags(),
+
+			"ignore_tags": schemaIgnoreTags(),
+
+			"default_timeouts": schemaDefaultTimeouts(),
+
+			"retry": schemaRetry(),
+
+			"rate_limit": schemaRateLimit(),
+
 			// Advanced feature flags
 			"resource_provider_registrations": {
 				Type:        schema.TypeString,
@@ -518,10 +542,14 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		Features:                    expandFeatures(d.Get("features").([]interface{})),
 		MetadataHost:                d.Get("metadata_host").(string),
 		PartnerID:                   d.Get("partner_id").(string),
+		RateLimit:                   expandRateLimitOptions(d.Get("rate_limit").([]interface{})),
 		RegisteredResourceProviders: requiredResourceProviders,
+		Retry:                       expandRetryOptions(d.Get("retry").([]interface{})),
 		StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
 		SubscriptionID:              d.Get("subscription_id").(string),
+		TagsConfig:                  expandTagsConfig(d.Get("default_tags").([]interface{}), d.Get("ignore_tags").([]interface{})),
 		TerraformVersion:            p.TerraformVersion,
+		TimeoutsConfig:              expandDefaultTimeouts(d.Get("default_timeouts").([]interface{})),
 
 		// this field is intentionally not exposed in the provider block, since it's only used for
 		// platform level tracing
@@ -541,6 +569,9 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 
 	client.StopContext = stopCtx
 
+	// Terraform determines the timeouts for each Resource when planning, which happens once the Provider is configured
+	timeouts.ApplyDefaults(p.ResourcesMap, client.TimeoutsConfig)
+
 	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
 
 	ctx2, cancel := context.WithTimeout(ctx, 30*time.Minute)
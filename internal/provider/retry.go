@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func schemaRetry() *pluginsdk.Schema {
+	defaults := common.DefaultRetryOptions()
+
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The Retry Policy applied to requests sent to Azure which fail with one of the configured HTTP Status Codes, in addition to the retries performed by the underlying SDKs.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"max_attempts": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      defaults.MaxAttempts,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"min_backoff_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      int(defaults.MinBackoff.Seconds()),
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+
+				"max_backoff_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      int(defaults.MaxBackoff.Seconds()),
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+
+				"honour_retry_after": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  defaults.HonourRetryAfter,
+				},
+
+				"status_code_rule": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"status_code": {
+								Type:         pluginsdk.TypeInt,
+								Required:     true,
+								ValidateFunc: common.ValidateRetryStatusCode,
+							},
+
+							"max_attempts": {
+								Type:         pluginsdk.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaRateLimit() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "A client-side Rate Limit applied to requests sent to the Subscription, which is shared between all Provider instances using the same Subscription.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"requests_per_second": {
+					Type:         pluginsdk.TypeFloat,
+					Required:     true,
+					ValidateFunc: validation.FloatAtLeast(0.01),
+				},
+
+				"burst": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func expandRetryOptions(input []interface{}) *common.RetryOptions {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	options := common.DefaultRetryOptions()
+	options.MaxAttempts = raw["max_attempts"].(int)
+	options.MinBackoff = time.Duration(raw["min_backoff_in_seconds"].(int)) * time.Second
+	options.MaxBackoff = time.Duration(raw["max_backoff_in_seconds"].(int)) * time.Second
+	options.HonourRetryAfter = raw["honour_retry_after"].(bool)
+
+	for _, item := range raw["status_code_rule"].([]interface{}) {
+		if item == nil {
+			continue
+		}
+		rule := item.(map[string]interface{})
+		// an unset `max_attempts` is `0`, which uses the `max_attempts` from the `retry` block
+		options.StatusCodeRules[rule["status_code"].(int)] = rule["max_attempts"].(int)
+	}
+
+	return &options
+}
+
+func expandRateLimitOptions(input []interface{}) *common.RateLimitOptions {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &common.RateLimitOptions{
+		RequestsPerSecond: raw["requests_per_second"].(float64),
+		Burst:             raw["burst"].(int),
+	}
+}
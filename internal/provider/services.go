@@ -230,6 +230,41 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 	return services
 }
 
+// SupportedTypedFrameworkResources returns the Typed Resources which are served natively by the
+// Plugin Framework Provider, rather than the Plugin SDK Provider, when 4.0 Beta is enabled.
+func SupportedTypedFrameworkResources() []sdk.Resource {
+	out := make([]sdk.Resource, 0)
+	for _, service := range SupportedTypedServices() {
+		if v, ok := service.(sdk.TypedServiceRegistrationWithFrameworkResources); ok {
+			out = append(out, v.FrameworkResources()...)
+		}
+	}
+	return out
+}
+
+// TypedResourcesForService returns all of the Typed Resources supported by the Service `service`, regardless
+// of whether these are served by the Plugin SDK Provider or the Plugin Framework Provider.
+func TypedResourcesForService(service sdk.TypedServiceRegistration) []sdk.Resource {
+	out := service.Resources()
+	if v, ok := service.(sdk.TypedServiceRegistrationWithFrameworkResources); ok {
+		out = append(out, v.FrameworkResources()...)
+	}
+	return out
+}
+
+func SupportedFrameworkServices() []sdk.FrameworkServiceRegistration {
+	return []sdk.FrameworkServiceRegistration{
+		appservice.Registration{},
+		cdn.Registration{},
+		compute.Registration{},
+		containers.Registration{},
+		keyvault.Registration{},
+		network.Registration{},
+		resource.Registration{},
+		storage.Registration{},
+	}
+}
+
 func SupportedUntypedServices() []sdk.UntypedServiceRegistration {
 	return func() []sdk.UntypedServiceRegistration {
 		out := []sdk.UntypedServiceRegistration{
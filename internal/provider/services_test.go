@@ -4,12 +4,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -30,7 +33,7 @@ func TestTypedDataSourcesContainValidModelObjects(t *testing.T) {
 func TestTypedResourcesContainValidModelObjects(t *testing.T) {
 	for _, service := range SupportedTypedServices() {
 		t.Logf("Service %q..", service.Name())
-		for _, resource := range service.Resources() {
+		for _, resource := range TypedResourcesForService(service) {
 			t.Logf("- Resource %q..", resource.ResourceType())
 			obj := resource.ModelObject()
 			if err := sdk.ValidateModelObject(obj); err != nil {
@@ -49,7 +52,7 @@ func TestTypedResourcesContainValidIDParsers(t *testing.T) {
 	// Untyped Resources are checked via TestUntypedResourcesContainImporters
 	for _, service := range SupportedTypedServices() {
 		t.Logf("Service %q..", service.Name())
-		for _, resource := range service.Resources() {
+		for _, resource := range TypedResourcesForService(service) {
 			t.Logf("- Resource %q..", resource.ResourceType())
 			obj := resource.IDValidationFunc()
 			if obj == nil {
@@ -59,6 +62,22 @@ func TestTypedResourcesContainValidIDParsers(t *testing.T) {
 	}
 }
 
+func TestTypedFrameworkResourcesCanBeWrapped(t *testing.T) {
+	// when 4.0 Beta is enabled Resources served by the Plugin Framework Provider must not also be
+	// served by the Plugin SDK Provider, since the Provider Servers are muxed together
+	pluginSdkResources := AzureProvider().ResourcesMap
+	for _, resource := range SupportedTypedFrameworkResources() {
+		t.Logf("- Resource %q..", resource.ResourceType())
+		if _, ok := pluginSdkResources[resource.ResourceType()]; ok && features.FourPointOhBeta() {
+			t.Fatalf("the Resource %q is registered with both the Plugin SDK and the Plugin Framework", resource.ResourceType())
+		}
+
+		if _, err := sdk.NewFrameworkResourceWrapper(resource); err != nil {
+			t.Fatalf("wrapping the Resource %q: %+v", resource.ResourceType(), err)
+		}
+	}
+}
+
 func TestUntypedResourcesContainImporters(t *testing.T) {
 	// Typed Resources are checked via TestTypedResourcesContainValidIDParsers
 	// as if an ID Parser is returned it's automatically used (and it's a required
@@ -97,7 +116,7 @@ func TestResourcesAreNamedConsistently(t *testing.T) {
 				t.Fatalf("the Data Source %q isn't named consistently: %+v", dataSource.ResourceType(), err)
 			}
 		}
-		for _, resource := range service.Resources() {
+		for _, resource := range TypedResourcesForService(service) {
 			if err := validateResourceTypeName(resource.ResourceType()); err != nil {
 				t.Fatalf("the Resource %q isn't named consistently: %+v", resource.ResourceType(), err)
 			}
@@ -120,6 +139,40 @@ func TestResourcesAreNamedConsistently(t *testing.T) {
 	}
 }
 
+func TestFrameworkActionsAreValid(t *testing.T) {
+	ctx := context.TODO()
+	actionTypes := make(map[string]struct{})
+	for _, service := range SupportedFrameworkServices() {
+		v, ok := service.(sdk.FrameworkServiceRegistrationWithActions)
+		if !ok {
+			continue
+		}
+
+		t.Logf("Service %q", service.Name())
+		for _, f := range v.Actions() {
+			a := f()
+
+			metadata := action.MetadataResponse{}
+			a.Metadata(ctx, action.MetadataRequest{}, &metadata)
+			t.Logf("- Action %q..", metadata.TypeName)
+			if err := validateResourceTypeName(metadata.TypeName); err != nil {
+				t.Fatalf("the Action %q isn't named consistently: %+v", metadata.TypeName, err)
+			}
+			if _, exists := actionTypes[metadata.TypeName]; exists {
+				t.Fatalf("an existing Action exists for %q", metadata.TypeName)
+			}
+			actionTypes[metadata.TypeName] = struct{}{}
+
+			schema := action.SchemaResponse{}
+			a.Schema(ctx, action.SchemaRequest{}, &schema)
+			schema.Diagnostics.Append(schema.Schema.ValidateImplementation(ctx)...)
+			if schema.Diagnostics.HasError() {
+				t.Fatalf("validating the Schema for the Action %q: %+v", metadata.TypeName, schema.Diagnostics)
+			}
+		}
+	}
+}
+
 func validateResourceTypeName(resourceType string) error {
 	if strings.ToLower(resourceType) != resourceType {
 		return fmt.Errorf("the resource type must be all lower-case")
@@ -147,7 +200,7 @@ func TestTypedResourcesUsePointersForOptionalProperties(t *testing.T) {
 	}
 	fails := false
 	for _, service := range SupportedTypedServices() {
-		for _, resource := range service.Resources() {
+		for _, resource := range TypedResourcesForService(service) {
 			model := resource.ModelObject()
 			if model == nil {
 				// Note, "base" models have no model object, e.g. roleAssignmentBaseResource
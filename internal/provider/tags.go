@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func schemaDefaultTags() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "A mapping of tags which should be assigned to all taggable Resources managed by this Provider.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": {
+					Type:         pluginsdk.TypeMap,
+					Optional:     true,
+					ValidateFunc: tags.Validate,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaIgnoreTags() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Tags which should be ignored when reading the tags for all taggable Resources managed by this Provider.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"keys": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+
+				"key_prefixes": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandTagsConfig(defaultTags []interface{}, ignoreTags []interface{}) tags.Config {
+	config := tags.Config{}
+
+	if len(defaultTags) > 0 && defaultTags[0] != nil {
+		val := defaultTags[0].(map[string]interface{})
+		if raw, ok := val["tags"].(map[string]interface{}); ok && len(raw) > 0 {
+			config.DefaultTags = make(map[string]string, len(raw))
+			for k, v := range raw {
+				value, _ := tags.TagValueToString(v)
+				config.DefaultTags[k] = value
+			}
+		}
+	}
+
+	if len(ignoreTags) > 0 && ignoreTags[0] != nil {
+		val := ignoreTags[0].(map[string]interface{})
+		if raw, ok := val["keys"].(*pluginsdk.Set); ok {
+			for _, v := range raw.List() {
+				config.IgnoreKeys = append(config.IgnoreKeys, v.(string))
+			}
+		}
+		if raw, ok := val["key_prefixes"].(*pluginsdk.Set); ok {
+			for _, v := range raw.List() {
+				config.IgnoreKeyPrefixes = append(config.IgnoreKeyPrefixes, v.(string))
+			}
+		}
+	}
+
+	return config
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestExpandTagsConfig(t *testing.T) {
+	testData := []struct {
+		Name        string
+		DefaultTags []interface{}
+		IgnoreTags  []interface{}
+		Expected    tags.Config
+	}{
+		{
+			Name:        "Empty Blocks",
+			DefaultTags: []interface{}{},
+			IgnoreTags:  []interface{}{},
+			Expected:    tags.Config{},
+		},
+		{
+			Name: "Default Tags",
+			DefaultTags: []interface{}{
+				map[string]interface{}{
+					"tags": map[string]interface{}{
+						"environment": "production",
+					},
+				},
+			},
+			IgnoreTags: []interface{}{},
+			Expected: tags.Config{
+				DefaultTags: map[string]string{
+					"environment": "production",
+				},
+			},
+		},
+		{
+			Name:        "Ignore Tags",
+			DefaultTags: []interface{}{},
+			IgnoreTags: []interface{}{
+				map[string]interface{}{
+					"keys":         pluginsdk.NewSet(pluginsdk.HashString, []interface{}{"CreatedBy", "CreatedOn"}),
+					"key_prefixes": pluginsdk.NewSet(pluginsdk.HashString, []interface{}{"hidden-"}),
+				},
+			},
+			Expected: tags.Config{
+				IgnoreKeys:        []string{"CreatedBy", "CreatedOn"},
+				IgnoreKeyPrefixes: []string{"hidden-"},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandTagsConfig(testCase.DefaultTags, testCase.IgnoreTags)
+		sort.Strings(result.IgnoreKeys)
+		sort.Strings(result.IgnoreKeyPrefixes)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
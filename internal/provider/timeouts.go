@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func schemaDefaultTimeouts() *pluginsdk.Schema {
+	resourceType := schemaTimeoutValues()
+	resourceType["name"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		Description:  "The Resource Type, which supports `*` wildcards - for example `azurerm_kubernetes_cluster*`.",
+		ValidateFunc: timeouts.ValidateResourceTypePattern,
+	}
+
+	s := schemaTimeoutValues()
+	s["resource_type"] = &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		Description: "The default timeouts for the Resource Types matching `name`, which take precedence over the default timeouts for all Resources.",
+		Elem: &pluginsdk.Resource{
+			Schema: resourceType,
+		},
+	}
+
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The default timeouts used for all Resources managed by this Provider, unless overridden in the `timeouts` block of the Resource.",
+		Elem: &pluginsdk.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func schemaTimeoutValues() map[string]*pluginsdk.Schema {
+	output := make(map[string]*pluginsdk.Schema)
+	for _, operation := range []string{pluginsdk.TimeoutCreate, pluginsdk.TimeoutRead, pluginsdk.TimeoutUpdate, pluginsdk.TimeoutDelete} {
+		output[operation] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Description:  fmt.Sprintf("The default timeout for the %s operation, for example `30m`.", operation),
+			ValidateFunc: timeouts.ValidateDuration,
+		}
+	}
+	return output
+}
+
+func expandDefaultTimeouts(input []interface{}) timeouts.Defaults {
+	if len(input) == 0 || input[0] == nil {
+		return timeouts.Defaults{}
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := timeouts.Defaults{
+		Values: expandTimeoutValues(raw),
+	}
+
+	for _, item := range raw["resource_type"].([]interface{}) {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+		output.ResourceTypes = append(output.ResourceTypes, timeouts.ResourceTypeDefaults{
+			Name:   v["name"].(string),
+			Values: expandTimeoutValues(v),
+		})
+	}
+
+	return output
+}
+
+func expandTimeoutValues(input map[string]interface{}) timeouts.Values {
+	parse := func(key string) *time.Duration {
+		v, ok := input[key].(string)
+		if !ok || v == "" {
+			return nil
+		}
+		// this has already been validated
+		duration, _ := time.ParseDuration(v)
+		return &duration
+	}
+
+	return timeouts.Values{
+		Create: parse(pluginsdk.TimeoutCreate),
+		Read:   parse(pluginsdk.TimeoutRead),
+		Update: parse(pluginsdk.TimeoutUpdate),
+		Delete: parse(pluginsdk.TimeoutDelete),
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func TestExpandDefaultTimeouts(t *testing.T) {
+	duration := func(d time.Duration) *time.Duration {
+		return &d
+	}
+
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected timeouts.Defaults
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: timeouts.Defaults{},
+		},
+		{
+			Name: "Default Timeouts",
+			Input: []interface{}{
+				map[string]interface{}{
+					"create": "1h",
+					"read":   "",
+					"update": "90m",
+					"delete": "",
+					"resource_type": []interface{}{
+						map[string]interface{}{
+							"name":   "azurerm_kubernetes_cluster*",
+							"create": "3h",
+							"read":   "",
+							"update": "",
+							"delete": "2h",
+						},
+					},
+				},
+			},
+			Expected: timeouts.Defaults{
+				Values: timeouts.Values{
+					Create: duration(time.Hour),
+					Update: duration(90 * time.Minute),
+				},
+				ResourceTypes: []timeouts.ResourceTypeDefaults{
+					{
+						Name: "azurerm_kubernetes_cluster*",
+						Values: timeouts.Values{
+							Create: duration(3 * time.Hour),
+							Delete: duration(2 * time.Hour),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandDefaultTimeouts(testCase.Input)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
@@ -6,6 +6,7 @@ package resourceproviders
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 	"sync"
 
@@ -47,6 +48,16 @@ func populateCache(ctx context.Context, client *providers.ProvidersClient, subsc
 	cacheLock.Lock()
 	defer cacheLock.Unlock()
 
+	endpoint := endpointForClient(client)
+	if entry, ok := readDiskCache(endpoint, subscriptionId.SubscriptionId); ok {
+		log.Printf("[DEBUG] Using the cached Resource Providers for %s: %s", subscriptionId, entry)
+		registeredResourceProviders = stringSliceToMap(entry.Registered)
+		unregisteredResourceProviders = stringSliceToMap(entry.Unregistered)
+		providerNames := append(append(make([]string, 0), entry.Registered...), entry.Unregistered...)
+		cachedResourceProviders = &providerNames
+		return nil
+	}
+
 	providers, err := client.ListComplete(ctx, subscriptionId, providers.DefaultListOperationOptions())
 	if err != nil {
 		return fmt.Errorf("listing Resource Providers: %+v", err)
@@ -70,5 +81,29 @@ func populateCache(ctx context.Context, client *providers.ProvidersClient, subsc
 	}
 
 	cachedResourceProviders = &providerNames
+	writeDiskCache(endpoint, subscriptionId.SubscriptionId, registeredResourceProviders, unregisteredResourceProviders)
 	return nil
 }
+
+// markAsRegistered updates the cache once the specified Resource Provider has been registered
+func markAsRegistered(providerName string) {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	if registeredResourceProviders == nil || unregisteredResourceProviders == nil {
+		return
+	}
+	delete(unregisteredResourceProviders, providerName)
+	registeredResourceProviders[providerName] = struct{}{}
+}
+
+// persistCache writes the cached registration state of the Resource Providers to disk
+func persistCache(client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId) {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	if registeredResourceProviders == nil || unregisteredResourceProviders == nil {
+		return
+	}
+	writeDiskCache(endpointForClient(client), subscriptionId.SubscriptionId, registeredResourceProviders, unregisteredResourceProviders)
+}
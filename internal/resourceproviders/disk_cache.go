@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+)
+
+const (
+	// EnvCacheDirectory is the Environment Variable containing the directory which the registration state of the
+	// Resource Providers is cached within, defaulting to a directory within the user's cache directory
+	EnvCacheDirectory = "ARM_RESOURCE_PROVIDER_CACHE_DIR"
+
+	// EnvCacheTTL is the Environment Variable containing how long (e.g. `30m`) the cached registration state of the
+	// Resource Providers is used for, defaulting to an hour - setting this to `0` disables the on-disk cache
+	EnvCacheTTL = "ARM_RESOURCE_PROVIDER_CACHE_TTL"
+)
+
+const defaultDiskCacheTTL = time.Hour
+
+// diskCacheEntry is the on-disk representation of the registration state of the Resource Providers in a Subscription
+type diskCacheEntry struct {
+	Endpoint       string    `json:"endpoint"`
+	SubscriptionId string    `json:"subscription_id"`
+	CachedAt       time.Time `json:"cached_at"`
+	Registered     []string  `json:"registered"`
+	Unregistered   []string  `json:"unregistered"`
+}
+
+// diskCacheTTL returns how long the on-disk cache is valid for, or zero when the on-disk cache is disabled
+func diskCacheTTL() time.Duration {
+	v := strings.TrimSpace(os.Getenv(EnvCacheTTL))
+	if v == "" {
+		return defaultDiskCacheTTL
+	}
+	if v == "0" {
+		return 0
+	}
+
+	ttl, err := time.ParseDuration(v)
+	if err != nil || ttl < 0 {
+		log.Printf("[WARN] ignoring the invalid value %q for `%s`, using the default of %s", v, EnvCacheTTL, defaultDiskCacheTTL)
+		return defaultDiskCacheTTL
+	}
+	return ttl
+}
+
+// diskCachePath returns the path of the cache file for the Subscription within the specified environment (identified
+// by its Resource Manager endpoint) - returning false when the on-disk cache is disabled
+func diskCachePath(endpoint, subscriptionId string) (string, bool) {
+	if diskCacheTTL() == 0 {
+		return "", false
+	}
+
+	directory := os.Getenv(EnvCacheDirectory)
+	if directory == "" {
+		userCacheDirectory, err := os.UserCacheDir()
+		if err != nil {
+			log.Printf("[DEBUG] unable to determine the user cache directory, the Resource Provider cache will not be persisted: %+v", err)
+			return "", false
+		}
+		directory = filepath.Join(userCacheDirectory, "terraform-provider-azurerm", "resource-providers")
+	}
+
+	hash := sha256.Sum256([]byte(strings.ToLower(strings.TrimSuffix(endpoint, "/") + "|" + subscriptionId)))
+	return filepath.Join(directory, hex.EncodeToString(hash[:])+".json"), true
+}
+
+// readDiskCache returns the cached registration state of the Resource Providers, when present and not expired
+func readDiskCache(endpoint, subscriptionId string) (*diskCacheEntry, bool) {
+	path, ok := diskCachePath(endpoint, subscriptionId)
+	if !ok {
+		return nil, false
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[DEBUG] reading the Resource Provider cache from %q: %+v", path, err)
+		}
+		return nil, false
+	}
+
+	var entry diskCacheEntry
+	if err := json.Unmarshal(contents, &entry); err != nil {
+		log.Printf("[DEBUG] parsing the Resource Provider cache from %q: %+v", path, err)
+		return nil, false
+	}
+
+	// the hash of the endpoint and subscription could (in theory) collide, so these are checked too
+	if !strings.EqualFold(entry.Endpoint, endpoint) || !strings.EqualFold(entry.SubscriptionId, subscriptionId) {
+		return nil, false
+	}
+
+	if age := time.Since(entry.CachedAt); age < 0 || age > diskCacheTTL() {
+		log.Printf("[DEBUG] the Resource Provider cache for Subscription %q has expired", subscriptionId)
+		return nil, false
+	}
+
+	return &entry, true
+}
+
+// writeDiskCache persists the registration state of the Resource Providers, errors are logged rather than returned
+// since the cache is an optimisation
+func writeDiskCache(endpoint, subscriptionId string, registered, unregistered map[string]struct{}) {
+	path, ok := diskCachePath(endpoint, subscriptionId)
+	if !ok {
+		return
+	}
+
+	entry := diskCacheEntry{
+		Endpoint:       endpoint,
+		SubscriptionId: subscriptionId,
+		CachedAt:       time.Now().UTC(),
+		Registered:     sortedKeys(registered),
+		Unregistered:   sortedKeys(unregistered),
+	}
+	contents, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[DEBUG] serializing the Resource Provider cache: %+v", err)
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		log.Printf("[DEBUG] creating the Resource Provider cache directory %q: %+v", filepath.Dir(path), err)
+		return
+	}
+
+	// the cache is written to a temporary file which is then renamed, so that concurrent runs never see a partial file
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		log.Printf("[DEBUG] writing the Resource Provider cache to %q: %+v", path, err)
+		return
+	}
+	_, err = file.Write(contents)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		log.Printf("[DEBUG] writing the Resource Provider cache to %q: %+v", path, err)
+	}
+}
+
+// InvalidateDiskCache removes the cached registration state of the Resource Providers for the Subscription within the
+// specified environment (identified by its Resource Manager endpoint), such that it's refreshed on the next run
+func InvalidateDiskCache(endpoint, subscriptionId string) {
+	path, ok := diskCachePath(endpoint, subscriptionId)
+	if !ok {
+		return
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		log.Printf("[DEBUG] removing the Resource Provider cache %q: %+v", path, err)
+		return
+	}
+	log.Printf("[DEBUG] invalidated the Resource Provider cache for Subscription %q", subscriptionId)
+}
+
+// endpointForClient returns the Resource Manager endpoint used by the client, which identifies the environment
+func endpointForClient(client *providers.ProvidersClient) string {
+	if client == nil || client.Client == nil || client.Client.Client == nil {
+		return ""
+	}
+	return client.Client.BaseUri
+}
+
+func sortedKeys(input map[string]struct{}) []string {
+	output := make([]string, 0, len(input))
+	for k := range input {
+		output = append(output, k)
+	}
+	sort.Strings(output)
+	return output
+}
+
+func stringSliceToMap(input []string) map[string]struct{} {
+	output := make(map[string]struct{}, len(input))
+	for _, v := range input {
+		output[v] = struct{}{}
+	}
+	return output
+}
+
+func (e diskCacheEntry) String() string {
+	return fmt.Sprintf("%d registered and %d unregistered Resource Providers cached at %s", len(e.Registered), len(e.Unregistered), e.CachedAt.Format(time.RFC3339))
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+const (
+	testEndpoint       = "https://management.azure.com/"
+	testSubscriptionId = "00000000-0000-0000-0000-000000000000"
+)
+
+func TestDiskCache(t *testing.T) {
+	t.Setenv(EnvCacheDirectory, t.TempDir())
+	t.Setenv(EnvCacheTTL, "")
+
+	if _, ok := readDiskCache(testEndpoint, testSubscriptionId); ok {
+		t.Fatalf("expected no cache entry prior to it being written")
+	}
+
+	registered := stringSliceToMap([]string{"Microsoft.Network", "Microsoft.Compute"})
+	unregistered := stringSliceToMap([]string{"Microsoft.Storage"})
+	writeDiskCache(testEndpoint, testSubscriptionId, registered, unregistered)
+
+	entry, ok := readDiskCache(testEndpoint, testSubscriptionId)
+	if !ok {
+		t.Fatalf("expected a cache entry but didn't get one")
+	}
+	if len(entry.Registered) != 2 || entry.Registered[0] != "Microsoft.Compute" || entry.Registered[1] != "Microsoft.Network" {
+		t.Fatalf("expected the registered Resource Providers to be [Microsoft.Compute Microsoft.Network] but got %+v", entry.Registered)
+	}
+	if len(entry.Unregistered) != 1 || entry.Unregistered[0] != "Microsoft.Storage" {
+		t.Fatalf("expected the unregistered Resource Providers to be [Microsoft.Storage] but got %+v", entry.Unregistered)
+	}
+
+	if _, ok := readDiskCache("https://management.chinacloudapi.cn/", testSubscriptionId); ok {
+		t.Fatalf("expected no cache entry for a different environment")
+	}
+	if _, ok := readDiskCache(testEndpoint, "11111111-1111-1111-1111-111111111111"); ok {
+		t.Fatalf("expected no cache entry for a different subscription")
+	}
+
+	InvalidateDiskCache(testEndpoint, testSubscriptionId)
+	if _, ok := readDiskCache(testEndpoint, testSubscriptionId); ok {
+		t.Fatalf("expected no cache entry after it was invalidated")
+	}
+}
+
+func TestDiskCacheExpired(t *testing.T) {
+	t.Setenv(EnvCacheDirectory, t.TempDir())
+	t.Setenv(EnvCacheTTL, "1h")
+
+	writeDiskCache(testEndpoint, testSubscriptionId, stringSliceToMap([]string{"Microsoft.Compute"}), nil)
+
+	if _, ok := readDiskCache(testEndpoint, testSubscriptionId); !ok {
+		t.Fatalf("expected a cache entry but didn't get one")
+	}
+
+	t.Setenv(EnvCacheTTL, "1ns")
+	time.Sleep(time.Millisecond)
+	if _, ok := readDiskCache(testEndpoint, testSubscriptionId); ok {
+		t.Fatalf("expected the cache entry to have expired")
+	}
+}
+
+func TestDiskCacheDisabled(t *testing.T) {
+	directory := t.TempDir()
+	t.Setenv(EnvCacheDirectory, directory)
+	t.Setenv(EnvCacheTTL, "0")
+
+	writeDiskCache(testEndpoint, testSubscriptionId, stringSliceToMap([]string{"Microsoft.Compute"}), nil)
+
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		t.Fatalf("reading the cache directory: %+v", err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected no files to be written when the cache is disabled but got %d", len(entries))
+	}
+	if _, ok := readDiskCache(testEndpoint, testSubscriptionId); ok {
+		t.Fatalf("expected no cache entry when the cache is disabled")
+	}
+}
+
+func TestDiskCacheTTL(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected time.Duration
+	}{
+		{
+			input:    "",
+			expected: defaultDiskCacheTTL,
+		},
+		{
+			input:    "0",
+			expected: 0,
+		},
+		{
+			input:    "30m",
+			expected: 30 * time.Minute,
+		},
+		{
+			input:    "-5m",
+			expected: defaultDiskCacheTTL,
+		},
+		{
+			input:    "invalid",
+			expected: defaultDiskCacheTTL,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Setenv(EnvCacheTTL, tc.input)
+		if actual := diskCacheTTL(); actual != tc.expected {
+			t.Fatalf("expected %q to be %s but got %s", tc.input, tc.expected, actual)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -17,6 +18,9 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders/custompollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // EnsureRegistered tries to determine whether all requiredRPs are registered in the subscription, and attempts to
@@ -47,26 +51,51 @@ func EnsureRegistered(ctx context.Context, client *providers.ProvidersClient, su
 	}
 
 	log.Printf("[DEBUG] Registering %d Resource Providers", len(*providersToRegister))
-	if err = registerForSubscription(ctx, client, subscriptionId, *providersToRegister); err != nil {
+	err = registerForSubscription(ctx, client, subscriptionId, *providersToRegister)
+
+	// the Resource Providers which were registered are persisted, even if others failed to register
+	persistCache(client, subscriptionId)
+
+	if err != nil {
 		return userError(err)
 	}
 
 	return nil
 }
 
-// registerForSubscription registers the specified Resource Providers in the current Subscription
+// maxConcurrentRegistrations is the maximum number of Resource Providers which are registered concurrently
+const maxConcurrentRegistrations = 10
+
+// registerForSubscription registers the specified Resource Providers in the current Subscription, in parallel
 func registerForSubscription(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, providersToRegister []string) error {
 	errs := &registrationErrors{}
 	var wg sync.WaitGroup
 	wg.Add(len(providersToRegister))
 
+	start := time.Now()
+	total := len(providersToRegister)
+	var completed atomic.Int64
+	semaphore := make(chan struct{}, maxConcurrentRegistrations)
+
 	for _, providerName := range providersToRegister {
 		go func(p string) {
 			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
 			log.Printf("[DEBUG] Registering Resource Provider %q with namespace", p)
-			if err := registerWithSubscription(ctx, client, subscriptionId, p); err != nil {
+			err := registerWithSubscription(ctx, client, subscriptionId, p)
+			if err != nil {
 				errs.append(err)
+			} else {
+				markAsRegistered(p)
+			}
+
+			status := "Registered"
+			if err != nil {
+				status = "Failed to register"
 			}
+			log.Printf("[INFO] %s Resource Provider %q (%d/%d completed after %s)", status, p, completed.Add(1), total, time.Since(start).Round(time.Second))
 		}(providerName)
 	}
 
@@ -79,7 +108,12 @@ func registerForSubscription(ctx context.Context, client *providers.ProvidersCli
 	return nil
 }
 
-func registerWithSubscription(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, providerName string) error {
+func registerWithSubscription(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, providerName string) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "register resource provider", trace.WithAttributes(attribute.String("azurerm.resource_provider", providerName)))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	providerId := providers.NewSubscriptionProviderID(subscriptionId.SubscriptionId, providerName)
 	log.Printf("[DEBUG] Registering %s..", providerId)
 	if resp, err := client.Register(ctx, providerId, providers.ProviderRegistrationRequest{}); err != nil {
@@ -95,8 +129,8 @@ func registerWithSubscription(ctx context.Context, client *providers.ProvidersCl
 
 	log.Printf("[DEBUG] Waiting for %s to finish registering..", providerId)
 	pollerType := custompollers.NewResourceProviderRegistrationPoller(client, providerId)
-	poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
-	if err := poller.PollUntilDone(ctx); err != nil {
+	poller := pollers.NewPoller(tracing.WrapPoller(pollerType), 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+	if err = poller.PollUntilDone(ctx); err != nil {
 		return fmt.Errorf("waiting for %s to be registered: %s", providerId, err)
 	}
 
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceskus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+)
+
+// cachedSkus contains the Compute Resource SKUs available within a Subscription and Location,
+// keyed by `{subscriptionId}/{location}`
+var cachedSkus = make(map[string][]skus.ResourceSku)
+
+var cacheLock = &sync.Mutex{}
+
+// ClearCache removes all of the cached Resource SKUs
+func ClearCache() {
+	cacheLock.Lock()
+	cachedSkus = make(map[string][]skus.ResourceSku)
+	cacheLock.Unlock()
+}
+
+// skusForLocation returns the Compute Resource SKUs available within the specified Subscription and Location,
+// retrieving these from the API the first time they're requested and caching them thereafter.
+func skusForLocation(ctx context.Context, client *skus.SkusClient, subscriptionId commonids.SubscriptionId, loc string) ([]skus.ResourceSku, error) {
+	loc = location.Normalize(loc)
+	key := fmt.Sprintf("%s/%s", subscriptionId.SubscriptionId, loc)
+
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	if v, ok := cachedSkus[key]; ok {
+		return v, nil
+	}
+
+	opts := skus.DefaultResourceSkusListOperationOptions()
+	// by default this API returns every SKU in every Location, so we filter to the Location being validated
+	opts.Filter = pointer.To(fmt.Sprintf("location eq '%s'", loc))
+	resp, err := client.ResourceSkusListComplete(ctx, subscriptionId, opts)
+	if err != nil {
+		return nil, fmt.Errorf("listing the Compute Resource SKUs available in %q: %+v", loc, err)
+	}
+
+	cachedSkus[key] = resp.Items
+	return resp.Items, nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceskus
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+)
+
+type VirtualMachineCapacity struct {
+	// Family is the name of the VM Family which this Size belongs to, for example `standardDSv3Family`
+	// this matches the name of the vCPU quota returned from the Compute Usages API.
+	Family string
+
+	// VCPUs is the number of vCPUs for a single instance of this Size
+	VCPUs int64
+}
+
+// VirtualMachineCapacityForSize returns the VM Family and the number of vCPUs for the specified Virtual Machine Size
+// within the specified Location, using the (cached) Compute Resource SKUs.
+func VirtualMachineCapacityForSize(ctx context.Context, client *skus.SkusClient, subscriptionId string, loc string, size string) (*VirtualMachineCapacity, error) {
+	available, err := skusForLocation(ctx, client, commonids.NewSubscriptionID(subscriptionId), loc)
+	if err != nil {
+		return nil, err
+	}
+
+	return virtualMachineCapacityFromSkus(available, location.Normalize(loc), size)
+}
+
+func virtualMachineCapacityFromSkus(available []skus.ResourceSku, loc string, size string) (*VirtualMachineCapacity, error) {
+	for _, v := range available {
+		if v.ResourceType == nil || !strings.EqualFold(*v.ResourceType, string(ResourceTypeVirtualMachines)) {
+			continue
+		}
+		if v.Name == nil || !strings.EqualFold(*v.Name, size) {
+			continue
+		}
+
+		capacity := VirtualMachineCapacity{
+			Family: pointer.From(v.Family),
+		}
+		for _, c := range pointer.From(v.Capabilities) {
+			if !strings.EqualFold(pointer.From(c.Name), "vCPUs") {
+				continue
+			}
+
+			vCPUs, err := strconv.ParseInt(pointer.From(c.Value), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing the number of vCPUs %q for the Size %q: %+v", pointer.From(c.Value), size, err)
+			}
+			capacity.VCPUs = vCPUs
+		}
+
+		if capacity.Family == "" || capacity.VCPUs == 0 {
+			return nil, fmt.Errorf("the VM Family/number of vCPUs for the Size %q in the location %q could not be determined", size, loc)
+		}
+
+		return &capacity, nil
+	}
+
+	return nil, fmt.Errorf("the Size %q was not found in the location %q", size, loc)
+}
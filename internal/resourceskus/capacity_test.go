@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceskus
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+)
+
+func TestVirtualMachineCapacityFromSkus(t *testing.T) {
+	available := []skus.ResourceSku{
+		{
+			ResourceType: pointer.To("virtualMachines"),
+			Name:         pointer.To("Standard_D2s_v3"),
+			Family:       pointer.To("standardDSv3Family"),
+			Capabilities: &[]skus.ResourceSkuCapabilities{
+				{
+					Name:  pointer.To("MemoryGB"),
+					Value: pointer.To("8"),
+				},
+				{
+					Name:  pointer.To("vCPUs"),
+					Value: pointer.To("2"),
+				},
+			},
+		},
+		{
+			ResourceType: pointer.To("virtualMachines"),
+			Name:         pointer.To("Standard_A1_v2"),
+			Family:       pointer.To("standardAv2Family"),
+		},
+		{
+			ResourceType: pointer.To("disks"),
+			Name:         pointer.To("Premium_LRS"),
+		},
+	}
+
+	testCases := []struct {
+		size     string
+		expected *VirtualMachineCapacity
+	}{
+		{
+			size: "Standard_D2s_v3",
+			expected: &VirtualMachineCapacity{
+				Family: "standardDSv3Family",
+				VCPUs:  2,
+			},
+		},
+		{
+			size: "standard_d2s_v3",
+			expected: &VirtualMachineCapacity{
+				Family: "standardDSv3Family",
+				VCPUs:  2,
+			},
+		},
+		{
+			// no vCPUs capability
+			size: "Standard_A1_v2",
+		},
+		{
+			size: "Premium_LRS",
+		},
+		{
+			size: "Standard_Z9000",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q..", testCase.size)
+
+		actual, err := virtualMachineCapacityFromSkus(available, "westeurope", testCase.size)
+		if testCase.expected == nil {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if *actual != *testCase.expected {
+			t.Fatalf("expected %+v but got %+v", *testCase.expected, *actual)
+		}
+	}
+}
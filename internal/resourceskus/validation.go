@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceskus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// this is only here to aid testing
+var enhancedEnabled = features.EnhancedValidationEnabled()
+
+type ResourceType string
+
+const (
+	ResourceTypeDisks           ResourceType = "disks"
+	ResourceTypeVirtualMachines ResourceType = "virtualMachines"
+)
+
+type Input struct {
+	// ResourceType is the type of Compute Resource SKU being validated
+	ResourceType ResourceType
+
+	// Location is the Azure Region where the resource will be provisioned
+	Location string
+
+	// SkuName is the name of the SKU, for example `Standard_D2s_v3` or `Premium_LRS`
+	SkuName string
+
+	// Zones is the (optional) list of Availability Zones where the resource will be provisioned
+	Zones []string
+}
+
+// EnhancedValidate checks that the SKU is available to this Subscription within the Location (and Availability
+// Zones, where specified) using the Compute Resource SKUs API - such that an unavailable or restricted SKU fails
+// during the plan, rather than part-way through an apply.
+//
+// NOTE: this is best-effort - if Enhanced Validation is disabled, or the Resource SKUs can't be retrieved, we'll
+// fall back to the API validating this during the apply.
+func EnhancedValidate(ctx context.Context, client *skus.SkusClient, subscriptionId string, input Input) error {
+	if !enhancedEnabled || input.Location == "" || input.SkuName == "" {
+		return nil
+	}
+
+	available, err := skusForLocation(ctx, client, commonids.NewSubscriptionID(subscriptionId), input.Location)
+	if err != nil {
+		log.Printf("[DEBUG] %+v - enhanced validation of the SKU %q will be unavailable", err, input.SkuName)
+		return nil
+	}
+
+	return validateSkuAvailability(available, input)
+}
+
+// ShouldValidate returns whether Enhanced Validation is enabled, any of the specified fields have changed and
+// all of them are known at plan time - meaning that the SKU can (and should) be validated.
+func ShouldValidate(diff *pluginsdk.ResourceDiff, keys ...string) bool {
+	if !enhancedEnabled {
+		return false
+	}
+
+	for _, key := range keys {
+		if !diff.NewValueKnown(key) {
+			return false
+		}
+	}
+
+	return diff.HasChanges(keys...)
+}
+
+func validateSkuAvailability(available []skus.ResourceSku, input Input) error {
+	loc := location.Normalize(input.Location)
+
+	var sku *skus.ResourceSku
+	for _, v := range available {
+		if v.ResourceType == nil || !strings.EqualFold(*v.ResourceType, string(input.ResourceType)) {
+			continue
+		}
+		if v.Name == nil || !strings.EqualFold(*v.Name, input.SkuName) {
+			continue
+		}
+
+		sku = pointer.To(v)
+		break
+	}
+	if sku == nil {
+		return fmt.Errorf("the SKU %q is not available in the location %q", input.SkuName, loc)
+	}
+
+	if sku.Restrictions != nil {
+		for _, restriction := range *sku.Restrictions {
+			reason := string(pointer.From(restriction.ReasonCode))
+
+			switch pointer.From(restriction.Type) {
+			case skus.ResourceSkuRestrictionsTypeLocation:
+				restrictedLocations := pointer.From(restriction.Values)
+				if restriction.RestrictionInfo != nil && restriction.RestrictionInfo.Locations != nil {
+					restrictedLocations = append(restrictedLocations, *restriction.RestrictionInfo.Locations...)
+				}
+				for _, v := range restrictedLocations {
+					if location.Normalize(v) == loc {
+						return fmt.Errorf("the SKU %q is restricted in the location %q for this Subscription (reason: %s)", input.SkuName, loc, reason)
+					}
+				}
+
+			case skus.ResourceSkuRestrictionsTypeZone:
+				if restriction.RestrictionInfo == nil || restriction.RestrictionInfo.Zones == nil {
+					continue
+				}
+				if restricted := intersection(input.Zones, *restriction.RestrictionInfo.Zones); len(restricted) > 0 {
+					return fmt.Errorf("the SKU %q is restricted in the Availability Zone(s) %s of the location %q for this Subscription (reason: %s)", input.SkuName, strings.Join(restricted, ", "), loc, reason)
+				}
+			}
+		}
+	}
+
+	if len(input.Zones) > 0 {
+		supportedZones := make([]string, 0)
+		for _, info := range pointer.From(sku.LocationInfo) {
+			if location.Normalize(pointer.From(info.Location)) == loc && info.Zones != nil {
+				supportedZones = append(supportedZones, *info.Zones...)
+			}
+		}
+
+		if len(supportedZones) == 0 {
+			return fmt.Errorf("the SKU %q does not support Availability Zones in the location %q", input.SkuName, loc)
+		}
+
+		unsupported := make([]string, 0)
+		for _, zone := range input.Zones {
+			if len(intersection([]string{zone}, supportedZones)) == 0 {
+				unsupported = append(unsupported, zone)
+			}
+		}
+		if len(unsupported) > 0 {
+			sort.Strings(supportedZones)
+			return fmt.Errorf("the SKU %q does not support the Availability Zone(s) %s in the location %q - supported Availability Zones are %s", input.SkuName, strings.Join(unsupported, ", "), loc, strings.Join(supportedZones, ", "))
+		}
+	}
+
+	return nil
+}
+
+func intersection(input []string, other []string) []string {
+	out := make([]string, 0)
+	for _, v := range input {
+		for _, o := range other {
+			if strings.EqualFold(v, o) {
+				out = append(out, v)
+				break
+			}
+		}
+	}
+	return out
+}
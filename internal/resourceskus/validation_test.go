@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceskus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+func TestEnhancedValidationDisabled(t *testing.T) {
+	enhancedEnabled = false
+	defer func() {
+		enhancedEnabled = features.EnhancedValidationEnabled()
+	}()
+
+	// the client is nil, so this would panic if the API was called
+	err := EnhancedValidate(context.TODO(), nil, "00000000-0000-0000-0000-000000000000", Input{
+		ResourceType: ResourceTypeVirtualMachines,
+		Location:     "West Europe",
+		SkuName:      "Standard_D2s_v3",
+	})
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+}
+
+func TestValidateSkuAvailability(t *testing.T) {
+	available := []skus.ResourceSku{
+		{
+			ResourceType: pointer.To("virtualMachines"),
+			Name:         pointer.To("Standard_D2s_v3"),
+			LocationInfo: &[]skus.ResourceSkuLocationInfo{
+				{
+					Location: pointer.To("westeurope"),
+					Zones:    &[]string{"1", "2", "3"},
+				},
+			},
+		},
+		{
+			ResourceType: pointer.To("virtualMachines"),
+			Name:         pointer.To("Standard_A1_v2"),
+			LocationInfo: &[]skus.ResourceSkuLocationInfo{
+				{
+					Location: pointer.To("westeurope"),
+				},
+			},
+		},
+		{
+			ResourceType: pointer.To("virtualMachines"),
+			Name:         pointer.To("Standard_M416ms_v2"),
+			Restrictions: &[]skus.ResourceSkuRestrictions{
+				{
+					Type:       pointer.To(skus.ResourceSkuRestrictionsTypeLocation),
+					ReasonCode: pointer.To(skus.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription),
+					Values:     &[]string{"westeurope"},
+				},
+			},
+		},
+		{
+			ResourceType: pointer.To("virtualMachines"),
+			Name:         pointer.To("Standard_E2s_v5"),
+			LocationInfo: &[]skus.ResourceSkuLocationInfo{
+				{
+					Location: pointer.To("westeurope"),
+					Zones:    &[]string{"1", "2", "3"},
+				},
+			},
+			Restrictions: &[]skus.ResourceSkuRestrictions{
+				{
+					Type:       pointer.To(skus.ResourceSkuRestrictionsTypeZone),
+					ReasonCode: pointer.To(skus.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription),
+					Values:     &[]string{"westeurope"},
+					RestrictionInfo: &skus.ResourceSkuRestrictionInfo{
+						Locations: &[]string{"westeurope"},
+						Zones:     &[]string{"3"},
+					},
+				},
+			},
+		},
+		{
+			ResourceType: pointer.To("disks"),
+			Name:         pointer.To("Premium_LRS"),
+			LocationInfo: &[]skus.ResourceSkuLocationInfo{
+				{
+					Location: pointer.To("westeurope"),
+					Zones:    &[]string{"1", "2", "3"},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name  string
+		input Input
+		valid bool
+	}{
+		{
+			name: "available",
+			input: Input{
+				ResourceType: ResourceTypeVirtualMachines,
+				Location:     "West Europe",
+				SkuName:      "Standard_D2s_v3",
+			},
+			valid: true,
+		},
+		{
+			name: "available case-insensitively",
+			input: Input{
+				ResourceType: ResourceTypeVirtualMachines,
+				Location:     "westeurope",
+				SkuName:      "standard_d2s_v3",
+			},
+			valid: true,
+		},
+		{
+			name: "available in zones",
+			input: Input{
+				ResourceType: ResourceTypeVirtualMachines,
+				Location:     "West Europe",
+				SkuName:      "Standard_D2s_v3",
+				Zones:        []string{"1", "3"},
+			},
+			valid: true,
+		},
+		{
+			name: "not available",
+			input: Input{
+				ResourceType: ResourceTypeVirtualMachines,
+				Location:     "West Europe",
+				SkuName:      "Standard_Z9000",
+			},
+			valid: false,
+		},
+		{
+			name: "different resource type",
+			input: Input{
+				ResourceType: ResourceTypeDisks,
+				Location:     "West Europe",
+				SkuName:      "Standard_D2s_v3",
+			},
+			valid: false,
+		},
+		{
+			name: "no zone support",
+			input: Input{
+				ResourceType: ResourceTypeVirtualMachines,
+				Location:     "West Europe",
+				SkuName:      "Standard_A1_v2",
+				Zones:        []string{"1"},
+			},
+			valid: false,
+		},
+		{
+			name: "unsupported zone",
+			input: Input{
+				ResourceType: ResourceTypeVirtualMachines,
+				Location:     "West Europe",
+				SkuName:      "Standard_D2s_v3",
+				Zones:        []string{"4"},
+			},
+			valid: false,
+		},
+		{
+			name: "restricted in location",
+			input: Input{
+				ResourceType: ResourceTypeVirtualMachines,
+				Location:     "West Europe",
+				SkuName:      "Standard_M416ms_v2",
+			},
+			valid: false,
+		},
+		{
+			name: "restricted in zone",
+			input: Input{
+				ResourceType: ResourceTypeVirtualMachines,
+				Location:     "West Europe",
+				SkuName:      "Standard_E2s_v5",
+				Zones:        []string{"3"},
+			},
+			valid: false,
+		},
+		{
+			name: "not restricted in other zones",
+			input: Input{
+				ResourceType: ResourceTypeVirtualMachines,
+				Location:     "West Europe",
+				SkuName:      "Standard_E2s_v5",
+				Zones:        []string{"1", "2"},
+			},
+			valid: true,
+		},
+		{
+			name: "disk in zone",
+			input: Input{
+				ResourceType: ResourceTypeDisks,
+				Location:     "West Europe",
+				SkuName:      "Premium_LRS",
+				Zones:        []string{"2"},
+			},
+			valid: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q..", testCase.name)
+
+		err := validateSkuAvailability(available, testCase.input)
+		if testCase.valid && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if !testCase.valid && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// ActionMetadata contains the common fields used by Actions, which are implemented natively using
+// the Terraform Plugin Framework.
+//
+// Actions are invoked either directly (`terraform apply -invoke`) or by a lifecycle event of a
+// resource and perform a day-2 operation (such as restarting a Virtual Machine) - as such they have
+// no State of their own.
+type ActionMetadata struct {
+	// Client is a reference to the Azure Providers Client - providing a typed reference to this object
+	Client *clients.Client
+
+	// SubscriptionId is the Subscription ID which the Provider is configured to use
+	SubscriptionId string
+
+	// TimeoutInvoke is the default timeout which should be used when invoking this Action
+	TimeoutInvoke time.Duration
+}
+
+// Defaults configures the ActionMetadata from the Provider Data passed to the Configure method of
+// an Action, with a default timeout of 30 minutes.
+func (a *ActionMetadata) Defaults(request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.TimeoutInvoke = 30 * time.Minute
+
+	// the Provider Data is nil until the Provider has been configured
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*clients.Client)
+	if !ok {
+		response.Diagnostics.AddError("Client Provider Data Error", fmt.Sprintf("invalid provider data supplied, got %T", request.ProviderData))
+		return
+	}
+
+	a.Client = client
+	if client.Account != nil {
+		a.SubscriptionId = client.Account.SubscriptionId
+	}
+}
+
+// InvokeContext returns a Context for use when invoking an Action, bounded by the TimeoutInvoke
+// for this Action.
+func (a *ActionMetadata) InvokeContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, a.TimeoutInvoke)
+}
+
+// SendProgress reports the progress `message` of an Action to Terraform, which is output to the user
+// whilst the Action is being invoked.
+func (a *ActionMetadata) SendProgress(response *action.InvokeResponse, message string, args ...interface{}) {
+	if response.SendProgress == nil {
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf(message, args...),
+	})
+}
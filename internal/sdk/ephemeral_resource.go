@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+// EphemeralResourceMetadata contains the common fields used by Ephemeral Resources, which are
+// implemented natively using the Terraform Plugin Framework.
+//
+// Ephemeral Resources are opened during each Terraform operation and their results are never
+// persisted into the Plan or State - as such they're useful for exposing secret values.
+type EphemeralResourceMetadata struct {
+	// Client is a reference to the Azure Providers Client - providing a typed reference to this object
+	Client *clients.Client
+
+	// Features contains the features enabled within the Provider block
+	Features features.UserFeatures
+
+	// SubscriptionId is the Subscription ID which the Provider is configured to use
+	SubscriptionId string
+
+	// TimeoutOpen is the default timeout which should be used when opening this Ephemeral Resource
+	TimeoutOpen time.Duration
+}
+
+// Defaults configures the EphemeralResourceMetadata from the Provider Data passed to the
+// Configure method of an Ephemeral Resource, with a default timeout of 5 minutes.
+func (r *EphemeralResourceMetadata) Defaults(request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	r.TimeoutOpen = 5 * time.Minute
+
+	// the Provider Data is nil until the Provider has been configured
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*clients.Client)
+	if !ok {
+		response.Diagnostics.AddError("Client Provider Data Error", fmt.Sprintf("invalid provider data supplied, got %T", request.ProviderData))
+		return
+	}
+
+	r.Client = client
+	r.Features = client.Features
+	if client.Account != nil {
+		r.SubscriptionId = client.Account.SubscriptionId
+	}
+}
+
+// ClientIsConfigured returns whether the Client has been configured, adding an error to `diags` when it hasn't
+// been - for example when the Provider configuration depends on values which aren't known yet.
+func (r *EphemeralResourceMetadata) ClientIsConfigured(diags *diag.Diagnostics) bool {
+	if r.Client != nil {
+		return true
+	}
+
+	diags.AddError("Provider Not Configured", "the Provider hasn't been configured, this can happen when the Provider configuration depends on values which aren't known yet")
+	return false
+}
+
+// OpenContext returns a Context for use when opening an Ephemeral Resource, bounded by the
+// TimeoutOpen for this Ephemeral Resource.
+func (r *EphemeralResourceMetadata) OpenContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, r.TimeoutOpen)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkhelpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type WrappedInt64Default struct {
+	Desc     *string
+	Markdown *string
+	Value    int64
+}
+
+var _ defaults.Int64 = WrappedInt64Default{}
+
+// NewWrappedInt64Default is a helper function to return a new defaults.Int64 implementation for any type that
+// implements the Go int64 type.
+func NewWrappedInt64Default[T ~int64](value T) WrappedInt64Default {
+	return WrappedInt64Default{
+		Value: int64(value),
+	}
+}
+
+func (w WrappedInt64Default) Description(_ context.Context) string {
+	return pointer.From(w.Desc)
+}
+
+func (w WrappedInt64Default) MarkdownDescription(_ context.Context) string {
+	return pointer.From(w.Markdown)
+}
+
+func (w WrappedInt64Default) DefaultInt64(_ context.Context, _ defaults.Int64Request, response *defaults.Int64Response) {
+	d := basetypes.NewInt64Value(w.Value)
+	response.PlanValue = d
+}
+
+// WrappedInt64Validator provides a wrapper for legacy SDKv2 type validations to ease migration to Framework Native
+// The provided function is tested against the value in the configuration (as an `int`) and populates the diagnostics accordingly.
+type WrappedInt64Validator struct {
+	Func         func(v interface{}, k string) (warnings []string, errors []error)
+	Desc         string
+	MarkdownDesc string
+}
+
+func (w WrappedInt64Validator) Description(_ context.Context) string {
+	return w.Desc
+}
+
+func (w WrappedInt64Validator) MarkdownDescription(_ context.Context) string {
+	return w.MarkdownDesc
+}
+
+func (w WrappedInt64Validator) ValidateInt64(_ context.Context, request validator.Int64Request, response *validator.Int64Response) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := int(request.ConfigValue.ValueInt64())
+	path := request.Path.String()
+	warnings, err := w.Func(value, path)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("invalid value for %s", path), fmt.Sprintf("%+v", err))
+		return
+	}
+
+	for _, v := range warnings {
+		response.Diagnostics.Append(diag.NewWarningDiagnostic(fmt.Sprintf("validating %s", path), v))
+	}
+}
+
+var _ validator.Int64 = &WrappedInt64Validator{}
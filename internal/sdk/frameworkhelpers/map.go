@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkhelpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// WrappedMapValidator provides a wrapper for legacy SDKv2 type validations to ease migration to Framework Native
+// The provided function is tested against the whole map (as a `map[string]interface{}`), this simulates the SDKv2
+// behaviour of defining the validation on a `TypeMap` field, for example `tags.Validate`.
+type WrappedMapValidator struct {
+	Func         func(v interface{}, k string) (warnings []string, errors []error)
+	Desc         string
+	MarkdownDesc string
+}
+
+func (w WrappedMapValidator) Description(_ context.Context) string {
+	return w.Desc
+}
+
+func (w WrappedMapValidator) MarkdownDescription(_ context.Context) string {
+	return w.MarkdownDesc
+}
+
+func (w WrappedMapValidator) ValidateMap(ctx context.Context, request validator.MapRequest, response *validator.MapResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	path := request.Path.String()
+
+	switch request.ConfigValue.ElementType(ctx) {
+	case basetypes.StringType{}, types.StringType:
+		items := make(map[string]interface{})
+		for k, v := range request.ConfigValue.Elements() {
+			value, ok := v.(types.String)
+			if !ok {
+				response.Diagnostics.AddError(fmt.Sprintf("unsupported map validation wrapper type for %s", path), fmt.Sprintf("%+v", request.ConfigValue))
+				return
+			}
+			// the map can't be validated until all of the values are known
+			if value.IsUnknown() {
+				return
+			}
+			items[k] = value.ValueString()
+		}
+
+		_, errors := w.Func(items, path)
+		if len(errors) > 0 {
+			response.Diagnostics.AddError(fmt.Sprintf("invalid value for %s", path), fmt.Sprintf("%+v", errors[0]))
+			return
+		}
+
+	default:
+		response.Diagnostics.AddError(fmt.Sprintf("unsupported map validation wrapper type for %s", path), fmt.Sprintf("%+v", request.ConfigValue))
+	}
+}
+
+var _ validator.Map = &WrappedMapValidator{}
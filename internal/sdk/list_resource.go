@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// ListResourceMetadata contains the common fields used by List Resources, which are implemented
+// natively using the Terraform Plugin Framework and used by `terraform query` to discover
+// existing resources which can then be imported.
+type ListResourceMetadata struct {
+	// Client is a reference to the Azure Providers Client - providing a typed reference to this object
+	Client *clients.Client
+
+	// SubscriptionId is the Subscription ID which the Provider is configured to use
+	SubscriptionId string
+
+	// TimeoutList is the default timeout which should be used when listing this resource
+	TimeoutList time.Duration
+
+	// PluginSdkResource is the Plugin SDK Resource registered within the Provider for the managed resource,
+	// which is used to build the Schemas and populate each result
+	PluginSdkResource *pluginsdk.Resource
+}
+
+// ListResourceWithPluginSdkResource is implemented by List Resources where the managed resource is implemented
+// using the Plugin SDK - allowing the Provider to supply the Plugin SDK Resource it has registered (including
+// any fields added by the Provider, such as `tags_all`) rather than the List Resource building it directly.
+type ListResourceWithPluginSdkResource interface {
+	list.ListResource
+
+	// SetPluginSdkResource sets the Plugin SDK Resource registered within the Provider for the managed resource
+	SetPluginSdkResource(resource *pluginsdk.Resource)
+}
+
+// SetPluginSdkResource sets the Plugin SDK Resource registered within the Provider for the managed resource.
+func (r *ListResourceMetadata) SetPluginSdkResource(resource *pluginsdk.Resource) {
+	r.PluginSdkResource = resource
+}
+
+// Defaults configures the ListResourceMetadata from the Provider Data passed to the
+// Configure method of a List Resource, with a default timeout of 30 minutes.
+func (r *ListResourceMetadata) Defaults(request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	r.TimeoutList = 30 * time.Minute
+
+	// the Provider Data is nil until the Provider has been configured
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*clients.Client)
+	if !ok {
+		response.Diagnostics.AddError("Client Provider Data Error", fmt.Sprintf("invalid provider data supplied, got %T", request.ProviderData))
+		return
+	}
+
+	r.Client = client
+	if client.Account != nil {
+		r.SubscriptionId = client.Account.SubscriptionId
+	}
+}
+
+// ListContext returns a Context for use when listing resources, bounded by the TimeoutList
+// for this List Resource.
+func (r *ListResourceMetadata) ListContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, r.TimeoutList)
+}
+
+// ListResourceFilterModel is the model for the `location` and `tags` filters which are common to all List Resources.
+type ListResourceFilterModel struct {
+	Location types.String `tfsdk:"location"`
+	Tags     types.Map    `tfsdk:"tags"`
+}
+
+// ListResourceWithResourceGroupFilterModel is the model for List Resources which can also be filtered
+// by the Resource Group which the resource exists within.
+type ListResourceWithResourceGroupFilterModel struct {
+	ListResourceFilterModel
+	ResourceGroupName types.String `tfsdk:"resource_group_name"`
+}
+
+// ListResourceFilterSchema returns the List Resource Config Schema for the filters defined in
+// ListResourceFilterModel, or ListResourceWithResourceGroupFilterModel when `supportsResourceGroup`
+// is true.
+func ListResourceFilterSchema(supportsResourceGroup bool) schema.Schema {
+	attributes := map[string]schema.Attribute{
+		"location": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return resources within this Azure Region.",
+			Validators: []validator.String{
+				frameworkhelpers.WrappedStringValidator{
+					Func: validation.StringIsNotEmpty,
+				},
+			},
+		},
+
+		"tags": schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: "Only return resources which have all of these Tags assigned with the same values.",
+		},
+	}
+
+	if supportsResourceGroup {
+		attributes["resource_group_name"] = schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return resources within this Resource Group.",
+			Validators: []validator.String{
+				frameworkhelpers.WrappedStringValidator{
+					Func: validation.StringIsNotEmpty,
+				},
+			},
+		}
+	}
+
+	return schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// Matches returns whether a resource in the specified location with the specified tags matches
+// the `location` and `tags` filters. Filtering on the Resource Group is done using the List API.
+func (f ListResourceFilterModel) Matches(ctx context.Context, resourceLocation string, resourceTags *map[string]string) bool {
+	if v := f.Location.ValueString(); v != "" && location.Normalize(v) != location.Normalize(resourceLocation) {
+		return false
+	}
+
+	if f.Tags.IsNull() || f.Tags.IsUnknown() {
+		return true
+	}
+
+	expected := make(map[string]string)
+	if diags := f.Tags.ElementsAs(ctx, &expected, false); diags.HasError() {
+		return false
+	}
+
+	// Tag Keys are case-insensitive in Azure, whereas Tag Values are case-sensitive
+	actual := make(map[string]string)
+	if resourceTags != nil {
+		for key, value := range *resourceTags {
+			actual[strings.ToLower(key)] = value
+		}
+	}
+	for key, value := range expected {
+		if v, ok := actual[strings.ToLower(key)]; !ok || v != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PluginSdkRawV5Schemas populates the RawV5SchemaResponse using the Schema and Identity Schema
+// of the Plugin SDK Resource registered within the Provider, since the managed resource for a
+// List Resource isn't implemented using the Plugin Framework.
+func (r *ListResourceMetadata) PluginSdkRawV5Schemas(ctx context.Context, response *list.RawV5SchemaResponse) {
+	if r.PluginSdkResource == nil {
+		return
+	}
+
+	response.ProtoV5Schema = r.PluginSdkResource.ProtoSchema(ctx)()
+	response.ProtoV5IdentitySchema = r.PluginSdkResource.ProtoIdentitySchema(ctx)()
+}
+
+// PluginSdkListResults returns the Results for a List Resource - where `listFunc` returns the IDs of the
+// resources matching the filters, with each result populated using the Plugin SDK Resource registered
+// within the Provider. The List API is called when Terraform begins consuming the results, bounded by
+// the TimeoutList.
+func (r *ListResourceMetadata) PluginSdkListResults(ctx context.Context, request list.ListRequest, listFunc func(ctx context.Context) ([]resourceids.ResourceId, error)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		if r.PluginSdkResource == nil {
+			result := list.ListResult{}
+			result.Diagnostics.AddError("listing resources", "the Plugin SDK Resource for this List Resource hasn't been registered within the Provider")
+			push(result)
+			return
+		}
+
+		ctx, cancel := r.ListContext(ctx)
+		defer cancel()
+
+		ids, err := listFunc(ctx)
+		if err != nil {
+			result := list.ListResult{}
+			result.Diagnostics.AddError("listing resources", err.Error())
+			push(result)
+			return
+		}
+
+		for i, id := range ids {
+			if request.Limit > 0 && int64(i) >= request.Limit {
+				return
+			}
+
+			if !push(r.PluginSdkListResult(ctx, request, id)) {
+				return
+			}
+		}
+	}
+}
+
+// PluginSdkListResult returns a ListResult for the resource with the ID `id`, populating the
+// Identity and (when requested) the Resource by reading it using the Plugin SDK Resource.
+func (r *ListResourceMetadata) PluginSdkListResult(ctx context.Context, request list.ListRequest, id resourceids.ResourceId) list.ListResult {
+	sdkResource := r.PluginSdkResource
+
+	result := request.NewListResult(ctx)
+	result.DisplayName = id.String()
+
+	identityValues, err := pluginsdk.ResourceIdentityValues(id)
+	if err != nil {
+		result.Diagnostics.AddError("building Resource Identity", err.Error())
+		return result
+	}
+	for key, value := range identityValues {
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(key), value)...)
+	}
+	if result.Diagnostics.HasError() || !request.IncludeResource {
+		return result
+	}
+
+	state, diags := sdkResource.RefreshWithoutUpgrade(ctx, &terraform.InstanceState{ID: id.ID()}, r.Client)
+	if diags.HasError() {
+		for _, d := range diags {
+			result.Diagnostics.AddError(d.Summary, d.Detail)
+		}
+		return result
+	}
+	if state == nil || state.ID == "" {
+		result.Diagnostics.AddWarning("Resource Not Found", fmt.Sprintf("%s was listed but could not be retrieved", id))
+		return result
+	}
+
+	impliedType := sdkResource.CoreConfigSchema().ImpliedType()
+	value, err := state.AttrsAsObjectValue(impliedType)
+	if err != nil {
+		result.Diagnostics.AddError("converting the State", fmt.Sprintf("converting the state for %s: %+v", id, err))
+		return result
+	}
+
+	encoded, err := msgpack.Marshal(value, impliedType)
+	if err != nil {
+		result.Diagnostics.AddError("encoding the State", fmt.Sprintf("encoding the state for %s: %+v", id, err))
+		return result
+	}
+
+	raw, err := (&tfprotov5.DynamicValue{MsgPack: encoded}).Unmarshal(request.ResourceSchema.Type().TerraformType(ctx))
+	if err != nil {
+		result.Diagnostics.AddError("decoding the State", fmt.Sprintf("decoding the state for %s: %+v", id, err))
+		return result
+	}
+	result.Resource.Raw = raw
+
+	return result
+}
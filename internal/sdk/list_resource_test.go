@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestListResourceFilterModel_Matches(t *testing.T) {
+	testData := []struct {
+		name     string
+		filter   ListResourceFilterModel
+		location string
+		tags     *map[string]string
+		expected bool
+	}{
+		{
+			name: "no filters",
+			filter: ListResourceFilterModel{
+				Location: types.StringNull(),
+				Tags:     types.MapNull(types.StringType),
+			},
+			location: "westeurope",
+			expected: true,
+		},
+		{
+			name: "matching location",
+			filter: ListResourceFilterModel{
+				Location: types.StringValue("West Europe"),
+				Tags:     types.MapNull(types.StringType),
+			},
+			location: "westeurope",
+			expected: true,
+		},
+		{
+			name: "different location",
+			filter: ListResourceFilterModel{
+				Location: types.StringValue("West Europe"),
+				Tags:     types.MapNull(types.StringType),
+			},
+			location: "eastus",
+			expected: false,
+		},
+		{
+			name: "matching tags",
+			filter: ListResourceFilterModel{
+				Location: types.StringNull(),
+				Tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"env": types.StringValue("prod"),
+				}),
+			},
+			location: "westeurope",
+			tags: pointer.To(map[string]string{
+				"env":  "prod",
+				"team": "networking",
+			}),
+			expected: true,
+		},
+		{
+			name: "matching tags with a different key casing",
+			filter: ListResourceFilterModel{
+				Location: types.StringNull(),
+				Tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"Env": types.StringValue("prod"),
+				}),
+			},
+			location: "westeurope",
+			tags: pointer.To(map[string]string{
+				"ENV": "prod",
+			}),
+			expected: true,
+		},
+		{
+			name: "different tag value casing",
+			filter: ListResourceFilterModel{
+				Location: types.StringNull(),
+				Tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"env": types.StringValue("prod"),
+				}),
+			},
+			location: "westeurope",
+			tags: pointer.To(map[string]string{
+				"env": "Prod",
+			}),
+			expected: false,
+		},
+		{
+			name: "different tag value",
+			filter: ListResourceFilterModel{
+				Location: types.StringNull(),
+				Tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"env": types.StringValue("prod"),
+				}),
+			},
+			location: "westeurope",
+			tags: pointer.To(map[string]string{
+				"env": "dev",
+			}),
+			expected: false,
+		},
+		{
+			name: "no tags on the resource",
+			filter: ListResourceFilterModel{
+				Location: types.StringNull(),
+				Tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"env": types.StringValue("prod"),
+				}),
+			},
+			location: "westeurope",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := v.filter.Matches(context.TODO(), v.location, v.tags)
+		if actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -108,6 +108,19 @@ type ResourceWithCustomImporter interface {
 	CustomImporter() ResourceRunFunc
 }
 
+// ResourceWithIdentity is an optional interface
+//
+// Resources implementing this interface expose a Resource Identity containing the Subscription ID,
+// Resource Group Name, Name and any Parent Names from the Resource ID - which is populated during
+// Read and allows the resource to be imported using an `identity` object within an `import` block.
+type ResourceWithIdentity interface {
+	Resource
+
+	// Identity returns an instance of the Resource ID Type for this Resource (e.g. `&commonids.ResourceGroupId{}`)
+	// which is used to build the Resource Identity Schema and to parse the Resource ID during Read.
+	Identity() resourceids.ResourceId
+}
+
 // ResourceWithUpdate is an optional interface
 //
 // Notably the Arguments for Resources implementing this interface
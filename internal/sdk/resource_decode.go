@@ -5,8 +5,11 @@ package sdk
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,6 +26,9 @@ import (
 //
 // var person Person
 // if err := metadata.Decode(&person); err != nil { .. }
+//
+// Fields marked as Write-Only (e.g. `tfschema:"password_wo,writeOnly"`) are read from the config
+// rather than the state, since these values are never persisted.
 func (rmd ResourceMetaData) Decode(input interface{}) error {
 	if rmd.ResourceData == nil {
 		return fmt.Errorf("ResourceData was nil")
@@ -47,6 +53,13 @@ type stateRetriever interface {
 	GetOkExists(key string) (interface{}, bool)
 }
 
+// writeOnlyRetriever is implemented by both the ResourceData and ResourceDiff from the Plugin SDK, and
+// allows retrieving the values for Write-Only fields which are only available from the config
+type writeOnlyRetriever interface {
+	GetRawConfig() cty.Value
+	GetRawConfigAt(valPath cty.Path) (cty.Value, diag.Diagnostics)
+}
+
 func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debugLogger Logger) error {
 	if reflect.TypeOf(input).Kind() != reflect.Ptr {
 		return fmt.Errorf("need a pointer")
@@ -64,6 +77,12 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 
 		if structTags != nil {
 			tfschemaValue, valExists := stateRetriever.GetOkExists(structTags.hclPath)
+			if structTags.writeOnly {
+				tfschemaValue, valExists, err = getWriteOnlyValue(stateRetriever, structTags.hclPath, field.Type)
+				if err != nil {
+					return fmt.Errorf("retrieving the Write-Only value for %q: %+v", field.Name, err)
+				}
+			}
 			if !valExists {
 				continue
 			}
@@ -79,6 +98,55 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 	return nil
 }
 
+// getWriteOnlyValue retrieves the value for the top-level Write-Only field at hclPath from the config - since
+// Write-Only values are never available in the plan or state, and as such can't be retrieved via GetOkExists.
+func getWriteOnlyValue(stateRetriever stateRetriever, hclPath string, fieldType reflect.Type) (interface{}, bool, error) {
+	retriever, ok := stateRetriever.(writeOnlyRetriever)
+	if !ok {
+		return nil, false, fmt.Errorf("internal-error: the state retriever %T doesn't support retrieving Write-Only values", stateRetriever)
+	}
+
+	// the config isn't available in all operations (e.g. Read) - in which case there's no value
+	if retriever.GetRawConfig().IsNull() {
+		return nil, false, nil
+	}
+
+	value, diags := retriever.GetRawConfigAt(cty.GetAttrPath(hclPath))
+	if diags.HasError() {
+		return nil, false, fmt.Errorf("retrieving %q from the config: %+v", hclPath, diags)
+	}
+
+	if value.IsNull() || !value.IsKnown() {
+		return nil, false, nil
+	}
+
+	if fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+
+	switch {
+	case value.Type() == cty.String && fieldType.Kind() == reflect.String:
+		return value.AsString(), true, nil
+
+	case value.Type() == cty.Bool && fieldType.Kind() == reflect.Bool:
+		return value.True(), true, nil
+
+	case value.Type() == cty.Number && fieldType.Kind() == reflect.Int64:
+		i, accuracy := value.AsBigFloat().Int64()
+		if accuracy != big.Exact {
+			return nil, false, fmt.Errorf("the value for %q is not a whole number", hclPath)
+		}
+		// GetOkExists returns an int for whole numbers, which setValue expects
+		return int(i), true, nil
+
+	case value.Type() == cty.Number && fieldType.Kind() == reflect.Float64:
+		f, _ := value.AsBigFloat().Float64()
+		return f, true, nil
+	}
+
+	return nil, false, fmt.Errorf("Write-Only fields must be a string, bool, int64 or float64 - got %s for %q", value.Type().FriendlyName(), hclPath)
+}
+
 func setValue(input, tfschemaValue interface{}, index int, fieldName string, debugLogger Logger) (errOut error) {
 	debugLogger.Infof("setting value for %q..", fieldName)
 	defer func() {
@@ -8,10 +8,13 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 type decodeTestData struct {
 	State       map[string]interface{}
+	Config      map[string]cty.Value
 	Input       interface{}
 	Expected    interface{}
 	ExpectError bool
@@ -1035,6 +1038,71 @@ func TestResourceDecode_NestedThreeLevelsDeepMultipleOptionalItems(t *testing.T)
 	}.test(t)
 }
 
+func TestDecode_TopLevelFieldsWriteOnly(t *testing.T) {
+	type WriteOnly struct {
+		Name            string  `tfschema:"name"`
+		Password        string  `tfschema:"password_wo,writeOnly"`
+		PasswordVersion int64   `tfschema:"password_wo_version"`
+		Count           *int64  `tfschema:"count_wo,writeOnly"`
+		Price           float64 `tfschema:"price_wo,writeOnly"`
+		Enabled         *bool   `tfschema:"enabled_wo,writeOnly"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"name": "example",
+			// Write-Only values are always null in the state
+			"password_wo":         "",
+			"password_wo_version": 2,
+		},
+		Config: map[string]cty.Value{
+			"name":                cty.StringVal("example"),
+			"password_wo":         cty.StringVal("s3cr3t!"),
+			"password_wo_version": cty.NumberIntVal(2),
+			"count_wo":            cty.NumberIntVal(3),
+			"price_wo":            cty.NumberFloatVal(129.99),
+			"enabled_wo":          cty.NullVal(cty.Bool),
+		},
+		Input: &WriteOnly{},
+		Expected: &WriteOnly{
+			Name:            "example",
+			Password:        "s3cr3t!",
+			PasswordVersion: 2,
+			Count:           pointer.To(int64(3)),
+			Price:           129.99,
+		},
+	}.test(t)
+}
+
+func TestDecode_TopLevelFieldsWriteOnlyNoConfig(t *testing.T) {
+	type WriteOnly struct {
+		Name     string `tfschema:"name"`
+		Password string `tfschema:"password_wo,writeOnly"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"name": "example",
+		},
+		Input: &WriteOnly{},
+		Expected: &WriteOnly{
+			Name: "example",
+		},
+	}.test(t)
+}
+
+func TestDecode_TopLevelFieldsWriteOnlyInvalidType(t *testing.T) {
+	type WriteOnly struct {
+		Password []string `tfschema:"password_wo,writeOnly"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{},
+		Config: map[string]cty.Value{
+			"password_wo": cty.ListVal([]cty.Value{cty.StringVal("s3cr3t!")}),
+		},
+		Input:       &WriteOnly{},
+		ExpectError: true,
+	}.test(t)
+}
+
 func (testData decodeTestData) test(t *testing.T) {
 	debugLogger := ConsoleLogger{}
 	state := testData.stateWrapper()
@@ -1058,11 +1126,13 @@ func (testData decodeTestData) test(t *testing.T) {
 func (testData decodeTestData) stateWrapper() testDataGetter {
 	return testDataGetter{
 		values: testData.State,
+		config: testData.Config,
 	}
 }
 
 type testDataGetter struct {
 	values map[string]interface{}
+	config map[string]cty.Value
 }
 
 func (td testDataGetter) Get(key string) interface{} {
@@ -1079,3 +1149,18 @@ func (td testDataGetter) GetOkExists(key string) (interface{}, bool) {
 	val, ok := td.values[key]
 	return val, ok
 }
+
+func (td testDataGetter) GetRawConfig() cty.Value {
+	if td.config == nil {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	return cty.ObjectVal(td.config)
+}
+
+func (td testDataGetter) GetRawConfigAt(valPath cty.Path) (cty.Value, diag.Diagnostics) {
+	v, err := valPath.Apply(td.GetRawConfig())
+	if err != nil {
+		return cty.DynamicVal, diag.FromErr(err)
+	}
+	return v, nil
+}
@@ -12,7 +12,8 @@ import (
 
 // Encode will encode the specified object into the Terraform State
 // NOTE: this requires that the object passed in is a pointer and
-// all fields contain `tfschema` struct tags
+// all fields contain `tfschema` struct tags. Fields marked as Write-Only
+// (e.g. `tfschema:"password_wo,writeOnly"`) are never set into the state.
 func (rmd ResourceMetaData) Encode(input interface{}) error {
 	if reflect.TypeOf(input).Kind() != reflect.Ptr {
 		return fmt.Errorf("need a pointer")
@@ -70,6 +71,11 @@ func recurse(objType reflect.Type, objVal reflect.Value, debugLogger Logger) (ou
 				continue
 			}
 
+			if structTags.writeOnly {
+				debugLogger.Infof("The HCL Path %q is marked as Write-Only - skipping", structTags.hclPath)
+				continue
+			}
+
 			switch field.Type.Kind() {
 			case reflect.Int64:
 				iv := fieldVal.Int()
@@ -4,6 +4,9 @@
 package sdk
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -41,6 +44,61 @@ type UntypedServiceRegistration interface {
 	SupportedResources() map[string]*pluginsdk.Resource
 }
 
+// FrameworkServiceRegistration is the interface used for types implemented natively using the
+// Terraform Plugin Framework, which aren't supported by the Plugin SDK.
+//
+// NOTE: the types supported by this Service are exposed by implementing the optional interfaces
+// FrameworkServiceRegistrationWithEphemeralResources, FrameworkServiceRegistrationWithListResources
+// and FrameworkServiceRegistrationWithActions.
+type FrameworkServiceRegistration interface {
+	// Name is the name of this Service
+	Name() string
+
+	// WebsiteCategories returns a list of categories which can be used for the sidebar
+	WebsiteCategories() []string
+}
+
+// FrameworkServiceRegistrationWithEphemeralResources is a superset of FrameworkServiceRegistration
+// allowing a Service to expose Ephemeral Resources.
+type FrameworkServiceRegistrationWithEphemeralResources interface {
+	FrameworkServiceRegistration
+
+	// EphemeralResources returns a list of Ephemeral Resources supported by this Service
+	EphemeralResources() []func() ephemeral.EphemeralResource
+}
+
+// FrameworkServiceRegistrationWithListResources is a superset of FrameworkServiceRegistration
+// allowing a Service to expose List Resources.
+type FrameworkServiceRegistrationWithListResources interface {
+	FrameworkServiceRegistration
+
+	// ListResources returns a list of List Resources supported by this Service
+	ListResources() []func() list.ListResource
+}
+
+// FrameworkServiceRegistrationWithActions is a superset of FrameworkServiceRegistration
+// allowing a Service to expose Actions.
+type FrameworkServiceRegistrationWithActions interface {
+	FrameworkServiceRegistration
+
+	// Actions returns a list of Actions supported by this Service
+	Actions() []func() action.Action
+}
+
+// TypedServiceRegistrationWithFrameworkResources is a superset of TypedServiceRegistration allowing
+// Typed Resources to be served natively by the Plugin Framework Provider (rather than the Plugin SDK)
+// which allows these to opt into functionality that's unavailable in the Plugin SDK.
+//
+// NOTE: Resources returned here must not also be returned from Resources - and must be compatible
+// with NewFrameworkResourceWrapper.
+type TypedServiceRegistrationWithFrameworkResources interface {
+	TypedServiceRegistration
+
+	// FrameworkResources returns a list of Resources supported by this Service which are served
+	// by the Plugin Framework Provider
+	FrameworkResources() []Resource
+}
+
 // TypedServiceRegistrationWithAGitHubLabel is a superset of TypedServiceRegistration allowing
 // a single GitHub Label to be specified that will be automatically applied to any Pull Requests
 // making changes to this package.
@@ -0,0 +1,724 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-cty/cty"
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	frameworkdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	pluginsdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &frameworkResourceWrapper{}
+	_ resource.ResourceWithImportState    = &frameworkResourceWrapper{}
+	_ resource.ResourceWithModifyPlan     = &frameworkResourceWrapper{}
+	_ resource.ResourceWithValidateConfig = &frameworkResourceWrapper{}
+
+	_ resource.ResourceWithIdentity = &frameworkResourceWithIdentityWrapper{}
+)
+
+// NewFrameworkResourceWrapper returns a Plugin Framework Resource for this Resource implementation, allowing a
+// Typed Resource to be served natively by the Plugin Framework Provider.
+//
+// The Create, Read, Update and Delete functions (and as such, the `tfschema` struct tags used by Encode/Decode) are
+// reused as-is, with the Plugin Framework Plan/State being converted to the Plugin SDK's ResourceData - so existing
+// Terraform State remains compatible. An error is returned when the Resource uses functionality which can't
+// be represented using the Plugin Framework, for example Optional and Computed blocks.
+func NewFrameworkResourceWrapper(r Resource) (resource.Resource, error) {
+	if _, ok := r.(ResourceWithStateMigration); ok {
+		return nil, fmt.Errorf("Resource %q implements ResourceWithStateMigration which isn't supported by the Plugin Framework", r.ResourceType())
+	}
+
+	wrapper := NewResourceWrapper(r)
+	pluginSdkResource, err := wrapper.Resource()
+	if err != nil {
+		return nil, fmt.Errorf("creating Wrapper for Resource %q: %+v", r.ResourceType(), err)
+	}
+
+	// the Provider-level `default_tags` and `ignore_tags` are applied in the same way as for the Plugin SDK Provider
+	WrapResourceWithProviderTags(pluginSdkResource)
+
+	frameworkSchema, err := frameworkSchemaFromPluginSdkResource(pluginSdkResource)
+	if err != nil {
+		return nil, fmt.Errorf("building the Plugin Framework Schema for Resource %q: %+v", r.ResourceType(), err)
+	}
+
+	out := &frameworkResourceWrapper{
+		resource:          r,
+		pluginSdkResource: pluginSdkResource,
+		schema:            *frameworkSchema,
+	}
+
+	if v, ok := r.(ResourceWithIdentity); ok {
+		out.identityType = v.Identity()
+		return &frameworkResourceWithIdentityWrapper{
+			frameworkResourceWrapper: out,
+		}, nil
+	}
+
+	return out, nil
+}
+
+// frameworkResourceWrapper serves a Typed Resource through the Plugin Framework, using the Plugin SDK Resource
+// built by the ResourceWrapper to run the Create, Read, Update and Delete functions.
+type frameworkResourceWrapper struct {
+	client            *clients.Client
+	identityType      resourceids.ResourceId
+	pluginSdkResource *pluginsdkschema.Resource
+	resource          Resource
+	schema            schema.Schema
+}
+
+// frameworkResourceWithIdentityWrapper is a frameworkResourceWrapper for a Typed Resource which implements
+// ResourceWithIdentity, since the Plugin Framework requires an Identity Schema to contain at least one field.
+type frameworkResourceWithIdentityWrapper struct {
+	*frameworkResourceWrapper
+}
+
+func (rw *frameworkResourceWrapper) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = rw.resource.ResourceType()
+}
+
+func (rw *frameworkResourceWrapper) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = rw.schema
+}
+
+func (rw *frameworkResourceWithIdentityWrapper) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	attributes := make(map[string]identityschema.Attribute)
+	for key, s := range rw.pluginSdkResource.Identity.SchemaFunc() {
+		attributes[key] = identityschema.StringAttribute{
+			RequiredForImport: s.RequiredForImport,
+			OptionalForImport: s.OptionalForImport,
+		}
+	}
+
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (rw *frameworkResourceWrapper) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	// the Provider Data is nil until the Provider has been configured
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*clients.Client)
+	if !ok {
+		response.Diagnostics.AddError("Client Provider Data Error", fmt.Sprintf("invalid provider data supplied, got %T", request.ProviderData))
+		return
+	}
+
+	rw.client = client
+
+	// the Timeouts defined on the Plugin SDK Resource are used when the `timeouts` block isn't configured - since a
+	// new Wrapper (and Plugin SDK Resource) is built each time, the Provider-level `default_timeouts` can be applied
+	rw.pluginSdkResource.Timeouts = client.TimeoutsConfig.ForResourceType(rw.resource.ResourceType()).ApplyTo(rw.pluginSdkResource.Timeouts)
+}
+
+func (rw *frameworkResourceWrapper) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	config, err := rw.ctyValue(request.Config.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("converting the Config", err.Error())
+		return
+	}
+
+	// validation is performed using the Plugin SDK Schema, which includes the ValidateFunc's, Required fields,
+	// the number of items within blocks and the ConflictsWith/ExactlyOneOf/AtLeastOneOf/RequiredWith rules
+	diags := rw.pluginSdkResource.Validate(rw.resourceConfig(config))
+	appendPluginSdkDiagnostics(config, diags, &response.Diagnostics)
+}
+
+func (rw *frameworkResourceWrapper) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// there's nothing to plan when the resource is being destroyed
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	config, err := rw.ctyValue(request.Config.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("converting the Config", err.Error())
+		return
+	}
+	planned, err := rw.ctyValue(request.Plan.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("converting the Plan", err.Error())
+		return
+	}
+	prior, err := rw.ctyValue(request.State.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("converting the State", err.Error())
+		return
+	}
+
+	priorState, err := rw.pluginSdkResource.ShimInstanceStateFromValue(prior)
+	if err != nil {
+		response.Diagnostics.AddError("converting the State", err.Error())
+		return
+	}
+
+	create := prior.IsNull()
+	if !create {
+		planned, err = rw.retainEquivalentValues(planned, prior, rw.pluginSdkResource.Data(priorState))
+		if err != nil {
+			response.Diagnostics.AddError("planning changes", err.Error())
+			return
+		}
+
+		raw, err := rw.terraformValue(ctx, planned)
+		if err != nil {
+			response.Diagnostics.AddError("converting the Plan", err.Error())
+			return
+		}
+		response.Plan.Raw = raw
+	}
+
+	if create && rw.pluginSdkResource.CustomizeDiff == nil {
+		return
+	}
+
+	// whether the resource needs to be replaced is determined using the Plugin SDK, which also runs the CustomizeDiff
+	diff, err := rw.pluginSdkResource.SimpleDiff(ctx, priorState, rw.resourceConfig(config), rw.client)
+	if err != nil {
+		response.Diagnostics.AddError("planning changes", err.Error())
+		return
+	}
+	if create || diff == nil {
+		return
+	}
+
+	for key, attributeDiff := range diff.Attributes {
+		if attributeDiff != nil && attributeDiff.RequiresNew {
+			response.RequiresReplace.Append(path.Root(strings.Split(key, ".")[0]))
+		}
+	}
+}
+
+func (rw *frameworkResourceWrapper) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	config, err := rw.ctyValue(request.Config.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("converting the Config", err.Error())
+		return
+	}
+	planned, err := rw.ctyValue(request.Plan.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("converting the Plan", err.Error())
+		return
+	}
+
+	prior := cty.NullVal(rw.impliedType())
+	newState, newValue := rw.apply(ctx, prior, planned, config, nil, &response.Diagnostics)
+	rw.setState(ctx, newState, newValue, &response.State, response.Identity, &response.Diagnostics)
+}
+
+func (rw *frameworkResourceWrapper) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	prior, err := rw.ctyValue(request.State.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("converting the State", err.Error())
+		return
+	}
+
+	state, err := rw.pluginSdkResource.ShimInstanceStateFromValue(prior)
+	if err != nil {
+		response.Diagnostics.AddError("converting the State", err.Error())
+		return
+	}
+	state.Identity = identityValues(request.Identity)
+
+	timeouts := pluginsdkschema.ResourceTimeout{}
+	if err := timeouts.ConfigDecode(rw.pluginSdkResource, rw.resourceConfig(prior)); err != nil {
+		response.Diagnostics.AddError("decoding the Timeouts", err.Error())
+		return
+	}
+	if err := timeouts.StateEncode(state); err != nil {
+		response.Diagnostics.AddError("encoding the Timeouts", err.Error())
+		return
+	}
+
+	newState, diags := rw.pluginSdkResource.RefreshWithoutUpgrade(ctx, state, rw.client)
+	appendPluginSdkDiagnostics(prior, diags, &response.Diagnostics)
+	if diags.HasError() {
+		return
+	}
+
+	if newState == nil || newState.ID == "" {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	newValue, err := pluginsdkschema.StateValueFromInstanceState(newState, rw.impliedType())
+	if err != nil {
+		response.Diagnostics.AddError("converting the State", err.Error())
+		return
+	}
+
+	newValue, err = rw.normalizeValue(newValue, prior, rw.pluginSdkResource.Data(newState))
+	if err != nil {
+		response.Diagnostics.AddError("converting the State", err.Error())
+		return
+	}
+
+	rw.setState(ctx, newState, newValue, &response.State, response.Identity, &response.Diagnostics)
+}
+
+func (rw *frameworkResourceWrapper) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	config, err := rw.ctyValue(request.Config.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("converting the Config", err.Error())
+		return
+	}
+	planned, err := rw.ctyValue(request.Plan.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("converting the Plan", err.Error())
+		return
+	}
+	prior, err := rw.ctyValue(request.State.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("converting the State", err.Error())
+		return
+	}
+
+	if _, ok := rw.resource.(ResourceWithUpdate); !ok {
+		// all other fields are ForceNew, so only the `timeouts` block can change - as such there's nothing to update
+		newValue, err := cty.Transform(planned, func(p cty.Path, v cty.Value) (cty.Value, error) {
+			if v.IsKnown() {
+				return v, nil
+			}
+			return p.Apply(prior)
+		})
+		if err != nil {
+			response.Diagnostics.AddError("converting the Plan", err.Error())
+			return
+		}
+
+		raw, err := rw.terraformValue(ctx, newValue)
+		if err != nil {
+			response.Diagnostics.AddError("converting the State", err.Error())
+			return
+		}
+		response.State.Raw = raw
+		return
+	}
+
+	newState, newValue := rw.apply(ctx, prior, planned, config, identityValues(request.Identity), &response.Diagnostics)
+	rw.setState(ctx, newState, newValue, &response.State, response.Identity, &response.Diagnostics)
+}
+
+func (rw *frameworkResourceWrapper) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	prior, err := rw.ctyValue(request.State.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("converting the State", err.Error())
+		return
+	}
+
+	planned := cty.NullVal(rw.impliedType())
+	rw.apply(ctx, prior, planned, planned, identityValues(request.Identity), &response.Diagnostics)
+}
+
+func (rw *frameworkResourceWrapper) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	id := request.ID
+	if id == "" && rw.identityType != nil {
+		subscriptionId := ""
+		if rw.client != nil {
+			subscriptionId = rw.client.DefaultSubscriptionId()
+		}
+
+		var err error
+		id, err = pluginsdk.ResourceIdFromIdentityValues(rw.identityType, identityValues(request.Identity), subscriptionId)
+		if err != nil {
+			response.Diagnostics.AddError("building the Resource ID from the Resource Identity", err.Error())
+			return
+		}
+	}
+
+	// the Importer validates the Resource ID and runs the CustomImporter, if one is defined
+	data := rw.pluginSdkResource.Data(&terraform.InstanceState{
+		ID: id,
+	})
+	results, err := rw.pluginSdkResource.Importer.StateContext(ctx, data, rw.client)
+	if err != nil {
+		response.Diagnostics.AddError("importing the Resource", err.Error())
+		return
+	}
+	if len(results) != 1 {
+		response.Diagnostics.AddError("importing the Resource", fmt.Sprintf("expected 1 resource to be imported but got %d", len(results)))
+		return
+	}
+
+	value, err := results[0].State().AttrsAsObjectValue(rw.impliedType())
+	if err != nil {
+		response.Diagnostics.AddError("converting the State", err.Error())
+		return
+	}
+
+	raw, err := rw.terraformValue(ctx, value)
+	if err != nil {
+		response.Diagnostics.AddError("converting the State", err.Error())
+		return
+	}
+	response.State.Raw = raw
+}
+
+// apply runs the Create, Update or Delete function of the Plugin SDK Resource for the changes between `prior` and
+// `planned` - returning the new State, which is nil when the resource has been deleted.
+func (rw *frameworkResourceWrapper) apply(ctx context.Context, prior, planned, config cty.Value, identity map[string]string, diags *frameworkdiag.Diagnostics) (*terraform.InstanceState, cty.Value) {
+	priorState, err := rw.pluginSdkResource.ShimInstanceStateFromValue(prior)
+	if err != nil {
+		diags.AddError("converting the State", err.Error())
+		return nil, cty.NilVal
+	}
+	priorState.Identity = identity
+
+	timeoutsConfig := config
+	var diff *terraform.InstanceDiff
+	if planned.IsNull() {
+		timeoutsConfig = prior
+		diff = &terraform.InstanceDiff{
+			Attributes: make(map[string]*terraform.ResourceAttrDiff),
+			Meta:       make(map[string]interface{}),
+			Destroy:    true,
+		}
+	} else {
+		diff, err = pluginsdkschema.DiffFromValues(ctx, prior, planned, config, rw.pluginSdkResource)
+		if err != nil {
+			diags.AddError("building the Diff", err.Error())
+			return nil, cty.NilVal
+		}
+		if diff == nil {
+			diff = terraform.NewInstanceDiff()
+		}
+
+		for key, attributeDiff := range diff.Attributes {
+			// replacements are handled by Terraform Core calling Delete and then Create, rather than by the Plugin SDK
+			attributeDiff.RequiresNew = false
+
+			if attributeDiff.NewRemoved {
+				if _, ok := priorState.Attributes[key]; !ok {
+					delete(diff.Attributes, key)
+				}
+			}
+		}
+	}
+	diff.Identity = identity
+
+	timeouts := pluginsdkschema.ResourceTimeout{}
+	if err := timeouts.ConfigDecode(rw.pluginSdkResource, rw.resourceConfig(timeoutsConfig)); err != nil {
+		diags.AddError("decoding the Timeouts", err.Error())
+		return nil, cty.NilVal
+	}
+	if err := timeouts.DiffEncode(diff); err != nil {
+		diags.AddError("encoding the Timeouts", err.Error())
+		return nil, cty.NilVal
+	}
+
+	newState, applyDiags := rw.pluginSdkResource.Apply(ctx, priorState, diff, rw.client)
+	appendPluginSdkDiagnostics(config, applyDiags, diags)
+
+	if planned.IsNull() || newState == nil || newState.ID == "" {
+		return nil, cty.NilVal
+	}
+
+	newValue, err := pluginsdkschema.StateValueFromInstanceState(newState, rw.impliedType())
+	if err != nil {
+		diags.AddError("converting the State", err.Error())
+		return nil, cty.NilVal
+	}
+
+	newValue, err = rw.normalizeValue(newValue, planned, rw.pluginSdkResource.Data(newState))
+	if err != nil {
+		diags.AddError("converting the State", err.Error())
+		return nil, cty.NilVal
+	}
+
+	return newState, newValue
+}
+
+// setState sets the State and Identity for the resource, providing it's not been deleted
+func (rw *frameworkResourceWrapper) setState(ctx context.Context, newState *terraform.InstanceState, newValue cty.Value, state *tfsdk.State, identity *tfsdk.ResourceIdentity, diags *frameworkdiag.Diagnostics) {
+	if newState == nil {
+		return
+	}
+
+	raw, err := rw.terraformValue(ctx, newValue)
+	if err != nil {
+		diags.AddError("converting the State", err.Error())
+		return
+	}
+	state.Raw = raw
+
+	if identity == nil {
+		return
+	}
+	for key, value := range newState.Identity {
+		diags.Append(identity.SetAttribute(ctx, path.Root(key), value)...)
+	}
+}
+
+// normalizeValue returns `value` using the values from `reference` where these are equivalent - for example where
+// the Plugin SDK returns an empty string for a field which is null in the Plan, or a value which differs only by
+// the `StateFunc` or `DiffSuppressFunc` - since the Plugin Framework requires that these are consistent.
+func (rw *frameworkResourceWrapper) normalizeValue(value, reference cty.Value, d *pluginsdkschema.ResourceData) (cty.Value, error) {
+	return cty.Transform(value, func(p cty.Path, v cty.Value) (cty.Value, error) {
+		existing, err := p.Apply(reference)
+		if err != nil || !existing.IsKnown() {
+			return v, nil
+		}
+
+		// the Timeouts aren't persisted by the Plugin SDK
+		if len(p) == 1 && p[0] == (cty.GetAttrStep{Name: pluginsdkschema.TimeoutsConfigKey}) {
+			return existing, nil
+		}
+
+		if existing.RawEquals(v) {
+			return v, nil
+		}
+		if isEmptyCtyValue(existing) && isEmptyCtyValue(v) {
+			return existing, nil
+		}
+		if rw.stringValuesAreEquivalent(p, existing, v, d) {
+			return existing, nil
+		}
+
+		return v, nil
+	})
+}
+
+// retainEquivalentValues returns the Plan `planned` using the existing values from `prior` for the ID and any
+// fields where the difference is suppressed by the `StateFunc` or `DiffSuppressFunc`.
+func (rw *frameworkResourceWrapper) retainEquivalentValues(planned, prior cty.Value, d *pluginsdkschema.ResourceData) (cty.Value, error) {
+	return cty.Transform(planned, func(p cty.Path, v cty.Value) (cty.Value, error) {
+		if len(p) == 1 && p[0] == (cty.GetAttrStep{Name: "id"}) && !v.IsKnown() {
+			return p.Apply(prior)
+		}
+
+		existing, err := p.Apply(prior)
+		if err != nil {
+			return v, nil
+		}
+		if rw.stringValuesAreEquivalent(p, existing, v, d) {
+			return existing, nil
+		}
+
+		return v, nil
+	})
+}
+
+// stringValuesAreEquivalent returns whether the (known, non-null) strings `existing` and `updated` are considered
+// equal by the `StateFunc` or `DiffSuppressFunc` defined in the Plugin SDK Schema for the field at `p`.
+func (rw *frameworkResourceWrapper) stringValuesAreEquivalent(p cty.Path, existing, updated cty.Value, d *pluginsdkschema.ResourceData) bool {
+	for _, v := range []cty.Value{existing, updated} {
+		if !v.IsKnown() || v.IsNull() || !v.Type().Equals(cty.String) {
+			return false
+		}
+	}
+
+	s := pluginSdkSchemaAtPath(rw.pluginSdkResource.SchemaMap(), p)
+	if s == nil {
+		return false
+	}
+
+	if s.StateFunc != nil && s.StateFunc(existing.AsString()) == s.StateFunc(updated.AsString()) {
+		return true
+	}
+
+	return s.DiffSuppressFunc != nil && s.DiffSuppressFunc(flatmapKeyFromCtyPath(p), existing.AsString(), updated.AsString(), d)
+}
+
+func (rw *frameworkResourceWrapper) impliedType() cty.Type {
+	return rw.pluginSdkResource.CoreConfigSchema().ImpliedType()
+}
+
+func (rw *frameworkResourceWrapper) resourceConfig(value cty.Value) *terraform.ResourceConfig {
+	return terraform.NewResourceConfigShimmed(value, rw.pluginSdkResource.CoreConfigSchema())
+}
+
+// ctyValue converts the Plugin Framework value `input` into the equivalent value used by the Plugin SDK
+func (rw *frameworkResourceWrapper) ctyValue(input tftypes.Value) (cty.Value, error) {
+	encoded, err := tfprotov5.NewDynamicValue(input.Type(), input)
+	if err != nil {
+		return cty.NilVal, fmt.Errorf("encoding: %+v", err)
+	}
+
+	return ctymsgpack.Unmarshal(encoded.MsgPack, rw.impliedType())
+}
+
+// terraformValue converts the Plugin SDK value `input` into the equivalent value used by the Plugin Framework
+func (rw *frameworkResourceWrapper) terraformValue(ctx context.Context, input cty.Value) (tftypes.Value, error) {
+	encoded, err := ctymsgpack.Marshal(input, rw.impliedType())
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("encoding: %+v", err)
+	}
+
+	return tfprotov5.DynamicValue{MsgPack: encoded}.Unmarshal(rw.schema.Type().TerraformType(ctx))
+}
+
+// identityValues returns the values within the Resource Identity `identity`, which only contains strings
+func identityValues(identity *tfsdk.ResourceIdentity) map[string]string {
+	if identity == nil || identity.Raw.IsNull() || !identity.Raw.IsKnown() {
+		return nil
+	}
+
+	values := make(map[string]tftypes.Value)
+	if err := identity.Raw.As(&values); err != nil {
+		return nil
+	}
+
+	out := make(map[string]string)
+	for key, value := range values {
+		var v *string
+		if err := value.As(&v); err == nil && v != nil {
+			out[key] = *v
+		}
+	}
+	return out
+}
+
+// appendPluginSdkDiagnostics appends the Plugin SDK Diagnostics `input` to `output`, where `root` is the value
+// the Attribute Paths within the Diagnostics refer to.
+func appendPluginSdkDiagnostics(root cty.Value, input diag.Diagnostics, output *frameworkdiag.Diagnostics) {
+	for _, d := range input {
+		attributePath := frameworkPathFromCtyPath(root, d.AttributePath)
+		switch {
+		case d.Severity == diag.Error && len(attributePath.Steps()) > 0:
+			output.AddAttributeError(attributePath, d.Summary, d.Detail)
+		case d.Severity == diag.Error:
+			output.AddError(d.Summary, d.Detail)
+		case len(attributePath.Steps()) > 0:
+			output.AddAttributeWarning(attributePath, d.Summary, d.Detail)
+		default:
+			output.AddWarning(d.Summary, d.Detail)
+		}
+	}
+}
+
+// frameworkPathFromCtyPath converts the path `input` within `root` into a Plugin Framework Path - since the
+// elements of a Set can't be referenced by their index, the path to the Set is returned for these.
+func frameworkPathFromCtyPath(root cty.Value, input cty.Path) path.Path {
+	out := path.Empty()
+	current := root
+	for _, step := range input {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			out = out.AtName(s.Name)
+
+		case cty.IndexStep:
+			switch {
+			case current.Type().IsListType() && s.Key.Type().Equals(cty.Number):
+				index, _ := s.Key.AsBigFloat().Int64()
+				out = out.AtListIndex(int(index))
+			case current.Type().IsMapType() && s.Key.Type().Equals(cty.String):
+				out = out.AtMapKey(s.Key.AsString())
+			default:
+				return out
+			}
+		}
+
+		next, err := step.Apply(current)
+		if err != nil {
+			return out
+		}
+		current = next
+	}
+
+	return out
+}
+
+// flatmapKeyFromCtyPath returns the key used by the Plugin SDK for the field at `input`, for example `block.0.name`
+func flatmapKeyFromCtyPath(input cty.Path) string {
+	components := make([]string, 0)
+	for _, step := range input {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			components = append(components, s.Name)
+
+		case cty.IndexStep:
+			switch {
+			case s.Key.Type().Equals(cty.Number):
+				index, _ := s.Key.AsBigFloat().Int64()
+				components = append(components, strconv.FormatInt(index, 10))
+			case s.Key.Type().Equals(cty.String):
+				components = append(components, s.Key.AsString())
+			}
+		}
+	}
+
+	return strings.Join(components, ".")
+}
+
+// pluginSdkSchemaAtPath returns the Plugin SDK Schema for the field at `input`, or nil if it can't be found
+func pluginSdkSchemaAtPath(schemaMap map[string]*pluginsdkschema.Schema, input cty.Path) *pluginsdkschema.Schema {
+	var current *pluginsdkschema.Schema
+	for _, step := range input {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			if schemaMap == nil {
+				return nil
+			}
+
+			current = schemaMap[s.Name]
+			if current == nil {
+				return nil
+			}
+
+			schemaMap = nil
+			if nested, ok := current.Elem.(*pluginsdkschema.Resource); ok {
+				schemaMap = nested.SchemaMap()
+			}
+
+		case cty.IndexStep:
+			if current == nil {
+				return nil
+			}
+
+			if elem, ok := current.Elem.(*pluginsdkschema.Schema); ok {
+				current = elem
+			}
+		}
+	}
+
+	return current
+}
+
+// isEmptyCtyValue returns whether `input` is null or the zero value for its type, which the Plugin SDK
+// doesn't distinguish between.
+func isEmptyCtyValue(input cty.Value) bool {
+	if input.IsNull() {
+		return true
+	}
+	if !input.IsKnown() {
+		return false
+	}
+
+	t := input.Type()
+	switch {
+	case t.Equals(cty.String):
+		return input.AsString() == ""
+	case t.Equals(cty.Bool):
+		return input.False()
+	case t.Equals(cty.Number):
+		return input.RawEquals(cty.Zero)
+	case t.IsListType() || t.IsSetType() || t.IsMapType():
+		return input.LengthInt() == 0
+	}
+
+	return false
+}
@@ -0,0 +1,371 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func TestFrameworkResourceWrapper_Schema(t *testing.T) {
+	ctx := context.TODO()
+	wrapper := newTestFrameworkResourceWrapper(t, &frameworkWrapperTestResource{})
+
+	response := resource.SchemaResponse{}
+	wrapper.Schema(ctx, resource.SchemaRequest{}, &response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %+v", response.Diagnostics)
+	}
+
+	for key, expected := range map[string]struct {
+		required bool
+		optional bool
+		computed bool
+	}{
+		"id":     {computed: true},
+		"name":   {required: true},
+		"value":  {optional: true},
+		"output": {computed: true},
+	} {
+		attribute, ok := response.Schema.Attributes[key]
+		if !ok {
+			t.Fatalf("expected the attribute %q to be present", key)
+		}
+		if attribute.IsRequired() != expected.required || attribute.IsOptional() != expected.optional || attribute.IsComputed() != expected.computed {
+			t.Fatalf("expected %q to be Required %t / Optional %t / Computed %t but got %t / %t / %t", key, expected.required, expected.optional, expected.computed, attribute.IsRequired(), attribute.IsOptional(), attribute.IsComputed())
+		}
+	}
+
+	if _, ok := response.Schema.Blocks["timeouts"]; !ok {
+		t.Fatalf("expected the `timeouts` block to be present")
+	}
+}
+
+func TestFrameworkResourceWrapper_Lifecycle(t *testing.T) {
+	ctx := context.TODO()
+	r := &frameworkWrapperTestResource{}
+	wrapper := newTestFrameworkResourceWrapper(t, r)
+	s := wrapper.schema
+	objectType := s.Type().TerraformType(ctx)
+
+	// Create
+	planned := testFrameworkWrapperValue(objectType, "group1", tftypes.NewValue(tftypes.String, "first"), tftypes.NewValue(tftypes.String, tftypes.UnknownValue))
+	createResponse := resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	wrapper.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: planned},
+		Plan:   tfsdk.Plan{Schema: s, Raw: planned},
+	}, &createResponse)
+	if createResponse.Diagnostics.HasError() {
+		t.Fatalf("creating: %+v", createResponse.Diagnostics)
+	}
+	assertFrameworkWrapperStateValue(t, createResponse.State, "id", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1")
+	assertFrameworkWrapperStateValue(t, createResponse.State, "output", "first-output")
+
+	// Update
+	prior := createResponse.State.Raw
+	updated := testFrameworkWrapperValue(objectType, "group1", tftypes.NewValue(tftypes.String, "second"), tftypes.NewValue(tftypes.String, tftypes.UnknownValue))
+	updateResponse := resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: prior},
+	}
+	wrapper.Update(ctx, resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: updated},
+		Plan:   tfsdk.Plan{Schema: s, Raw: updated},
+		State:  tfsdk.State{Schema: s, Raw: prior},
+	}, &updateResponse)
+	if updateResponse.Diagnostics.HasError() {
+		t.Fatalf("updating: %+v", updateResponse.Diagnostics)
+	}
+	assertFrameworkWrapperStateValue(t, updateResponse.State, "output", "second-output")
+
+	// Plan a change requiring replacement
+	replaced := testFrameworkWrapperValue(objectType, "group2", tftypes.NewValue(tftypes.String, "second"), tftypes.NewValue(tftypes.String, "second-output"))
+	modifyPlanResponse := resource.ModifyPlanResponse{
+		Plan: tfsdk.Plan{Schema: s, Raw: replaced},
+	}
+	wrapper.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: s, Raw: replaced},
+		Plan:   tfsdk.Plan{Schema: s, Raw: replaced},
+		State:  tfsdk.State{Schema: s, Raw: updateResponse.State.Raw},
+	}, &modifyPlanResponse)
+	if modifyPlanResponse.Diagnostics.HasError() {
+		t.Fatalf("planning: %+v", modifyPlanResponse.Diagnostics)
+	}
+	if !modifyPlanResponse.RequiresReplace.Contains(path.Root("name")) {
+		t.Fatalf("expected `name` to require replacement but got %+v", modifyPlanResponse.RequiresReplace)
+	}
+
+	// Delete, and then confirm that a Read removes the resource from the State
+	current := updateResponse.State.Raw
+	deleteResponse := resource.DeleteResponse{
+		State: tfsdk.State{Schema: s, Raw: current},
+	}
+	wrapper.Delete(ctx, resource.DeleteRequest{
+		State: tfsdk.State{Schema: s, Raw: current},
+	}, &deleteResponse)
+	if deleteResponse.Diagnostics.HasError() {
+		t.Fatalf("deleting: %+v", deleteResponse.Diagnostics)
+	}
+
+	readResponse := resource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: current},
+	}
+	wrapper.Read(ctx, resource.ReadRequest{
+		State: tfsdk.State{Schema: s, Raw: current},
+	}, &readResponse)
+	if readResponse.Diagnostics.HasError() {
+		t.Fatalf("reading: %+v", readResponse.Diagnostics)
+	}
+	if !readResponse.State.Raw.IsNull() {
+		t.Fatalf("expected the resource to be removed from the State")
+	}
+}
+
+func TestFrameworkResourceWrapper_ValidateConfig(t *testing.T) {
+	ctx := context.TODO()
+	wrapper := newTestFrameworkResourceWrapper(t, &frameworkWrapperTestResource{})
+	s := wrapper.schema
+	objectType := s.Type().TerraformType(ctx)
+
+	config := testFrameworkWrapperValue(objectType, "", tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, nil))
+	response := resource.ValidateConfigResponse{}
+	wrapper.ValidateConfig(ctx, resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: s, Raw: config},
+	}, &response)
+	if !response.Diagnostics.HasError() {
+		t.Fatalf("expected an error for an empty `name`")
+	}
+}
+
+func TestFrameworkResourceWrapper_DefaultTimeouts(t *testing.T) {
+	wrapped, err := NewFrameworkResourceWrapper(&frameworkWrapperTestResource{})
+	if err != nil {
+		t.Fatalf("building wrapper: %+v", err)
+	}
+	wrapper := wrapped.(*frameworkResourceWrapper)
+
+	create := time.Hour
+	deleteTimeout := 2 * time.Hour
+	configureResponse := resource.ConfigureResponse{}
+	wrapper.Configure(context.TODO(), resource.ConfigureRequest{
+		ProviderData: &clients.Client{
+			TimeoutsConfig: timeouts.Defaults{
+				Values: timeouts.Values{
+					Create: &create,
+				},
+				ResourceTypes: []timeouts.ResourceTypeDefaults{
+					{
+						Name: "azurerm_framework_wrapper_*",
+						Values: timeouts.Values{
+							Delete: &deleteTimeout,
+						},
+					},
+				},
+			},
+		},
+	}, &configureResponse)
+	if configureResponse.Diagnostics.HasError() {
+		t.Fatalf("configuring: %+v", configureResponse.Diagnostics)
+	}
+
+	actual := wrapper.pluginSdkResource.Timeouts
+	if *actual.Create != create {
+		t.Fatalf("expected the Create timeout to be %s but got %s", create, *actual.Create)
+	}
+	if *actual.Read != 5*time.Minute {
+		t.Fatalf("expected the Read timeout to be %s but got %s", 5*time.Minute, *actual.Read)
+	}
+	if *actual.Delete != deleteTimeout {
+		t.Fatalf("expected the Delete timeout to be %s but got %s", deleteTimeout, *actual.Delete)
+	}
+}
+
+func newTestFrameworkResourceWrapper(t *testing.T, r Resource) *frameworkResourceWrapper {
+	wrapped, err := NewFrameworkResourceWrapper(r)
+	if err != nil {
+		t.Fatalf("building wrapper: %+v", err)
+	}
+
+	wrapper, ok := wrapped.(*frameworkResourceWrapper)
+	if !ok {
+		t.Fatalf("expected a *frameworkResourceWrapper but got %T", wrapped)
+	}
+
+	configureResponse := resource.ConfigureResponse{}
+	wrapper.Configure(context.TODO(), resource.ConfigureRequest{
+		ProviderData: &clients.Client{},
+	}, &configureResponse)
+	if configureResponse.Diagnostics.HasError() {
+		t.Fatalf("configuring: %+v", configureResponse.Diagnostics)
+	}
+
+	return wrapper
+}
+
+func testFrameworkWrapperValue(objectType tftypes.Type, name string, value, output tftypes.Value) tftypes.Value {
+	attributeTypes := objectType.(tftypes.Object).AttributeTypes
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"name":     tftypes.NewValue(tftypes.String, name),
+		"value":    value,
+		"output":   output,
+		"timeouts": tftypes.NewValue(attributeTypes["timeouts"], nil),
+	})
+}
+
+func assertFrameworkWrapperStateValue(t *testing.T, state tfsdk.State, key, expected string) {
+	var actual *string
+	if diags := state.GetAttribute(context.TODO(), path.Root(key), &actual); diags.HasError() {
+		t.Fatalf("retrieving %q: %+v", key, diags)
+	}
+	if actual == nil || *actual != expected {
+		t.Fatalf("expected %q to be %q but got %v", key, expected, actual)
+	}
+}
+
+type frameworkWrapperTestModel struct {
+	Name   string `tfschema:"name"`
+	Value  string `tfschema:"value"`
+	Output string `tfschema:"output"`
+}
+
+// frameworkWrapperTestResource is a Typed Resource which stores the Resources in-memory
+type frameworkWrapperTestResource struct {
+	lock  sync.Mutex
+	items map[string]frameworkWrapperTestModel
+}
+
+var _ ResourceWithUpdate = &frameworkWrapperTestResource{}
+
+func (r *frameworkWrapperTestResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"value": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func (r *frameworkWrapperTestResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"output": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r *frameworkWrapperTestResource) ModelObject() interface{} {
+	return &frameworkWrapperTestModel{}
+}
+
+func (r *frameworkWrapperTestResource) ResourceType() string {
+	return "azurerm_framework_wrapper_test"
+}
+
+func (r *frameworkWrapperTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateResourceGroupID
+}
+
+func (r *frameworkWrapperTestResource) Create() ResourceFunc {
+	return ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			var model frameworkWrapperTestModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := commonids.NewResourceGroupID("12345678-1234-9876-4563-123456789012", model.Name)
+			r.put(id.ID(), model)
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r *frameworkWrapperTestResource) Read() ResourceFunc {
+	return ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			id, err := commonids.ParseResourceGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			r.lock.Lock()
+			model, ok := r.items[id.ID()]
+			r.lock.Unlock()
+			if !ok {
+				return metadata.MarkAsGone(id)
+			}
+
+			model.Output = fmt.Sprintf("%s-output", model.Value)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r *frameworkWrapperTestResource) Update() ResourceFunc {
+	return ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			var model frameworkWrapperTestModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			r.put(metadata.ResourceData.Id(), model)
+			return nil
+		},
+	}
+}
+
+func (r *frameworkWrapperTestResource) Delete() ResourceFunc {
+	return ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			r.lock.Lock()
+			defer r.lock.Unlock()
+
+			if !strings.HasPrefix(metadata.ResourceData.Id(), "/subscriptions/") {
+				return fmt.Errorf("unexpected ID %q", metadata.ResourceData.Id())
+			}
+			delete(r.items, metadata.ResourceData.Id())
+			return nil
+		},
+	}
+}
+
+func (r *frameworkWrapperTestResource) put(id string, model frameworkWrapperTestModel) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.items == nil {
+		r.items = make(map[string]frameworkWrapperTestModel)
+	}
+	r.items[id] = model
+}
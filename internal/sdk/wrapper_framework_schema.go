@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	pluginsdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+)
+
+// frameworkSchemaFromPluginSdkResource converts the Plugin SDK Resource `r` into the equivalent Plugin Framework
+// Schema - which uses the same Attributes, Blocks and Types as the Plugin SDK would expose to Terraform Core, so
+// that existing Terraform State can be used by the Plugin Framework without being upgraded.
+//
+// Validation is intentionally not converted, since this is performed using the Plugin SDK Schema (see
+// frameworkResourceWrapper.ValidateConfig) - however fields with a `StateFunc` or `DiffSuppressFunc` are marked as
+// Computed, allowing the Plan to retain the existing value when the difference is suppressed.
+func frameworkSchemaFromPluginSdkResource(r *pluginsdkschema.Resource) (*schema.Schema, error) {
+	attributes, blocks, err := frameworkAttributesAndBlocksFromPluginSdkSchema(r.SchemaMap())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := attributes["id"]; !ok {
+		attributes["id"] = schema.StringAttribute{
+			Computed: true,
+		}
+	}
+
+	if r.Timeouts != nil {
+		timeouts := make(map[string]schema.Attribute)
+		for key, enabled := range map[string]bool{
+			pluginsdkschema.TimeoutCreate:  r.Timeouts.Create != nil,
+			pluginsdkschema.TimeoutRead:    r.Timeouts.Read != nil,
+			pluginsdkschema.TimeoutUpdate:  r.Timeouts.Update != nil,
+			pluginsdkschema.TimeoutDelete:  r.Timeouts.Delete != nil,
+			pluginsdkschema.TimeoutDefault: r.Timeouts.Default != nil,
+		} {
+			if enabled {
+				timeouts[key] = schema.StringAttribute{
+					Optional: true,
+				}
+			}
+		}
+
+		blocks[pluginsdkschema.TimeoutsConfigKey] = schema.SingleNestedBlock{
+			Attributes: timeouts,
+		}
+	}
+
+	return &schema.Schema{
+		Attributes:         attributes,
+		Blocks:             blocks,
+		Description:        r.Description,
+		DeprecationMessage: r.DeprecationMessage,
+		Version:            int64(r.SchemaVersion),
+	}, nil
+}
+
+func frameworkAttributesAndBlocksFromPluginSdkSchema(input map[string]*pluginsdkschema.Schema) (map[string]schema.Attribute, map[string]schema.Block, error) {
+	attributes := make(map[string]schema.Attribute)
+	blocks := make(map[string]schema.Block)
+
+	for key, s := range input {
+		if s.DefaultFunc != nil {
+			return nil, nil, fmt.Errorf("`%s` specifies a DefaultFunc which is not supported", key)
+		}
+
+		nested, isResource := s.Elem.(*pluginsdkschema.Resource)
+		isAttribute := !isResource || s.Type == pluginsdkschema.TypeMap || s.ConfigMode == pluginsdkschema.SchemaConfigModeAttr || (s.ConfigMode != pluginsdkschema.SchemaConfigModeBlock && s.Computed && !s.Optional)
+		if isAttribute {
+			attribute, err := frameworkAttributeFromPluginSdkSchema(s)
+			if err != nil {
+				return nil, nil, fmt.Errorf("converting `%s`: %+v", key, err)
+			}
+			attributes[key] = attribute
+			continue
+		}
+
+		if s.Computed {
+			return nil, nil, fmt.Errorf("`%s` is an Optional and Computed block which is not supported", key)
+		}
+
+		nestedAttributes, nestedBlocks, err := frameworkAttributesAndBlocksFromPluginSdkSchema(nested.SchemaMap())
+		if err != nil {
+			return nil, nil, fmt.Errorf("converting `%s`: %+v", key, err)
+		}
+		object := schema.NestedBlockObject{
+			Attributes: nestedAttributes,
+			Blocks:     nestedBlocks,
+		}
+
+		switch s.Type {
+		case pluginsdkschema.TypeList:
+			blocks[key] = schema.ListNestedBlock{
+				NestedObject:       object,
+				Description:        s.Description,
+				DeprecationMessage: s.Deprecated,
+			}
+		case pluginsdkschema.TypeSet:
+			blocks[key] = schema.SetNestedBlock{
+				NestedObject:       object,
+				Description:        s.Description,
+				DeprecationMessage: s.Deprecated,
+			}
+		default:
+			return nil, nil, fmt.Errorf("`%s` is a block of the unsupported type %s", key, s.Type)
+		}
+	}
+
+	return attributes, blocks, nil
+}
+
+func frameworkAttributeFromPluginSdkSchema(s *pluginsdkschema.Schema) (schema.Attribute, error) {
+	required := s.Required
+	optional := s.Optional
+	computed := s.Computed
+	if s.StateFunc != nil || s.DiffSuppressFunc != nil {
+		// the Plan must match the Config for non-Computed fields, so these are marked as Computed to allow the
+		// existing value to be retained when the values are equivalent - whether a value is Required is validated
+		// using the Plugin SDK Schema
+		required = false
+		optional = true
+		computed = true
+	}
+	if s.Default != nil {
+		// the Plugin Framework requires that fields with a Default are Computed
+		computed = true
+	}
+
+	switch s.Type {
+	case pluginsdkschema.TypeString:
+		attribute := schema.StringAttribute{
+			Required:           required,
+			Optional:           optional,
+			Computed:           computed,
+			Sensitive:          s.Sensitive,
+			WriteOnly:          s.WriteOnly,
+			Description:        s.Description,
+			DeprecationMessage: s.Deprecated,
+		}
+		if v, ok := s.Default.(string); ok {
+			attribute.Default = frameworkhelpers.NewWrappedStringDefault(v)
+		}
+		return attribute, nil
+
+	case pluginsdkschema.TypeBool:
+		attribute := schema.BoolAttribute{
+			Required:           required,
+			Optional:           optional,
+			Computed:           computed,
+			Sensitive:          s.Sensitive,
+			WriteOnly:          s.WriteOnly,
+			Description:        s.Description,
+			DeprecationMessage: s.Deprecated,
+		}
+		if v, ok := s.Default.(bool); ok {
+			attribute.Default = frameworkhelpers.NewWrappedBoolDefault(v)
+		}
+		return attribute, nil
+
+	case pluginsdkschema.TypeInt:
+		attribute := schema.Int64Attribute{
+			Required:           required,
+			Optional:           optional,
+			Computed:           computed,
+			Sensitive:          s.Sensitive,
+			WriteOnly:          s.WriteOnly,
+			Description:        s.Description,
+			DeprecationMessage: s.Deprecated,
+		}
+		if v, ok := s.Default.(int); ok {
+			attribute.Default = frameworkhelpers.NewWrappedInt64Default(int64(v))
+		}
+		return attribute, nil
+
+	case pluginsdkschema.TypeFloat:
+		attribute := schema.Float64Attribute{
+			Required:           required,
+			Optional:           optional,
+			Computed:           computed,
+			Sensitive:          s.Sensitive,
+			WriteOnly:          s.WriteOnly,
+			Description:        s.Description,
+			DeprecationMessage: s.Deprecated,
+		}
+		if v, ok := s.Default.(float64); ok {
+			attribute.Default = frameworkhelpers.NewWrappedFloat64Default(v)
+		}
+		return attribute, nil
+	}
+
+	if s.Default != nil {
+		return nil, fmt.Errorf("a Default is only supported for primitive types")
+	}
+
+	elementType, err := frameworkElementTypeFromPluginSdkSchema(s)
+	if err != nil {
+		return nil, err
+	}
+
+	switch s.Type {
+	case pluginsdkschema.TypeList:
+		return schema.ListAttribute{
+			ElementType:        elementType,
+			Required:           required,
+			Optional:           optional,
+			Computed:           computed,
+			Sensitive:          s.Sensitive,
+			WriteOnly:          s.WriteOnly,
+			Description:        s.Description,
+			DeprecationMessage: s.Deprecated,
+		}, nil
+
+	case pluginsdkschema.TypeSet:
+		return schema.SetAttribute{
+			ElementType:        elementType,
+			Required:           required,
+			Optional:           optional,
+			Computed:           computed,
+			Sensitive:          s.Sensitive,
+			Description:        s.Description,
+			DeprecationMessage: s.Deprecated,
+		}, nil
+
+	case pluginsdkschema.TypeMap:
+		return schema.MapAttribute{
+			ElementType:        elementType,
+			Required:           required,
+			Optional:           optional,
+			Computed:           computed,
+			Sensitive:          s.Sensitive,
+			WriteOnly:          s.WriteOnly,
+			Description:        s.Description,
+			DeprecationMessage: s.Deprecated,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", s.Type)
+}
+
+// frameworkElementTypeFromPluginSdkSchema returns the Element Type for the List, Set or Map `s` - matching the
+// type used by the Plugin SDK, which (for example) treats a Map with a Resource as an Element as a Map of Strings.
+func frameworkElementTypeFromPluginSdkSchema(s *pluginsdkschema.Schema) (attr.Type, error) {
+	switch elem := s.Elem.(type) {
+	case *pluginsdkschema.Schema:
+		return frameworkTypeFromPluginSdkSchema(elem)
+
+	case pluginsdkschema.ValueType:
+		return frameworkTypeFromPluginSdkSchema(&pluginsdkschema.Schema{Type: elem})
+
+	case *pluginsdkschema.Resource:
+		if s.Type == pluginsdkschema.TypeMap {
+			return types.StringType, nil
+		}
+
+		attributeTypes := make(map[string]attr.Type)
+		for key, nested := range elem.SchemaMap() {
+			t, err := frameworkTypeFromPluginSdkSchema(nested)
+			if err != nil {
+				return nil, fmt.Errorf("converting `%s`: %+v", key, err)
+			}
+			attributeTypes[key] = t
+		}
+		return types.ObjectType{AttrTypes: attributeTypes}, nil
+
+	case nil:
+		return types.StringType, nil
+	}
+
+	return nil, fmt.Errorf("unsupported Elem %T", s.Elem)
+}
+
+func frameworkTypeFromPluginSdkSchema(s *pluginsdkschema.Schema) (attr.Type, error) {
+	switch s.Type {
+	case pluginsdkschema.TypeString:
+		return types.StringType, nil
+	case pluginsdkschema.TypeBool:
+		return types.BoolType, nil
+	case pluginsdkschema.TypeInt:
+		return types.Int64Type, nil
+	case pluginsdkschema.TypeFloat:
+		return types.Float64Type, nil
+	}
+
+	elementType, err := frameworkElementTypeFromPluginSdkSchema(s)
+	if err != nil {
+		return nil, err
+	}
+
+	switch s.Type {
+	case pluginsdkschema.TypeList:
+		return types.ListType{ElemType: elementType}, nil
+	case pluginsdkschema.TypeSet:
+		return types.SetType{ElemType: elementType}, nil
+	case pluginsdkschema.TypeMap:
+		return types.MapType{ElemType: elementType}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", s.Type)
+}
@@ -52,24 +52,28 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 
 		CreateContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, rw.logger)
-			err := rw.resource.Create().Func(ctx, metaData)
+			err := traceResourceOperation(ctx, rw.resource.ResourceType(), "Create", d, func(ctx context.Context) error {
+				return rw.resource.Create().Func(ctx, metaData)
+			})
 			if err != nil {
 				return err
 			}
 			// NOTE: whilst this may look like we should use the Read
 			// functions timeout here, we're still /technically/ in the
 			// Create function so reusing that timeout should be sufficient
-			return rw.resource.Read().Func(ctx, metaData)
+			return rw.read(ctx, metaData)
 		}),
 
 		// looks like these could be reused, easiest if they're not
 		ReadContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, rw.logger)
-			return rw.resource.Read().Func(ctx, metaData)
+			return rw.read(ctx, metaData)
 		}),
 		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, rw.logger)
-			return rw.resource.Delete().Func(ctx, metaData)
+			return traceResourceOperation(ctx, rw.resource.ResourceType(), "Delete", d, func(ctx context.Context) error {
+				return rw.resource.Delete().Func(ctx, metaData)
+			})
 		}),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -90,7 +94,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 				for _, err := range errors {
 					out += err.Error()
 				}
-				return fmt.Errorf(out)
+				return fmt.Errorf("%s", out)
 			}
 
 			return nil
@@ -98,9 +102,11 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
 				metaData := runArgs(d, meta, rw.logger)
 
-				ctx, cancel := context.WithTimeout(ctx, rw.resource.Read().Timeout)
+				ctx, cancel := context.WithTimeout(ctx, rw.readTimeout(meta))
 				defer cancel()
-				err := v.CustomImporter()(ctx, metaData)
+				err := traceResourceOperation(ctx, rw.resource.ResourceType(), "Import", d, func(ctx context.Context) error {
+					return v.CustomImporter()(ctx, metaData)
+				})
 				if err != nil {
 					return nil, err
 				}
@@ -118,14 +124,16 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		resource.UpdateContext = rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, rw.logger)
 
-			err := v.Update().Func(ctx, metaData)
+			err := traceResourceOperation(ctx, rw.resource.ResourceType(), "Update", d, func(ctx context.Context) error {
+				return v.Update().Func(ctx, metaData)
+			})
 			if err != nil {
 				return err
 			}
 			// whilst this may look like we should use the Update timeout here
 			// we're still "technically" in the update method, so reusing the
 			// Update's timeout should be fine
-			return rw.resource.Read().Func(ctx, metaData)
+			return rw.read(ctx, metaData)
 		})
 		resource.Timeouts.Update = d(v.Update().Timeout)
 	}
@@ -172,6 +180,11 @@ and we recommend using the %[2]q resource instead.
 `, rw.resource.ResourceType(), replacementResourceType)
 	}
 
+	if v, ok := rw.resource.(ResourceWithIdentity); ok {
+		resource.Identity = pluginsdk.ResourceIdentityForResourceId(v.Identity())
+		resource.Importer = pluginsdk.ImporterValidatingIdentity(v.Identity(), resource.Importer)
+	}
+
 	if v, ok := rw.resource.(ResourceWithStateMigration); ok {
 		stateUpgradeData := v.StateUpgraders()
 		resource.SchemaVersion = stateUpgradeData.SchemaVersion
@@ -182,6 +195,36 @@ and we recommend using the %[2]q resource instead.
 	return &resource, nil
 }
 
+// read calls the Read function for this Resource and then, when the Resource implements ResourceWithIdentity,
+// populates the Resource Identity from the Resource ID.
+func (rw *ResourceWrapper) read(ctx context.Context, metaData ResourceMetaData) error {
+	err := traceResourceOperation(ctx, rw.resource.ResourceType(), "Read", metaData.ResourceData, func(ctx context.Context) error {
+		return rw.resource.Read().Func(ctx, metaData)
+	})
+	if err != nil {
+		return err
+	}
+
+	v, ok := rw.resource.(ResourceWithIdentity)
+	if !ok || metaData.ResourceData.Id() == "" {
+		return nil
+	}
+
+	return pluginsdk.SetResourceIdentityFromId(metaData.ResourceData, v.Identity())
+}
+
+// readTimeout returns the timeout for the Read function, taking into account the Provider-level `default_timeouts`.
+// This is used where Terraform doesn't provide the configured timeouts, such as when importing.
+func (rw *ResourceWrapper) readTimeout(meta interface{}) time.Duration {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		if v := client.TimeoutsConfig.ForResourceType(rw.resource.ResourceType()).Read; v != nil {
+			return *v
+		}
+	}
+
+	return rw.resource.Read().Timeout
+}
+
 func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return diagnosticsWrapper(in, rw.logger)
 }
@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// WrapResourceWithProviderTags configures the specified Resource to support the `default_tags` and
+// `ignore_tags` blocks defined in the Provider block, when the Resource exposes a top-level `tags` field.
+//
+// The Default Tags are merged into the `tags` field prior to the Create/Update functions being called,
+// and then removed again once the Resource has been read - meaning that the `tags` field continues to
+// reflect the user's configuration. The Ignored Tags are removed when reading the Resource, such that
+// Tags added outside of Terraform (for example by Azure Policy) don't show a diff. The effective set of
+// tags is exposed in the Computed field `tags_all`. Since updating the tags replaces them, any Ignored Tags
+// currently assigned to the Resource are carried over prior to the Update function being called.
+//
+// NOTE: Resources which only update the `tags` when `d.HasChange("tags")` is true will only pick up changes
+// to the Default Tags once the `tags` field defined on the Resource has also changed.
+func WrapResourceWithProviderTags(resource *schema.Resource) {
+	if !resourceSupportsProviderTags(resource) {
+		return
+	}
+
+	resource.Schema["tags_all"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeMap,
+		Computed: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
+
+	// this must be obtained prior to the Read function being wrapped, since that removes the Ignored Tags
+	existingTags := existingTagsFunc(resource)
+
+	//nolint:staticcheck
+	supportsUpdate := resource.Update != nil || resource.UpdateContext != nil || resource.UpdateWithoutTimeout != nil
+
+	customizeDiff := resource.CustomizeDiff
+	resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		// Resources which don't support Update can only pick up changes to the Default Tags when recreated
+		if supportsUpdate || d.Id() == "" || d.HasChange("tags") {
+			if !d.NewValueKnown("tags") {
+				if err := d.SetNewComputed("tags_all"); err != nil {
+					return fmt.Errorf("setting `tags_all` as computed: %+v", err)
+				}
+			} else {
+				config := providerTagsConfig(meta)
+				configured := d.Get("tags").(map[string]interface{})
+				all := config.RemoveIgnored(config.MergeDefaults(configured))
+				for k, v := range configured {
+					all[k] = v
+				}
+				if err := d.SetNew("tags_all", all); err != nil {
+					return fmt.Errorf("setting `tags_all`: %+v", err)
+				}
+			}
+		}
+
+		if customizeDiff != nil {
+			return customizeDiff(ctx, d, meta)
+		}
+
+		return nil
+	}
+
+	//nolint:staticcheck
+	if resource.Create != nil {
+		create := resource.Create
+		resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+			return withProviderTags(context.TODO(), d, meta, true, nil, func() error {
+				return create(d, meta)
+			})
+		}
+	}
+	if resource.CreateContext != nil {
+		resource.CreateContext = withProviderTagsContext(resource.CreateContext, true, nil)
+	}
+	if resource.CreateWithoutTimeout != nil {
+		resource.CreateWithoutTimeout = withProviderTagsContext(resource.CreateWithoutTimeout, true, nil)
+	}
+
+	//nolint:staticcheck
+	if resource.Read != nil {
+		read := resource.Read
+		resource.Read = func(d *schema.ResourceData, meta interface{}) error {
+			return withProviderTags(context.TODO(), d, meta, false, nil, func() error {
+				return read(d, meta)
+			})
+		}
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = withProviderTagsContext(resource.ReadContext, false, nil)
+	}
+	if resource.ReadWithoutTimeout != nil {
+		resource.ReadWithoutTimeout = withProviderTagsContext(resource.ReadWithoutTimeout, false, nil)
+	}
+
+	//nolint:staticcheck
+	if resource.Update != nil {
+		update := resource.Update
+		resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return withProviderTags(context.TODO(), d, meta, true, existingTags, func() error {
+				return update(d, meta)
+			})
+		}
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = withProviderTagsContext(resource.UpdateContext, true, existingTags)
+	}
+	if resource.UpdateWithoutTimeout != nil {
+		resource.UpdateWithoutTimeout = withProviderTagsContext(resource.UpdateWithoutTimeout, true, existingTags)
+	}
+}
+
+func resourceSupportsProviderTags(resource *schema.Resource) bool {
+	if resource == nil {
+		return false
+	}
+
+	v, ok := resource.Schema["tags"]
+	if !ok || v.Type != pluginsdk.TypeMap || !(v.Optional || v.Required) {
+		return false
+	}
+
+	_, exists := resource.Schema["tags_all"]
+	return !exists
+}
+
+func providerTagsConfig(meta interface{}) tags.Config {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		return client.TagsConfig
+	}
+
+	return tags.Config{}
+}
+
+type existingTagsFunction func(ctx context.Context, d *schema.ResourceData, meta interface{}) (map[string]interface{}, error)
+
+// existingTagsFunc returns a function which retrieves the tags currently assigned to the Resource. These are
+// retrieved using the Tags API where the ID of the Resource is an Azure Resource Manager ID - otherwise (for
+// example for Data Plane resources), or where the Tags API isn't supported for this Resource Type, this falls
+// back to calling the (unwrapped) Read function for the Resource against a copy of the ResourceData.
+func existingTagsFunc(resource *schema.Resource) existingTagsFunction {
+	var read func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+	//nolint:staticcheck
+	switch {
+	case resource.ReadContext != nil:
+		read = resource.ReadContext
+	case resource.ReadWithoutTimeout != nil:
+		read = resource.ReadWithoutTimeout
+	case resource.Read != nil:
+		legacy := resource.Read
+		read = func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(legacy(d, meta))
+		}
+	default:
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+		existingTags, err := existingTagsFromTagsApi(ctx, d.Id(), meta)
+		if err == nil {
+			return existingTags, nil
+		}
+		log.Printf("[DEBUG] retrieving the existing tags for %q using the Tags API: %+v - reading the Resource instead", d.Id(), err)
+
+		existing := resource.Data(d.State())
+		if diags := read(ctx, existing, meta); diags.HasError() {
+			for _, v := range diags {
+				if v.Severity == diag.Error {
+					return nil, fmt.Errorf("%s: %s", v.Summary, v.Detail)
+				}
+			}
+		}
+
+		// the Resource has been removed
+		if existing.Id() == "" {
+			return nil, nil
+		}
+
+		tagsMap, _ := existing.Get("tags").(map[string]interface{})
+		return tagsMap, nil
+	}
+}
+
+// existingTagsFromTagsApi retrieves the tags currently assigned to the Resource with the specified Azure Resource
+// Manager ID using the Tags API, which (unlike reading the Resource) returns only the tags.
+func existingTagsFromTagsApi(ctx context.Context, id string, meta interface{}) (map[string]interface{}, error) {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || client.Resource == nil || client.Resource.TagsClient == nil {
+		return nil, fmt.Errorf("the Tags client isn't configured")
+	}
+	if !strings.HasPrefix(strings.ToLower(id), "/subscriptions/") {
+		return nil, fmt.Errorf("%q isn't an Azure Resource Manager ID", id)
+	}
+
+	resp, err := client.Resource.TagsClient.GetAtScope(ctx, commonids.NewScopeID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]interface{})
+	if model := resp.Model; model != nil && model.Properties.Tags != nil {
+		for k, v := range *model.Properties.Tags {
+			output[k] = v
+		}
+	}
+	return output, nil
+}
+
+func withProviderTagsContext(in func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, mergeDefaults bool, existingTags existingTagsFunction) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		err := withProviderTags(ctx, d, meta, mergeDefaults, existingTags, func() error {
+			diags = in(ctx, d, meta)
+			if diags.HasError() {
+				return fmt.Errorf("calling the wrapped function")
+			}
+			return nil
+		})
+		if err != nil && !diags.HasError() {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
+
+// withProviderTags merges the Default Tags into the `tags` field (when mergeDefaults is true) together with any
+// Ignored Tags returned from existingTags (when specified), calls the specified function and then sets the
+// `tags_all` and `tags` fields based on the tags which were returned.
+func withProviderTags(ctx context.Context, d *schema.ResourceData, meta interface{}, mergeDefaults bool, existingTags existingTagsFunction, in func() error) error {
+	config := providerTagsConfig(meta)
+
+	// during Create/Update this is the configuration, during Read this is the existing state
+	configured, _ := d.Get("tags").(map[string]interface{})
+
+	if mergeDefaults {
+		merged := config.MergeDefaults(configured)
+
+		if existingTags != nil && (len(config.IgnoreKeys) > 0 || len(config.IgnoreKeyPrefixes) > 0) {
+			existing, err := existingTags(ctx, d, meta)
+			if err != nil {
+				return fmt.Errorf("retrieving the existing tags: %+v", err)
+			}
+			merged = config.MergeIgnored(merged, existing)
+		}
+
+		if tagsHaveChanged(configured, merged) {
+			if err := d.Set("tags", merged); err != nil {
+				return fmt.Errorf("merging the Default and Ignored Tags into `tags`: %+v", err)
+			}
+		}
+	}
+
+	if err := in(); err != nil {
+		return err
+	}
+
+	// the Resource has been removed/wasn't found
+	if d.Id() == "" {
+		return nil
+	}
+
+	current, _ := d.Get("tags").(map[string]interface{})
+	all := config.RemoveIgnored(current)
+	for k := range configured {
+		// tags explicitly defined on the Resource are never ignored
+		if v, ok := current[k]; ok {
+			all[k] = v
+		}
+	}
+	if err := d.Set("tags_all", all); err != nil {
+		return fmt.Errorf("setting `tags_all`: %+v", err)
+	}
+	if err := d.Set("tags", config.RemoveDefaults(all, configured)); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
+
+// tagsHaveChanged returns whether the Tag Keys or Values in `updated` differ from those in `original`
+func tagsHaveChanged(original map[string]interface{}, updated map[string]interface{}) bool {
+	if len(original) != len(updated) {
+		return true
+	}
+
+	for k, v := range updated {
+		if existing, ok := original[k]; !ok || existing != v {
+			return true
+		}
+	}
+
+	return false
+}
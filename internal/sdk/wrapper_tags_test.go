@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestWrapResourceWithProviderTags_UpdateRetainsIgnoredTags(t *testing.T) {
+	remote := map[string]interface{}{
+		"env":       "dev",
+		"CreatedBy": "policy",
+		"removed":   "value",
+	}
+	var sent map[string]interface{}
+
+	resource := &schema.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+			"tags": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+		CreateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			return nil
+		},
+		ReadContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			return diag.FromErr(d.Set("tags", remote))
+		},
+		UpdateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			sent = d.Get("tags").(map[string]interface{})
+			return nil
+		},
+		DeleteContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			return nil
+		},
+	}
+	WrapResourceWithProviderTags(resource)
+
+	client := &clients.Client{
+		TagsConfig: tags.Config{
+			DefaultTags: map[string]string{"owner": "team"},
+			IgnoreKeys:  []string{"createdby"},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"env": "prod",
+		},
+	})
+	d.SetId("example")
+
+	if diags := resource.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("updating: %+v", diags)
+	}
+
+	expected := map[string]interface{}{
+		"env":       "prod",
+		"owner":     "team",
+		"CreatedBy": "policy",
+	}
+	if !reflect.DeepEqual(sent, expected) {
+		t.Fatalf("expected the tags sent to be %+v but got %+v", expected, sent)
+	}
+}
+
+func TestTagsHaveChanged(t *testing.T) {
+	testData := []struct {
+		original map[string]interface{}
+		updated  map[string]interface{}
+		expected bool
+	}{
+		{
+			original: map[string]interface{}{"env": "prod"},
+			updated:  map[string]interface{}{"env": "prod"},
+			expected: false,
+		},
+		{
+			// an Ignored Tag replacing a configured key with the same number of tags
+			original: map[string]interface{}{"env": "prod", "createdby": "terraform"},
+			updated:  map[string]interface{}{"env": "prod", "CreatedBy": "policy"},
+			expected: true,
+		},
+		{
+			original: map[string]interface{}{"env": "prod"},
+			updated:  map[string]interface{}{"env": "dev"},
+			expected: true,
+		},
+		{
+			original: map[string]interface{}{"env": "prod"},
+			updated:  map[string]interface{}{"env": "prod", "owner": "team"},
+			expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		if actual := tagsHaveChanged(v.original, v.updated); actual != v.expected {
+			t.Fatalf("expected %t but got %t for %+v -> %+v", v.expected, actual, v.original, v.updated)
+		}
+	}
+}
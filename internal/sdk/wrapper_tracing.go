@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type resourceIdGetter interface {
+	Id() string
+}
+
+// WrapResourceWithTracing records each Create/Read/Update/Delete of the specified (untyped) Resource as a span.
+//
+// Untyped Resources build their context from the StopContext of the Client, rather than the context passed in - as
+// such these functions are called with a copy of the Client, whose StopContext contains the span, when tracing is
+// enabled so that any requests made are recorded as children of the span.
+func WrapResourceWithTracing(resourceType string, resource *schema.Resource) {
+	if resource == nil {
+		return
+	}
+
+	//nolint:staticcheck
+	if resource.Create != nil {
+		resource.Create = withTracing(resourceType, "Create", resource.Create)
+	}
+	if resource.CreateContext != nil {
+		resource.CreateContext = withTracingContext(resourceType, "Create", resource.CreateContext)
+	}
+	if resource.CreateWithoutTimeout != nil {
+		resource.CreateWithoutTimeout = withTracingContext(resourceType, "Create", resource.CreateWithoutTimeout)
+	}
+
+	//nolint:staticcheck
+	if resource.Read != nil {
+		resource.Read = withTracing(resourceType, "Read", resource.Read)
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = withTracingContext(resourceType, "Read", resource.ReadContext)
+	}
+	if resource.ReadWithoutTimeout != nil {
+		resource.ReadWithoutTimeout = withTracingContext(resourceType, "Read", resource.ReadWithoutTimeout)
+	}
+
+	//nolint:staticcheck
+	if resource.Update != nil {
+		resource.Update = withTracing(resourceType, "Update", resource.Update)
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = withTracingContext(resourceType, "Update", resource.UpdateContext)
+	}
+	if resource.UpdateWithoutTimeout != nil {
+		resource.UpdateWithoutTimeout = withTracingContext(resourceType, "Update", resource.UpdateWithoutTimeout)
+	}
+
+	//nolint:staticcheck
+	if resource.Delete != nil {
+		resource.Delete = withTracing(resourceType, "Delete", resource.Delete)
+	}
+	if resource.DeleteContext != nil {
+		resource.DeleteContext = withTracingContext(resourceType, "Delete", resource.DeleteContext)
+	}
+	if resource.DeleteWithoutTimeout != nil {
+		resource.DeleteWithoutTimeout = withTracingContext(resourceType, "Delete", resource.DeleteWithoutTimeout)
+	}
+}
+
+func withTracing(resourceType, operation string, in func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		ctx := context.Background()
+		if client, ok := meta.(*clients.Client); ok && client != nil && client.StopContext != nil {
+			ctx = client.StopContext
+		}
+
+		return traceResourceOperation(ctx, resourceType, operation, d, func(ctx context.Context) error {
+			return in(d, tracedClient(ctx, meta))
+		})
+	}
+}
+
+func withTracingContext(resourceType, operation string, in func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		_ = traceResourceOperation(ctx, resourceType, operation, d, func(ctx context.Context) error {
+			diags = in(ctx, d, tracedClient(ctx, meta))
+			for _, v := range diags {
+				if v.Severity == diag.Error {
+					return fmt.Errorf("%s", v.Summary)
+				}
+			}
+			return nil
+		})
+
+		return diags
+	}
+}
+
+// tracedClient returns a copy of the Client whose StopContext contains the span from ctx, when the span is recording
+func tracedClient(ctx context.Context, meta interface{}) interface{} {
+	client, ok := meta.(*clients.Client)
+	span := trace.SpanFromContext(ctx)
+	if !ok || client == nil || !span.IsRecording() {
+		return meta
+	}
+
+	stopContext := client.StopContext
+	if stopContext == nil {
+		stopContext = context.Background()
+	}
+
+	traced := *client
+	traced.StopContext = trace.ContextWithSpan(stopContext, span)
+	return &traced
+}
+
+// traceResourceOperation calls the specified function within a span for the Create/Read/Update/Delete operation
+func traceResourceOperation(ctx context.Context, resourceType, operation string, d resourceIdGetter, in func(ctx context.Context) error) error {
+	ctx, span := tracing.StartSpan(ctx, fmt.Sprintf("%s %s", operation, resourceType), trace.WithAttributes(
+		tracing.AttributeResourceType.String(resourceType),
+		tracing.AttributeOperation.String(operation),
+	))
+
+	err := in(ctx)
+	if id := d.Id(); id != "" {
+		span.SetAttributes(tracing.AttributeResourceID.String(id))
+	}
+	tracing.End(span, err)
+
+	return err
+}
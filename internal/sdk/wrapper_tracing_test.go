@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestWrapResourceWithTracing(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	defer otel.SetTracerProvider(previous)
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	client := &clients.Client{
+		StopContext: context.Background(),
+	}
+
+	var createSpan trace.SpanContext
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			// the context built from the StopContext should contain the span
+			createSpan = trace.SpanContextFromContext(meta.(*clients.Client).StopContext)
+			d.SetId("/subscriptions/1234/resourceGroups/group1")
+			return nil
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return fmt.Errorf("bad things happened")
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+	WrapResourceWithTracing("azurerm_resource_group", resource)
+
+	d := resource.TestResourceData()
+	if err := resource.Create(d, client); err != nil { //nolint:staticcheck
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if err := resource.Read(d, client); err == nil { //nolint:staticcheck
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	if client.StopContext != context.Background() {
+		t.Fatalf("expected the StopContext of the original Client not to be modified")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans but got %d", len(spans))
+	}
+
+	if spans[0].Name() != "Create azurerm_resource_group" {
+		t.Fatalf("expected the span name `Create azurerm_resource_group` but got %q", spans[0].Name())
+	}
+	if spans[0].SpanContext().SpanID() != createSpan.SpanID() {
+		t.Fatalf("expected the StopContext passed to Create to contain the span")
+	}
+	found := false
+	for _, v := range spans[0].Attributes() {
+		if v.Key == tracing.AttributeResourceID && v.Value.AsString() == "/subscriptions/1234/resourceGroups/group1" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected the Create span to contain the Resource ID")
+	}
+
+	if spans[1].Name() != "Read azurerm_resource_group" {
+		t.Fatalf("expected the span name `Read azurerm_resource_group` but got %q", spans[1].Name())
+	}
+	if spans[1].Status().Code != codes.Error {
+		t.Fatalf("expected the Read span to be an error")
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+)
+
+var _ action.ActionWithConfigure = &FunctionAppSyncTriggersAction{}
+
+func NewFunctionAppSyncTriggersAction() action.Action {
+	return &FunctionAppSyncTriggersAction{}
+}
+
+type FunctionAppSyncTriggersAction struct {
+	sdk.ActionMetadata
+}
+
+type FunctionAppSyncTriggersActionModel struct {
+	FunctionAppId types.String `tfsdk:"function_app_id"`
+}
+
+func (a *FunctionAppSyncTriggersAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_function_app_sync_triggers"
+}
+
+func (a *FunctionAppSyncTriggersAction) Configure(_ context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(request, response)
+}
+
+func (a *FunctionAppSyncTriggersAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Synchronises the Function Triggers of a Function App, for example after deploying new code.",
+		Attributes: map[string]schema.Attribute{
+			"function_app_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Linux or Windows Function App.",
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: commonids.ValidateFunctionAppID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *FunctionAppSyncTriggersAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.AppService.WebAppsClient
+
+	ctx, cancel := a.InvokeContext(ctx)
+	defer cancel()
+
+	var data FunctionAppSyncTriggersActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := commonids.ParseFunctionAppID(data.FunctionAppId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("parsing `function_app_id`", err.Error())
+		return
+	}
+
+	a.SendProgress(response, "synchronising the Function Triggers for %s..", *id)
+
+	if _, err := client.SyncFunctionTriggers(ctx, *id); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("synchronising the Function Triggers for %s", *id), err.Error())
+		return
+	}
+
+	a.SendProgress(response, "synchronised the Function Triggers for %s", *id)
+}
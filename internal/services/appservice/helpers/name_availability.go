@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/nameavailability"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// ValidateSiteNameDuringPlan checks that the name of a new Web App or Function App is available, when the
+// `check_during_plan` feature within the `name_availability` block is enabled
+func ValidateSiteNameDuringPlan(ctx context.Context, metadata sdk.ResourceMetaData, resourceType string) error {
+	client := metadata.Client.AppService.ResourceProvidersClient
+	servicePlanClient := metadata.Client.AppService.ServicePlanClient
+	subscriptionId := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+	rd := metadata.ResourceDiff
+
+	check := func(ctx context.Context, name string) (*nameavailability.Result, error) {
+		// the plan id is known after apply when the Service Plan is created in the same run
+		if rd.NewValueKnown("service_plan_id") {
+			if planId := rd.Get("service_plan_id").(string); planId != "" {
+				servicePlanId, err := commonids.ParseAppServicePlanID(planId)
+				if err != nil {
+					return nil, err
+				}
+
+				servicePlan, err := servicePlanClient.Get(ctx, *servicePlanId)
+				if err != nil {
+					return nil, fmt.Errorf("retrieving %s: %+v", servicePlanId, err)
+				}
+
+				// Apps within an App Service Environment use the DNS suffix of the ASE, which is checked during creation
+				if model := servicePlan.Model; model != nil && model.Properties != nil && model.Properties.HostingEnvironmentProfile != nil {
+					return &nameavailability.Result{
+						Available: true,
+					}, nil
+				}
+			}
+		}
+
+		input := resourceproviders.ResourceNameAvailabilityRequest{
+			Name: name,
+			Type: resourceproviders.CheckNameResourceTypesMicrosoftPointWebSites,
+		}
+		resp, err := client.CheckNameAvailability(ctx, subscriptionId, input)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Model == nil || resp.Model.NameAvailable == nil {
+			return nil, fmt.Errorf("model was nil")
+		}
+
+		return &nameavailability.Result{
+			Available: *resp.Model.NameAvailable,
+			Message:   pointer.From(resp.Model.Message),
+		}, nil
+	}
+
+	return nameavailability.ValidateDuringPlan(ctx, rd, metadata.Client.Features, resourceType, check)
+}
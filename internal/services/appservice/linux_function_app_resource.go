@@ -1237,6 +1237,10 @@ func (r LinuxFunctionAppResource) CustomizeDiff() sdk.ResourceFunc {
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.AppService.ServicePlanClient
 			rd := metadata.ResourceDiff
+
+			if err := helpers.ValidateSiteNameDuringPlan(ctx, metadata, "Linux Function App"); err != nil {
+				return err
+			}
 			if rd.HasChange("vnet_image_pull_enabled") && features.FourPointOhBeta() {
 				planId := rd.Get("service_plan_id")
 				// the plan id is known after apply during the initial creation
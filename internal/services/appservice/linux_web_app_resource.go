@@ -76,6 +76,8 @@ var _ sdk.ResourceWithCustomImporter = LinuxWebAppResource{}
 
 var _ sdk.ResourceWithStateMigration = LinuxWebAppResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = LinuxWebAppResource{}
+
 func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -1003,6 +1005,15 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 	}
 }
 
+func (r LinuxWebAppResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return helpers.ValidateSiteNameDuringPlan(ctx, metadata, "Linux Web App")
+		},
+	}
+}
+
 func (r LinuxWebAppResource) CustomImporter() sdk.ResourceRunFunc {
 	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 		client := metadata.Client.AppService.WebAppsClient
@@ -4,10 +4,14 @@
 package appservice
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 )
 
-var _ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
+var (
+	_ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.FrameworkServiceRegistrationWithActions  = Registration{}
+)
 
 type Registration struct{}
 
@@ -61,3 +65,10 @@ func (r Registration) Resources() []sdk.Resource {
 		WindowsWebAppSlotResource{},
 	}
 }
+
+// Actions returns the Actions supported by this Service
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{
+		NewFunctionAppSyncTriggersAction,
+	}
+}
@@ -1260,6 +1260,10 @@ func (r WindowsFunctionAppResource) CustomizeDiff() sdk.ResourceFunc {
 			client := metadata.Client.AppService.ServicePlanClient
 			rd := metadata.ResourceDiff
 
+			if err := helpers.ValidateSiteNameDuringPlan(ctx, metadata, "Windows Function App"); err != nil {
+				return err
+			}
+
 			if rd.HasChange("vnet_image_pull_enabled") {
 				planId := rd.Get("service_plan_id")
 				// the plan id is known after apply during the initial creation
@@ -74,6 +74,8 @@ var _ sdk.ResourceWithCustomImporter = WindowsWebAppResource{}
 
 var _ sdk.ResourceWithStateMigration = WindowsWebAppResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = WindowsWebAppResource{}
+
 func (r WindowsWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -1062,6 +1064,15 @@ func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 	}
 }
 
+func (r WindowsWebAppResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return helpers.ValidateSiteNameDuringPlan(ctx, metadata, "Windows Web App")
+		},
+	}
+}
+
 func (r WindowsWebAppResource) CustomImporter() sdk.ResourceRunFunc {
 	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 		client := metadata.Client.AppService.WebAppsClient
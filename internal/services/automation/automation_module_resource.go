@@ -160,7 +160,7 @@ func resourceAutomationModuleCreateUpdate(d *pluginsdk.ResourceData, meta interf
 						provisioningState = string(*props.ProvisioningState)
 					}
 					if props.Error != nil && props.Error.Message != nil && *props.Error.Message != "" {
-						return resp, provisioningState, fmt.Errorf(*props.Error.Message)
+						return resp, provisioningState, fmt.Errorf("%s", *props.Error.Message)
 					}
 					return resp, provisioningState, nil
 				}
@@ -189,7 +189,7 @@ func (r PowerShell72ModuleResource) Create() sdk.ResourceFunc {
 								provisioningState = string(*props.ProvisioningState)
 							}
 							if props.Error != nil && props.Error.Message != nil && *props.Error.Message != "" {
-								return resp, provisioningState, fmt.Errorf(*props.Error.Message)
+								return resp, provisioningState, fmt.Errorf("%s", *props.Error.Message)
 							}
 							return resp, provisioningState, nil
 						}
@@ -281,7 +281,7 @@ func (r PowerShell72ModuleResource) Update() sdk.ResourceFunc {
 								provisioningState = string(*props.ProvisioningState)
 							}
 							if props.Error != nil && props.Error.Message != nil && *props.Error.Message != "" {
-								return resp, provisioningState, fmt.Errorf(*props.Error.Message)
+								return resp, provisioningState, fmt.Errorf("%s", *props.Error.Message)
 							}
 							return resp, provisioningState, nil
 						}
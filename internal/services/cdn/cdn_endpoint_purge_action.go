@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cdn
+
+import (
+	"context"
+	"fmt"
+
+	cdnSdk "github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn" // nolint: staticcheck
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+)
+
+var _ action.ActionWithConfigure = &CdnEndpointPurgeAction{}
+
+func NewCdnEndpointPurgeAction() action.Action {
+	return &CdnEndpointPurgeAction{}
+}
+
+type CdnEndpointPurgeAction struct {
+	sdk.ActionMetadata
+}
+
+type CdnEndpointPurgeActionModel struct {
+	CdnEndpointId types.String `tfsdk:"cdn_endpoint_id"`
+	ContentPaths  types.List   `tfsdk:"content_paths"`
+}
+
+func (a *CdnEndpointPurgeAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_cdn_endpoint_purge"
+}
+
+func (a *CdnEndpointPurgeAction) Configure(_ context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(request, response)
+}
+
+func (a *CdnEndpointPurgeAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Purges cached content from a CDN Endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"cdn_endpoint_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the CDN Endpoint which the content should be purged from.",
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validate.EndpointID,
+					},
+				},
+			},
+
+			"content_paths": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The paths to the content which should be purged, which can be a file path or a wildcard directory (for example `/*`).",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+func (a *CdnEndpointPurgeAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.Cdn.EndpointsClient
+
+	ctx, cancel := a.InvokeContext(ctx)
+	defer cancel()
+
+	var data CdnEndpointPurgeActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parse.EndpointID(data.CdnEndpointId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("parsing `cdn_endpoint_id`", err.Error())
+		return
+	}
+
+	contentPaths := make([]string, 0)
+	response.Diagnostics.Append(data.ContentPaths.ElementsAs(ctx, &contentPaths, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	a.SendProgress(response, "purging %d content path(s) from %s..", len(contentPaths), *id)
+
+	future, err := client.PurgeContent(ctx, id.ResourceGroup, id.ProfileName, id.Name, cdnSdk.PurgeParameters{
+		ContentPaths: &contentPaths,
+	})
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("purging content from %s", *id), err.Error())
+		return
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for the content to be purged from %s", *id), err.Error())
+		return
+	}
+
+	a.SendProgress(response, "purged %d content path(s) from %s", len(contentPaths), *id)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cdn
+
+import (
+	"context"
+	"fmt"
+
+	cdnFrontDoorSdk "github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn" // nolint: staticcheck
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+)
+
+var _ action.ActionWithConfigure = &CdnFrontDoorEndpointPurgeAction{}
+
+func NewCdnFrontDoorEndpointPurgeAction() action.Action {
+	return &CdnFrontDoorEndpointPurgeAction{}
+}
+
+type CdnFrontDoorEndpointPurgeAction struct {
+	sdk.ActionMetadata
+}
+
+type CdnFrontDoorEndpointPurgeActionModel struct {
+	CdnFrontDoorEndpointId types.String `tfsdk:"cdn_frontdoor_endpoint_id"`
+	ContentPaths           types.List   `tfsdk:"content_paths"`
+	Domains                types.List   `tfsdk:"domains"`
+}
+
+func (a *CdnFrontDoorEndpointPurgeAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_cdn_frontdoor_endpoint_purge"
+}
+
+func (a *CdnFrontDoorEndpointPurgeAction) Configure(_ context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(request, response)
+}
+
+func (a *CdnFrontDoorEndpointPurgeAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Purges cached content from a Front Door (standard/premium) Endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"cdn_frontdoor_endpoint_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Front Door Endpoint which the content should be purged from.",
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validate.FrontDoorEndpointID,
+					},
+				},
+			},
+
+			"content_paths": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The paths to the content which should be purged, which can be a file path or a wildcard directory (for example `/*`).",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+
+			"domains": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The domains which the content should be purged from. Defaults to all of the domains associated with the Front Door Endpoint.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+func (a *CdnFrontDoorEndpointPurgeAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.Cdn.FrontDoorEndpointsClient
+
+	ctx, cancel := a.InvokeContext(ctx)
+	defer cancel()
+
+	var data CdnFrontDoorEndpointPurgeActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parse.FrontDoorEndpointID(data.CdnFrontDoorEndpointId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("parsing `cdn_frontdoor_endpoint_id`", err.Error())
+		return
+	}
+
+	contentPaths := make([]string, 0)
+	response.Diagnostics.Append(data.ContentPaths.ElementsAs(ctx, &contentPaths, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parameters := cdnFrontDoorSdk.AfdPurgeParameters{
+		ContentPaths: &contentPaths,
+	}
+	if !data.Domains.IsNull() {
+		domains := make([]string, 0)
+		response.Diagnostics.Append(data.Domains.ElementsAs(ctx, &domains, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		parameters.Domains = &domains
+	}
+
+	a.SendProgress(response, "purging %d content path(s) from %s..", len(contentPaths), *id)
+
+	future, err := client.PurgeContent(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, parameters)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("purging content from %s", *id), err.Error())
+		return
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for the content to be purged from %s", *id), err.Error())
+		return
+	}
+
+	a.SendProgress(response, "purged %d content path(s) from %s", len(contentPaths), *id)
+}
@@ -4,6 +4,7 @@
 package cdn
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -11,7 +12,10 @@ import (
 
 type Registration struct{}
 
-var _ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+var (
+	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.FrameworkServiceRegistrationWithActions    = Registration{}
+)
 
 func (r Registration) AssociatedGitHubLabel() string {
 	return "service/cdn"
@@ -75,3 +79,11 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 	return resources
 }
+
+// Actions returns the Actions supported by this Service
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{
+		NewCdnEndpointPurgeAction,
+		NewCdnFrontDoorEndpointPurgeAction,
+	}
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetvms"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/marketplaceordering/2015-06-01/agreements"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdkhacks"
 )
 
 type Client struct {
@@ -68,6 +69,7 @@ type Client struct {
 	SkusClient                                  *skus.SkusClient
 	SSHPublicKeysClient                         *sshpublickeys.SshPublicKeysClient
 	SnapshotsClient                             *snapshots.SnapshotsClient
+	UsagesClient                                *sdkhacks.UsagesClient
 	VirtualMachinesClient                       *virtualmachines.VirtualMachinesClient
 	VirtualMachineExtensionsClient              *virtualmachineextensions.VirtualMachineExtensionsClient
 	VirtualMachineRunCommandsClient             *virtualmachineruncommands.VirtualMachineRunCommandsClient
@@ -199,6 +201,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(skusClient.Client, o.Authorizers.ResourceManager)
 
+	usagesClient, err := sdkhacks.NewUsagesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Usages client: %+v", err)
+	}
+	o.Configure(usagesClient.Client, o.Authorizers.ResourceManager)
+
 	snapshotsClient, err := snapshots.NewSnapshotsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Snapshots client: %+v", err)
@@ -282,6 +290,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		SkusClient:                                  skusClient,
 		SSHPublicKeysClient:                         sshPublicKeysClient,
 		SnapshotsClient:                             snapshotsClient,
+		UsagesClient:                                usagesClient,
 		VirtualMachinesClient:                       virtualMachinesClient,
 		VirtualMachineExtensionsClient:              virtualMachineExtensionsClient,
 		VirtualMachineRunCommandsClient:             virtualMachineRunCommandsClient,
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/computequota"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// computeQuotaCustomizeDiff checks that enough vCPU quota is available for a new or resized/scaled resource during the
+// plan, when the `check_during_plan` feature within the `compute_quota` block is enabled.
+//
+// The instancesField is optional - when omitted (e.g. for a Virtual Machine) a single instance is requested.
+func computeQuotaCustomizeDiff(resourceType string, sizeField string, instancesField string) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+		client := v.(*clients.Client)
+		if !client.Features.ComputeQuota.CheckDuringPlan {
+			return nil
+		}
+
+		fields := []string{"location", sizeField, "priority"}
+		if instancesField != "" {
+			fields = append(fields, instancesField)
+		}
+		for _, field := range fields {
+			if !diff.NewValueKnown(field) {
+				return nil
+			}
+		}
+
+		// only the size or number of instances of an existing resource affect the vCPUs requested
+		if diff.Id() != "" && !diff.HasChange(sizeField) && (instancesField == "" || !diff.HasChange(instancesField)) {
+			return nil
+		}
+
+		oldSize, newSize := diff.GetChange(sizeField)
+		previousInstances, instances := int64(0), int64(1)
+		if diff.Id() != "" {
+			previousInstances = 1
+		}
+		if instancesField != "" {
+			oldInstances, newInstances := diff.GetChange(instancesField)
+			previousInstances, instances = int64(oldInstances.(int)), int64(newInstances.(int))
+		}
+
+		resourceKey := diff.Id()
+		if resourceKey == "" {
+			resourceKey = fmt.Sprintf("%s/%s/%s", resourceType, diff.Get("resource_group_name").(string), diff.Get("name").(string))
+		}
+
+		input := computequota.Input{
+			ResourceKey: resourceKey,
+			Location:    diff.Get("location").(string),
+			Size:        newSize.(string),
+			Instances:   instances,
+			Spot:        !strings.EqualFold(diff.Get("priority").(string), "Regular"),
+		}
+		if diff.Id() != "" {
+			input.PreviousSize = oldSize.(string)
+			input.PreviousInstances = previousInstances
+		}
+
+		quotaClients := computequota.Clients{
+			SkusClient:   client.Compute.SkusClient,
+			UsagesClient: client.Compute.UsagesClient,
+		}
+		return computequota.ValidateDuringPlan(ctx, client.Features, quotaClients, client.Account.SubscriptionId, input)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-03/galleryapplications"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
@@ -27,6 +28,7 @@ type GalleryApplicationResource struct{}
 var (
 	_ sdk.ResourceWithUpdate        = GalleryApplicationResource{}
 	_ sdk.ResourceWithCustomizeDiff = GalleryApplicationResource{}
+	_ sdk.ResourceWithIdentity      = GalleryApplicationResource{}
 )
 
 type GalleryApplicationModel struct {
@@ -117,6 +119,10 @@ func (r GalleryApplicationResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return galleryapplications.ValidateApplicationID
 }
 
+func (r GalleryApplicationResource) Identity() resourceids.ResourceId {
+	return &galleryapplications.ApplicationId{}
+}
+
 func (r GalleryApplicationResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -26,6 +26,7 @@ import (
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceskus"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/base64"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -41,10 +42,12 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 		Read:   resourceLinuxVirtualMachineRead,
 		Update: resourceLinuxVirtualMachineUpdate,
 		Delete: resourceLinuxVirtualMachineDelete,
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingIdentity(&virtualmachines.VirtualMachineId{}, pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := commonids.ParseVirtualMachineID(id)
 			return err
-		}, importVirtualMachine(virtualmachines.OperatingSystemTypesLinux, "azurerm_linux_virtual_machine")),
+		}, importVirtualMachine(virtualmachines.OperatingSystemTypesLinux, "azurerm_linux_virtual_machine"))),
+
+		Identity: pluginsdk.ResourceIdentityForResourceId(&virtualmachines.VirtualMachineId{}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(45 * time.Minute),
@@ -53,6 +56,11 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(45 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(
+			skuAvailabilityCustomizeDiff(resourceskus.ResourceTypeVirtualMachines, "size", "zone"),
+			computeQuotaCustomizeDiff("azurerm_linux_virtual_machine", "size", ""),
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -101,6 +109,24 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 				Sensitive:        true,
 				DiffSuppressFunc: adminPasswordDiffSuppressFunc,
 				ValidateFunc:     computeValidate.LinuxAdminPassword,
+				ConflictsWith:    []string{"admin_password_wo"},
+			},
+
+			"admin_password_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ValidateFunc:  computeValidate.LinuxAdminPassword,
+				ConflictsWith: []string{"admin_password"},
+				RequiredWith:  []string{"admin_password_wo_version"},
+			},
+
+			"admin_password_wo_version": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"admin_password_wo"},
 			},
 
 			"admin_ssh_key": SSHKeysSchema(true),
@@ -761,11 +787,18 @@ func resourceLinuxVirtualMachineCreate(d *pluginsdk.ResourceData, meta interface
 
 	// "Authentication using either SSH or by user name and password must be enabled in Linux profile." Target="linuxConfiguration"
 	adminPassword := d.Get("admin_password").(string)
+	adminPasswordWo, err := pluginsdk.GetWriteOnlyString(d, "admin_password_wo")
+	if err != nil {
+		return err
+	}
+	if adminPasswordWo != "" {
+		adminPassword = adminPasswordWo
+	}
 	if disablePasswordAuthentication && len(sshKeys) == 0 {
 		return fmt.Errorf("at least one `admin_ssh_key` must be specified when `disable_password_authentication` is set to `true`")
 	} else if !disablePasswordAuthentication {
 		if adminPassword == "" {
-			return fmt.Errorf("an `admin_password` must be specified if `disable_password_authentication` is set to `false`")
+			return fmt.Errorf("either `admin_password` or `admin_password_wo` must be specified if `disable_password_authentication` is set to `false`")
 		}
 
 		params.Properties.OsProfile.AdminPassword = pointer.To(adminPassword)
@@ -808,6 +841,10 @@ func resourceLinuxVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}
 	d.Set("name", id.VirtualMachineName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
+	if err := pluginsdk.SetResourceIdentity(d, id); err != nil {
+		return err
+	}
+
 	if model := resp.Model; model != nil {
 		d.Set("location", location.Normalize(model.Location))
 		d.Set("edge_zone", flattenEdgeZone(model.ExtendedLocation))
@@ -26,6 +26,7 @@ import (
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceskus"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/base64"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -54,6 +55,11 @@ func resourceLinuxVirtualMachineScaleSet() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(time.Minute * 60),
 		},
 
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(
+			skuAvailabilityCustomizeDiff(resourceskus.ResourceTypeVirtualMachines, "sku", "zones"),
+			computeQuotaCustomizeDiff("azurerm_linux_virtual_machine_scale_set", "sku", "instances"),
+		),
+
 		// TODO: exposing requireGuestProvisionSignal once it's available
 		// https://github.com/Azure/azure-rest-api-specs/pull/7246
 
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceskus"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -313,6 +314,7 @@ func resourceManagedDisk() *pluginsdk.Resource {
 				}
 				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
 			}),
+			skuAvailabilityCustomizeDiff(resourceskus.ResourceTypeDisks, "storage_account_type", "zone"),
 		),
 	}
 }
@@ -4,12 +4,19 @@
 package compute
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 type Registration struct{}
 
+var (
+	_ sdk.FrameworkServiceRegistrationWithListResources = Registration{}
+	_ sdk.FrameworkServiceRegistrationWithActions       = Registration{}
+)
+
 // Name is the name of this Service
 func (r Registration) Name() string {
 	return "Compute"
@@ -98,3 +105,18 @@ func (r Registration) Resources() []sdk.Resource {
 		VirtualMachineGalleryApplicationAssignmentResource{},
 	}
 }
+
+// ListResources returns the List Resources supported by this Service
+func (r Registration) ListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewLinuxVirtualMachineListResource,
+		NewWindowsVirtualMachineListResource,
+	}
+}
+
+// Actions returns the Actions supported by this Service
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{
+		NewVirtualMachinePowerAction,
+	}
+}
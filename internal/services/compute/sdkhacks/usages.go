@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// TODO: switch to the `compute/{version}/usage` package once it's been added to `hashicorp/go-azure-sdk`
+// the Compute Usages API (`Microsoft.Compute/locations/{location}/usages`) isn't currently generated.
+
+const usagesApiVersion = "2024-03-01"
+
+type UsagesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewUsagesClientWithBaseURI(sdkApi sdkEnv.Api) (*UsagesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "usage", usagesApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating UsagesClient: %+v", err)
+	}
+
+	return &UsagesClient{
+		Client: client,
+	}, nil
+}
+
+type Usage struct {
+	CurrentValue int64     `json:"currentValue"`
+	Limit        int64     `json:"limit"`
+	Name         UsageName `json:"name"`
+	Unit         string    `json:"unit"`
+}
+
+type UsageName struct {
+	LocalizedValue *string `json:"localizedValue,omitempty"`
+	Value          *string `json:"value,omitempty"`
+}
+
+type ListOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]Usage
+}
+
+type listCustomPager struct {
+	NextLink *odata.Link `json:"nextLink"`
+}
+
+func (p *listCustomPager) NextPageLink() *odata.Link {
+	defer func() {
+		p.NextLink = nil
+	}()
+
+	return p.NextLink
+}
+
+// List returns the current Compute usage and limits (e.g. the vCPU quotas for each VM Family) for the
+// specified Subscription within the specified Location.
+func (c UsagesClient) List(ctx context.Context, subscriptionId commonids.SubscriptionId, loc string) (result ListOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Pager:      &listCustomPager{},
+		Path:       fmt.Sprintf("%s/providers/Microsoft.Compute/locations/%s/usages", subscriptionId.ID(), location.Normalize(loc)),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.ExecutePaged(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var values struct {
+		Values *[]Usage `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return
+	}
+
+	result.Model = values.Values
+
+	return
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceskus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// skuAvailabilityCustomizeDiff validates that the SKU (and Availability Zones) of a new or changing resource are
+// available to the Subscription within the Location during the plan, when Enhanced Validation is enabled.
+//
+// The zonesField can either be a single zone (e.g. `zone`) or a set of zones (e.g. `zones`).
+func skuAvailabilityCustomizeDiff(resourceType resourceskus.ResourceType, skuField string, zonesField string) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+		if !resourceskus.ShouldValidate(diff, "location", skuField, zonesField) {
+			return nil
+		}
+
+		requestedZones := make([]string, 0)
+		switch raw := diff.Get(zonesField).(type) {
+		case string:
+			if raw != "" {
+				requestedZones = append(requestedZones, raw)
+			}
+		case *pluginsdk.Set:
+			requestedZones = zones.ExpandUntyped(raw.List())
+		}
+
+		client := v.(*clients.Client)
+		return resourceskus.EnhancedValidate(ctx, client.Compute.SkusClient, client.Account.SubscriptionId, resourceskus.Input{
+			ResourceType: resourceType,
+			Location:     diff.Get("location").(string),
+			SkuName:      diff.Get(skuField).(string),
+			Zones:        requestedZones,
+		})
+	}
+}
@@ -65,3 +65,15 @@ func importVirtualMachine(osType virtualmachines.OperatingSystemTypes, resourceT
 		return []*pluginsdk.ResourceData{d}, nil
 	}
 }
+
+// virtualMachineSupportsImportAs returns whether the Virtual Machine `vm` can be imported into the
+// Linux/Windows Virtual Machine resource for the OS Type `osType`, using the same checks as importVirtualMachine.
+func virtualMachineSupportsImportAs(vm virtualmachines.VirtualMachine, osType virtualmachines.OperatingSystemTypes) bool {
+	props := vm.Properties
+	if props == nil || props.OsProfile == nil || props.StorageProfile == nil {
+		return false
+	}
+
+	osDisk := props.StorageProfile.OsDisk
+	return osDisk != nil && osDisk.Vhd == nil && osDisk.OsType != nil && *osDisk.OsType == osType
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+)
+
+var _ action.ActionWithConfigure = &VirtualMachinePowerAction{}
+
+const (
+	virtualMachinePowerActionDeallocate = "deallocate"
+	virtualMachinePowerActionPowerOff   = "power_off"
+	virtualMachinePowerActionRestart    = "restart"
+	virtualMachinePowerActionStart      = "start"
+)
+
+func NewVirtualMachinePowerAction() action.Action {
+	return &VirtualMachinePowerAction{}
+}
+
+type VirtualMachinePowerAction struct {
+	sdk.ActionMetadata
+}
+
+type VirtualMachinePowerActionModel struct {
+	VirtualMachineId types.String `tfsdk:"virtual_machine_id"`
+	PowerAction      types.String `tfsdk:"power_action"`
+	SkipShutdown     types.Bool   `tfsdk:"skip_shutdown"`
+}
+
+func (a *VirtualMachinePowerAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_virtual_machine_power"
+}
+
+func (a *VirtualMachinePowerAction) Configure(_ context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(request, response)
+}
+
+func (a *VirtualMachinePowerAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Changes the power state of a Virtual Machine, for example restarting or deallocating it.",
+		Attributes: map[string]schema.Attribute{
+			"virtual_machine_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Linux or Windows Virtual Machine.",
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: virtualmachines.ValidateVirtualMachineID,
+					},
+				},
+			},
+
+			"power_action": schema.StringAttribute{
+				Required:    true,
+				Description: "The power action which should be performed on the Virtual Machine.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						virtualMachinePowerActionDeallocate,
+						virtualMachinePowerActionPowerOff,
+						virtualMachinePowerActionRestart,
+						virtualMachinePowerActionStart,
+					),
+				},
+			},
+
+			"skip_shutdown": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Should the graceful shutdown of the Virtual Machine be skipped when `power_action` is `power_off`?",
+			},
+		},
+	}
+}
+
+func (a *VirtualMachinePowerAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.Compute.VirtualMachinesClient
+
+	ctx, cancel := a.InvokeContext(ctx)
+	defer cancel()
+
+	var data VirtualMachinePowerActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := virtualmachines.ParseVirtualMachineID(data.VirtualMachineId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("parsing `virtual_machine_id`", err.Error())
+		return
+	}
+
+	a.SendProgress(response, "performing the power action %q on %s..", data.PowerAction.ValueString(), *id)
+
+	switch data.PowerAction.ValueString() {
+	case virtualMachinePowerActionDeallocate:
+		err = client.DeallocateThenPoll(ctx, *id, virtualmachines.DefaultDeallocateOperationOptions())
+	case virtualMachinePowerActionPowerOff:
+		options := virtualmachines.DefaultPowerOffOperationOptions()
+		options.SkipShutdown = pointer.To(data.SkipShutdown.ValueBool())
+		err = client.PowerOffThenPoll(ctx, *id, options)
+	case virtualMachinePowerActionRestart:
+		err = client.RestartThenPoll(ctx, *id)
+	case virtualMachinePowerActionStart:
+		err = client.StartThenPoll(ctx, *id)
+	}
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("performing the power action %q on %s", data.PowerAction.ValueString(), *id), err.Error())
+		return
+	}
+
+	a.SendProgress(response, "performed the power action %q on %s", data.PowerAction.ValueString(), *id)
+}
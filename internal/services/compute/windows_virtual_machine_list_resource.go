@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var (
+	_ list.ListResourceWithConfigure        = &WindowsVirtualMachineListResource{}
+	_ list.ListResourceWithRawV5Schemas     = &WindowsVirtualMachineListResource{}
+	_ sdk.ListResourceWithPluginSdkResource = &WindowsVirtualMachineListResource{}
+)
+
+func NewWindowsVirtualMachineListResource() list.ListResource {
+	return &WindowsVirtualMachineListResource{}
+}
+
+type WindowsVirtualMachineListResource struct {
+	sdk.ListResourceMetadata
+}
+
+func (r *WindowsVirtualMachineListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_windows_virtual_machine"
+}
+
+func (r *WindowsVirtualMachineListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	r.Defaults(request, response)
+}
+
+func (r *WindowsVirtualMachineListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = sdk.ListResourceFilterSchema(true)
+}
+
+func (r *WindowsVirtualMachineListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	r.PluginSdkRawV5Schemas(ctx, response)
+}
+
+func (r *WindowsVirtualMachineListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var filter sdk.ListResourceWithResourceGroupFilterModel
+	if diags := request.Config.Get(ctx, &filter); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = r.PluginSdkListResults(ctx, request, func(ctx context.Context) ([]resourceids.ResourceId, error) {
+		client := r.Client.Compute.VirtualMachinesClient
+		subscriptionId := commonids.NewSubscriptionID(r.SubscriptionId)
+
+		items := make([]virtualmachines.VirtualMachine, 0)
+		if v := filter.ResourceGroupName.ValueString(); v != "" {
+			resourceGroupId := commonids.NewResourceGroupID(r.SubscriptionId, v)
+			resp, err := client.ListComplete(ctx, resourceGroupId, virtualmachines.DefaultListOperationOptions())
+			if err != nil {
+				return nil, fmt.Errorf("listing Virtual Machines within %s: %+v", resourceGroupId, err)
+			}
+			items = resp.Items
+		} else {
+			resp, err := client.ListAllComplete(ctx, subscriptionId, virtualmachines.DefaultListAllOperationOptions())
+			if err != nil {
+				return nil, fmt.Errorf("listing Virtual Machines within %s: %+v", subscriptionId, err)
+			}
+			items = resp.Items
+		}
+
+		ids := make([]resourceids.ResourceId, 0)
+		for _, item := range items {
+			if item.Id == nil || !filter.Matches(ctx, item.Location, item.Tags) {
+				continue
+			}
+			if !virtualMachineSupportsImportAs(item, virtualmachines.OperatingSystemTypesWindows) {
+				continue
+			}
+
+			id, err := virtualmachines.ParseVirtualMachineIDInsensitively(*item.Id)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+
+		return ids, nil
+	})
+}
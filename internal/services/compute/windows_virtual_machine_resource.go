@@ -26,6 +26,7 @@ import (
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceskus"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/base64"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -41,10 +42,12 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 		Update: resourceWindowsVirtualMachineUpdate,
 		Delete: resourceWindowsVirtualMachineDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingIdentity(&virtualmachines.VirtualMachineId{}, pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := commonids.ParseVirtualMachineID(id)
 			return err
-		}, importVirtualMachine(virtualmachines.OperatingSystemTypesWindows, "azurerm_windows_virtual_machine")),
+		}, importVirtualMachine(virtualmachines.OperatingSystemTypesWindows, "azurerm_windows_virtual_machine"))),
+
+		Identity: pluginsdk.ResourceIdentityForResourceId(&virtualmachines.VirtualMachineId{}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(45 * time.Minute),
@@ -53,6 +56,11 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(45 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(
+			skuAvailabilityCustomizeDiff(resourceskus.ResourceTypeVirtualMachines, "size", "zone"),
+			computeQuotaCustomizeDiff("azurerm_windows_virtual_machine", "size", ""),
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -844,6 +852,10 @@ func resourceWindowsVirtualMachineRead(d *pluginsdk.ResourceData, meta interface
 	d.Set("name", id.VirtualMachineName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
+	if err := pluginsdk.SetResourceIdentity(d, id); err != nil {
+		return err
+	}
+
 	if model := resp.Model; model != nil {
 		d.Set("location", location.Normalize(model.Location))
 		d.Set("edge_zone", flattenEdgeZone(model.ExtendedLocation))
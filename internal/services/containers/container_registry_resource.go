@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/nameavailability"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
 	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -158,11 +159,34 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 				return fmt.Errorf("`data_endpoint_enabled` can only be applied when using the Premium Sku")
 			}
 
-			return nil
+			client := v.(*clients.Client)
+			subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+			return nameavailability.ValidateDuringPlan(ctx, d, client.Features, "Container Registry", checkContainerRegistryNameAvailability(client.Containers.ContainerRegistryClient_v2021_08_01_preview.Operation, subscriptionId))
 		}),
 	}
 }
 
+func checkContainerRegistryNameAvailability(client *operation.OperationClient, subscriptionId commonids.SubscriptionId) nameavailability.CheckFunc {
+	return func(ctx context.Context, name string) (*nameavailability.Result, error) {
+		input := operation.RegistryNameCheckRequest{
+			Name: name,
+			Type: operation.ContainerRegistryResourceTypeMicrosoftPointContainerRegistryRegistries,
+		}
+		resp, err := client.RegistriesCheckNameAvailability(ctx, subscriptionId, input)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Model == nil || resp.Model.NameAvailable == nil {
+			return nil, fmt.Errorf("model was nil")
+		}
+
+		return &nameavailability.Result{
+			Available: *resp.Model.NameAvailable,
+			Message:   pointer.From(resp.Model.Message),
+		}, nil
+	}
+}
+
 func resourceContainerRegistryCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Containers.ContainerRegistryClient_v2021_08_01_preview.Registries
 	operationClient := meta.(*clients.Client).Containers.ContainerRegistryClient_v2021_08_01_preview.Operation
@@ -27,8 +27,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/computequota"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceskus"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
@@ -83,10 +85,134 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("upgrade_settings.0.drain_timeout_in_minutes", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old != 0 && new == 0
 			}),
+			validateKubernetesClusterNodePoolSkuAvailability,
+			validateKubernetesClusterNodePoolComputeQuota,
 		),
 	}
 }
 
+// validateKubernetesClusterNodePoolSkuAvailability validates that the `vm_size` (and `zones`) of the Node Pool are
+// available within the Location of the Kubernetes Cluster during the plan, when Enhanced Validation is enabled
+func validateKubernetesClusterNodePoolSkuAvailability(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if !resourceskus.ShouldValidate(diff, "kubernetes_cluster_id", "vm_size", "zones") {
+		return nil
+	}
+
+	clusterId, err := commonids.ParseKubernetesClusterID(diff.Get("kubernetes_cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*clients.Client)
+	loc, err := kubernetesClusterNodePoolLocation(ctx, client, *clusterId)
+	if err != nil {
+		return err
+	}
+	// the Location of the Kubernetes Cluster is needed to validate the SKU, so this is best-effort
+	if loc == "" {
+		return nil
+	}
+
+	return resourceskus.EnhancedValidate(ctx, client.Compute.SkusClient, clusterId.SubscriptionId, resourceskus.Input{
+		ResourceType: resourceskus.ResourceTypeVirtualMachines,
+		Location:     loc,
+		SkuName:      diff.Get("vm_size").(string),
+		Zones:        zones.ExpandUntyped(diff.Get("zones").(*pluginsdk.Set).List()),
+	})
+}
+
+// validateKubernetesClusterNodePoolComputeQuota checks that enough vCPU quota is available for the nodes of a new or
+// scaled Node Pool during the plan, when the `check_during_plan` feature within the `compute_quota` block is enabled.
+func validateKubernetesClusterNodePoolComputeQuota(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client)
+	if !client.Features.ComputeQuota.CheckDuringPlan {
+		return nil
+	}
+
+	autoScalingField := "enable_auto_scaling"
+	if features.FourPointOh() {
+		autoScalingField = "auto_scaling_enabled"
+	}
+
+	for _, field := range []string{"kubernetes_cluster_id", "vm_size", "priority", autoScalingField} {
+		if !diff.NewValueKnown(field) {
+			return nil
+		}
+	}
+
+	if diff.Id() != "" && !diff.HasChanges("vm_size", "node_count", "min_count", autoScalingField) {
+		return nil
+	}
+
+	// when auto-scaling is enabled the Node Pool is provisioned with (at least) the minimum number of nodes
+	nodeCountKnown := diff.NewValueKnown("node_count")
+	oldNodeCount, newNodeCount := diff.GetChange("node_count")
+	previousNodes, nodes := int64(oldNodeCount.(int)), int64(newNodeCount.(int))
+	if diff.Get(autoScalingField).(bool) && diff.NewValueKnown("min_count") {
+		if minCount := int64(diff.Get("min_count").(int)); !nodeCountKnown || minCount > nodes {
+			nodes = minCount
+			nodeCountKnown = true
+		}
+	}
+	if !nodeCountKnown {
+		return nil
+	}
+
+	clusterId, err := commonids.ParseKubernetesClusterID(diff.Get("kubernetes_cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	loc, err := kubernetesClusterNodePoolLocation(ctx, client, *clusterId)
+	if err != nil {
+		return err
+	}
+	if loc == "" {
+		return nil
+	}
+
+	resourceKey := diff.Id()
+	if resourceKey == "" {
+		resourceKey = fmt.Sprintf("%s/agentPools/%s", clusterId.ID(), diff.Get("name").(string))
+	}
+
+	oldSize, newSize := diff.GetChange("vm_size")
+	input := computequota.Input{
+		ResourceKey: resourceKey,
+		Location:    loc,
+		Size:        newSize.(string),
+		Instances:   nodes,
+		Spot:        diff.Get("priority").(string) == string(agentpools.ScaleSetPrioritySpot),
+	}
+	if diff.Id() != "" {
+		input.PreviousSize = oldSize.(string)
+		input.PreviousInstances = previousNodes
+	}
+
+	quotaClients := computequota.Clients{
+		SkusClient:   client.Compute.SkusClient,
+		UsagesClient: client.Compute.UsagesClient,
+	}
+	return computequota.ValidateDuringPlan(ctx, client.Features, quotaClients, clusterId.SubscriptionId, input)
+}
+
+// kubernetesClusterNodePoolLocation returns the Location of the Kubernetes Cluster which the Node Pool belongs to,
+// or an empty string when the Kubernetes Cluster doesn't exist yet.
+func kubernetesClusterNodePoolLocation(ctx context.Context, client *clients.Client, clusterId commonids.KubernetesClusterId) (string, error) {
+	cluster, err := client.Containers.KubernetesClustersClient.Get(ctx, clusterId)
+	if err != nil {
+		if response.WasNotFound(cluster.HttpResponse) {
+			return "", nil
+		}
+		return "", fmt.Errorf("retrieving %s: %+v", clusterId, err)
+	}
+	if cluster.Model == nil {
+		return "", nil
+	}
+
+	return cluster.Model.Location, nil
+}
+
 func resourceKubernetesClusterNodePoolSchema() map[string]*pluginsdk.Schema {
 	s := map[string]*pluginsdk.Schema{
 		"name": {
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/agentpools"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ action.ActionWithConfigure = &KubernetesClusterNodePoolUpgradeAction{}
+
+func NewKubernetesClusterNodePoolUpgradeAction() action.Action {
+	return &KubernetesClusterNodePoolUpgradeAction{}
+}
+
+type KubernetesClusterNodePoolUpgradeAction struct {
+	sdk.ActionMetadata
+}
+
+type KubernetesClusterNodePoolUpgradeActionModel struct {
+	KubernetesClusterNodePoolId types.String `tfsdk:"kubernetes_cluster_node_pool_id"`
+	OrchestratorVersion         types.String `tfsdk:"orchestrator_version"`
+}
+
+func (a *KubernetesClusterNodePoolUpgradeAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_kubernetes_cluster_node_pool_upgrade"
+}
+
+func (a *KubernetesClusterNodePoolUpgradeAction) Configure(_ context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(request, response)
+	// upgrading a Node Pool replaces each of the Nodes, which can take a considerable amount of time
+	a.TimeoutInvoke = 90 * time.Minute
+}
+
+func (a *KubernetesClusterNodePoolUpgradeAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Upgrades the Node Image, or the Kubernetes Version, used by a Kubernetes Cluster Node Pool.",
+		Attributes: map[string]schema.Attribute{
+			"kubernetes_cluster_node_pool_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Kubernetes Cluster Node Pool which should be upgraded.",
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: agentpools.ValidateAgentPoolID,
+					},
+				},
+			},
+
+			"orchestrator_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Kubernetes Version which the Node Pool should be upgraded to. When omitted only the Node Image is upgraded to the latest version.",
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *KubernetesClusterNodePoolUpgradeAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	containersClient := a.Client.Containers
+	client := a.Client.Containers.AgentPoolsClient
+
+	ctx, cancel := a.InvokeContext(ctx)
+	defer cancel()
+
+	var data KubernetesClusterNodePoolUpgradeActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := agentpools.ParseAgentPoolID(data.KubernetesClusterNodePoolId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("parsing `kubernetes_cluster_node_pool_id`", err.Error())
+		return
+	}
+
+	orchestratorVersion := data.OrchestratorVersion.ValueString()
+	if orchestratorVersion == "" {
+		a.SendProgress(response, "upgrading the Node Image for %s..", *id)
+		if err := client.UpgradeNodeImageVersionThenPoll(ctx, *id); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("upgrading the Node Image for %s", *id), err.Error())
+			return
+		}
+
+		a.SendProgress(response, "upgraded the Node Image for %s", *id)
+		return
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("retrieving %s", *id), err.Error())
+		return
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		response.Diagnostics.AddError(fmt.Sprintf("retrieving %s", *id), "`properties` was nil")
+		return
+	}
+
+	currentOrchestratorVersion := pointer.From(existing.Model.Properties.CurrentOrchestratorVersion)
+	if err := validateNodePoolSupportsVersion(ctx, containersClient, currentOrchestratorVersion, *id, orchestratorVersion); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("validating the Kubernetes Version for %s", *id), err.Error())
+		return
+	}
+
+	a.SendProgress(response, "upgrading %s from Kubernetes Version %q to %q..", *id, currentOrchestratorVersion, orchestratorVersion)
+	existing.Model.Properties.OrchestratorVersion = pointer.To(orchestratorVersion)
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, *existing.Model); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("upgrading %s to Kubernetes Version %q", *id, orchestratorVersion), err.Error())
+		return
+	}
+
+	a.SendProgress(response, "upgraded %s to Kubernetes Version %q", *id, orchestratorVersion)
+}
@@ -4,6 +4,7 @@
 package containers
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -13,8 +14,9 @@ type Registration struct {
 }
 
 var (
-	_ sdk.TypedServiceRegistration   = Registration{}
-	_ sdk.UntypedServiceRegistration = Registration{}
+	_ sdk.TypedServiceRegistration                = Registration{}
+	_ sdk.UntypedServiceRegistration              = Registration{}
+	_ sdk.FrameworkServiceRegistrationWithActions = Registration{}
 )
 
 // Name is the name of this Service
@@ -83,3 +85,10 @@ func (r Registration) Resources() []sdk.Resource {
 	resources = append(resources, r.autoRegistration.Resources()...)
 	return resources
 }
+
+// Actions returns the Actions supported by this Service
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{
+		NewKubernetesClusterNodePoolUpgradeAction,
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/nameavailability"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
@@ -169,6 +170,11 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 				}
 				return nil
 			}),
+
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				client := v.(*clients.Client)
+				return nameavailability.ValidateDuringPlan(ctx, diff, client.Features, "CosmosDB Account", checkCosmosDbAccountNameAvailability(client.Cosmos.CosmosDBClient))
+			}),
 		),
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -857,6 +863,25 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 	return resource
 }
 
+func checkCosmosDbAccountNameAvailability(client *cosmosdb.CosmosDBClient) nameavailability.CheckFunc {
+	return func(ctx context.Context, name string) (*nameavailability.Result, error) {
+		resp, err := client.DatabaseAccountsCheckNameExists(ctx, cosmosdb.NewDatabaseAccountNameID(name))
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return &nameavailability.Result{
+					Available: true,
+				}, nil
+			}
+			return nil, err
+		}
+
+		return &nameavailability.Result{
+			Available: false,
+			Message:   "a CosmosDB Account with this name already exists",
+		}, nil
+	}
+}
+
 func resourceCosmosDbAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Cosmos.CosmosDBClient
 	databaseClient := meta.(*clients.Client).Cosmos.DatabaseClient
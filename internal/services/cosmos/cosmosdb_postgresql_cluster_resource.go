@@ -22,31 +22,33 @@ import (
 var CosmosDbPostgreSQLClusterResourceName = "azurerm_cosmosdb_postgresql_cluster"
 
 type CosmosDbPostgreSQLClusterModel struct {
-	Name                             string              `tfschema:"name"`
-	ResourceGroupName                string              `tfschema:"resource_group_name"`
-	Location                         string              `tfschema:"location"`
-	AdministratorLoginPassword       string              `tfschema:"administrator_login_password"`
-	CitusVersion                     string              `tfschema:"citus_version"`
-	CoordinatorPublicIPAccessEnabled bool                `tfschema:"coordinator_public_ip_access_enabled"`
-	CoordinatorServerEdition         string              `tfschema:"coordinator_server_edition"`
-	CoordinatorStorageQuotaInMb      int64               `tfschema:"coordinator_storage_quota_in_mb"`
-	CoordinatorVCoreCount            int64               `tfschema:"coordinator_vcore_count"`
-	HaEnabled                        bool                `tfschema:"ha_enabled"`
-	ShardsOnCoordinatorEnabled       bool                `tfschema:"shards_on_coordinator_enabled"`
-	SourceLocation                   string              `tfschema:"source_location"`
-	SourceResourceId                 string              `tfschema:"source_resource_id"`
-	MaintenanceWindow                []MaintenanceWindow `tfschema:"maintenance_window"`
-	ServerNames                      []ServerNameItem    `tfschema:"servers"`
-	NodeCount                        int64               `tfschema:"node_count"`
-	NodePublicIPAccessEnabled        bool                `tfschema:"node_public_ip_access_enabled"`
-	NodeServerEdition                string              `tfschema:"node_server_edition"`
-	NodeStorageQuotaInMb             int64               `tfschema:"node_storage_quota_in_mb"`
-	NodeVCores                       int64               `tfschema:"node_vcores"`
-	PointInTimeInUTC                 string              `tfschema:"point_in_time_in_utc"`
-	PreferredPrimaryZone             string              `tfschema:"preferred_primary_zone"`
-	SqlVersion                       string              `tfschema:"sql_version"`
-	Tags                             map[string]string   `tfschema:"tags"`
-	EarliestRestoreTime              string              `tfschema:"earliest_restore_time"`
+	Name                                string              `tfschema:"name"`
+	ResourceGroupName                   string              `tfschema:"resource_group_name"`
+	Location                            string              `tfschema:"location"`
+	AdministratorLoginPassword          string              `tfschema:"administrator_login_password"`
+	AdministratorLoginPasswordWo        string              `tfschema:"administrator_login_password_wo,writeOnly"`
+	AdministratorLoginPasswordWoVersion int64               `tfschema:"administrator_login_password_wo_version"`
+	CitusVersion                        string              `tfschema:"citus_version"`
+	CoordinatorPublicIPAccessEnabled    bool                `tfschema:"coordinator_public_ip_access_enabled"`
+	CoordinatorServerEdition            string              `tfschema:"coordinator_server_edition"`
+	CoordinatorStorageQuotaInMb         int64               `tfschema:"coordinator_storage_quota_in_mb"`
+	CoordinatorVCoreCount               int64               `tfschema:"coordinator_vcore_count"`
+	HaEnabled                           bool                `tfschema:"ha_enabled"`
+	ShardsOnCoordinatorEnabled          bool                `tfschema:"shards_on_coordinator_enabled"`
+	SourceLocation                      string              `tfschema:"source_location"`
+	SourceResourceId                    string              `tfschema:"source_resource_id"`
+	MaintenanceWindow                   []MaintenanceWindow `tfschema:"maintenance_window"`
+	ServerNames                         []ServerNameItem    `tfschema:"servers"`
+	NodeCount                           int64               `tfschema:"node_count"`
+	NodePublicIPAccessEnabled           bool                `tfschema:"node_public_ip_access_enabled"`
+	NodeServerEdition                   string              `tfschema:"node_server_edition"`
+	NodeStorageQuotaInMb                int64               `tfschema:"node_storage_quota_in_mb"`
+	NodeVCores                          int64               `tfschema:"node_vcores"`
+	PointInTimeInUTC                    string              `tfschema:"point_in_time_in_utc"`
+	PreferredPrimaryZone                string              `tfschema:"preferred_primary_zone"`
+	SqlVersion                          string              `tfschema:"sql_version"`
+	Tags                                map[string]string   `tfschema:"tags"`
+	EarliestRestoreTime                 string              `tfschema:"earliest_restore_time"`
 }
 
 type ServerNameItem struct {
@@ -99,10 +101,27 @@ func (r CosmosDbPostgreSQLClusterResource) Arguments() map[string]*pluginsdk.Sch
 		},
 
 		"administrator_login_password": {
-			Type:         pluginsdk.TypeString,
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ValidateFunc:  validation.StringLenBetween(8, 256),
+			ConflictsWith: []string{"administrator_login_password_wo"},
+		},
+
+		"administrator_login_password_wo": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			WriteOnly:     true,
+			Sensitive:     true,
+			ValidateFunc:  validation.StringLenBetween(8, 256),
+			ConflictsWith: []string{"administrator_login_password"},
+			RequiredWith:  []string{"administrator_login_password_wo_version"},
+		},
+
+		"administrator_login_password_wo_version": {
+			Type:         pluginsdk.TypeInt,
 			Optional:     true,
-			Sensitive:    true,
-			ValidateFunc: validation.StringLenBetween(8, 256),
+			RequiredWith: []string{"administrator_login_password_wo"},
 		},
 
 		"citus_version": {
@@ -351,10 +370,15 @@ func (r CosmosDbPostgreSQLClusterResource) Create() sdk.ResourceFunc {
 				return metadata.ResourceRequiresImport(r.ResourceType(), id)
 			}
 
+			adminPassword := model.AdministratorLoginPassword
+			if model.AdministratorLoginPasswordWo != "" {
+				adminPassword = model.AdministratorLoginPasswordWo
+			}
+
 			parameters := &clusters.Cluster{
 				Location: location.Normalize(model.Location),
 				Properties: &clusters.ClusterProperties{
-					AdministratorLoginPassword:      &model.AdministratorLoginPassword,
+					AdministratorLoginPassword:      &adminPassword,
 					CoordinatorEnablePublicIPAccess: &model.CoordinatorPublicIPAccessEnabled,
 					CoordinatorServerEdition:        &model.CoordinatorServerEdition,
 					CoordinatorStorageQuotaInMb:     &model.CoordinatorStorageQuotaInMb,
@@ -401,8 +425,8 @@ func (r CosmosDbPostgreSQLClusterResource) Create() sdk.ResourceFunc {
 			switch {
 			case model.SourceResourceId != "":
 				parameters.Properties.SourceResourceId = &model.SourceResourceId
-			case model.AdministratorLoginPassword == "":
-				return fmt.Errorf("`administrator_login_password` is required when `source_resource_id` isn't set")
+			case adminPassword == "":
+				return fmt.Errorf("one of `administrator_login_password` or `administrator_login_password_wo` is required when `source_resource_id` isn't set")
 			case model.CoordinatorStorageQuotaInMb == 0:
 				return fmt.Errorf("`coordinator_storage_quota_in_mb` is required when `source_resource_id` isn't set")
 			case model.CoordinatorVCoreCount == 0:
@@ -451,12 +475,26 @@ func (r CosmosDbPostgreSQLClusterResource) Update() sdk.ResourceFunc {
 				Properties: &clusters.ClusterPropertiesForUpdate{},
 			}
 
+			// when switching between `administrator_login_password` and `administrator_login_password_wo` both change,
+			// as such the password is only sent when it's been specified - rather than being cleared
 			if metadata.ResourceData.HasChange("administrator_login_password") {
-				if model.SourceResourceId == "" && model.AdministratorLoginPassword == "" {
+				if model.SourceResourceId == "" && model.AdministratorLoginPassword == "" && model.AdministratorLoginPasswordWo == "" {
 					return fmt.Errorf("`administrator_login_password` is required when `source_resource_id` isn't set")
 				}
 
-				parameters.Properties.AdministratorLoginPassword = &model.AdministratorLoginPassword
+				if model.AdministratorLoginPassword != "" {
+					parameters.Properties.AdministratorLoginPassword = &model.AdministratorLoginPassword
+				}
+			}
+
+			if metadata.ResourceData.HasChange("administrator_login_password_wo_version") {
+				if model.SourceResourceId == "" && model.AdministratorLoginPasswordWo == "" && model.AdministratorLoginPassword == "" {
+					return fmt.Errorf("`administrator_login_password_wo` is required when `source_resource_id` isn't set")
+				}
+
+				if model.AdministratorLoginPasswordWo != "" {
+					parameters.Properties.AdministratorLoginPassword = &model.AdministratorLoginPasswordWo
+				}
 			}
 
 			if metadata.ResourceData.HasChange("citus_version") {
@@ -573,6 +611,7 @@ func (r CosmosDbPostgreSQLClusterResource) Read() sdk.ResourceFunc {
 
 			if props := model.Properties; props != nil {
 				state.AdministratorLoginPassword = metadata.ResourceData.Get("administrator_login_password").(string)
+				state.AdministratorLoginPasswordWoVersion = int64(metadata.ResourceData.Get("administrator_login_password_wo_version").(int))
 				state.SourceResourceId = metadata.ResourceData.Get("source_resource_id").(string)
 				state.SourceLocation = metadata.ResourceData.Get("source_location").(string)
 				state.PointInTimeInUTC = metadata.ResourceData.Get("point_in_time_in_utc").(string)
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	vaults20230701 "github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/vaults"
+)
+
+// ListKeyVaults returns the Key Vaults within the specified Subscription - or within the specified
+// Resource Group when `resourceGroupName` isn't empty.
+func (c *Client) ListKeyVaults(ctx context.Context, subscriptionId commonids.SubscriptionId, resourceGroupName string) ([]vaults20230701.Vault, error) {
+	if resourceGroupName != "" {
+		resourceGroupId := commonids.NewResourceGroupID(subscriptionId.SubscriptionId, resourceGroupName)
+		resp, err := c.vaults20230701Client.ListByResourceGroupComplete(ctx, resourceGroupId, vaults20230701.DefaultListByResourceGroupOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("listing the Key Vaults within %s: %+v", resourceGroupId, err)
+		}
+		return resp.Items, nil
+	}
+
+	resp, err := c.vaults20230701Client.ListBySubscriptionComplete(ctx, subscriptionId, vaults20230701.DefaultListBySubscriptionOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing the Key Vaults within %s: %+v", subscriptionId, err)
+	}
+	return resp.Items, nil
+}
@@ -166,24 +166,46 @@ func dataSourceArmKeyVaultCertificateDataRead(d *pluginsdk.ResourceData, meta in
 		return fmt.Errorf("retrieving certificate %q from keyvault: %+v", id.Name, err)
 	}
 
+	certs, key, certificatesCount, err := convertKeyVaultCertificateSecretToPEM(id.Name, pfx.ContentType, pfx.Value)
+	if err != nil {
+		return err
+	}
+
+	d.Set("pem", certs)
+	d.Set("key", key)
+	d.Set("certificates_count", certificatesCount)
+
+	return tags.FlattenAndSet(d, cert.Tags)
+}
+
+// convertKeyVaultCertificateSecretToPEM converts the Secret backing a Key Vault Certificate (either a PKCS#12
+// bundle or a PEM file) into the PEM-encoded Certificates and Private Key, also returning the number of Certificates
+func convertKeyVaultCertificateSecretToPEM(name string, contentTypePtr *string, valuePtr *string) (string, string, int, error) {
+	if contentTypePtr == nil || valuePtr == nil {
+		return "", "", 0, fmt.Errorf("the Secret for Certificate %q had no content type or value", name)
+	}
+	contentType := *contentTypePtr
+	value := *valuePtr
+
+	var err error
 	var PEMBlocks []*pem.Block
 
-	if *pfx.ContentType == "application/x-pkcs12" {
-		bytes, err := base64.StdEncoding.DecodeString(*pfx.Value)
+	if contentType == "application/x-pkcs12" {
+		bytes, err := base64.StdEncoding.DecodeString(value)
 		if err != nil {
-			return fmt.Errorf("decoding base64 certificate (%q): %+v", id.Name, err)
+			return "", "", 0, fmt.Errorf("decoding base64 certificate (%q): %+v", name, err)
 		}
 
 		// note PFX passwords are set to an empty string in Key Vault, this include password protected PFX uploads.
 		blocks, err := pkcs12.ToPEM(bytes, "")
 		if err != nil {
-			return fmt.Errorf("decoding certificate (%q): %+v", id.Name, err)
+			return "", "", 0, fmt.Errorf("decoding certificate (%q): %+v", name, err)
 		}
 		PEMBlocks = blocks
 	} else {
-		block, rest := pem.Decode([]byte(*pfx.Value))
+		block, rest := pem.Decode([]byte(value))
 		if block == nil {
-			return fmt.Errorf("decoding certificate (%q): %+v", id.Name, err)
+			return "", "", 0, fmt.Errorf("decoding certificate (%q): %+v", name, err)
 		}
 		PEMBlocks = append(PEMBlocks, block)
 		for len(rest) > 0 {
@@ -208,13 +230,13 @@ func dataSourceArmKeyVaultCertificateDataRead(d *pluginsdk.ResourceData, meta in
 
 	var privateKey interface{}
 
-	if *pfx.ContentType == "application/x-pkcs12" {
+	if contentType == "application/x-pkcs12" {
 		rsakey, err := x509.ParsePKCS1PrivateKey(pemKey)
 		if err != nil {
 			// try to parse as a EC key
 			eckey, err := x509.ParseECPrivateKey(pemKey)
 			if err != nil {
-				return fmt.Errorf("decoding private key: not RSA or ECDSA type (%q): %+v", id.Name, err)
+				return "", "", 0, fmt.Errorf("decoding private key: not RSA or ECDSA type (%q): %+v", name, err)
 			}
 			privateKey = eckey
 		} else {
@@ -223,7 +245,7 @@ func dataSourceArmKeyVaultCertificateDataRead(d *pluginsdk.ResourceData, meta in
 	} else {
 		pkey, err := x509.ParsePKCS8PrivateKey(pemKey)
 		if err != nil {
-			return fmt.Errorf("decoding PKCS8 RSA private key (%q): %+v", id.Name, err)
+			return "", "", 0, fmt.Errorf("decoding PKCS8 RSA private key (%q): %+v", name, err)
 		}
 		privateKey = pkey
 	}
@@ -235,14 +257,14 @@ func dataSourceArmKeyVaultCertificateDataRead(d *pluginsdk.ResourceData, meta in
 		case *ecdsa.PrivateKey:
 			keyX509, err = x509.MarshalECPrivateKey(privateKey.(*ecdsa.PrivateKey))
 			if err != nil {
-				return fmt.Errorf("marshalling private key type %+v (%q): %+v", v, id.Name, err)
+				return "", "", 0, fmt.Errorf("marshalling private key type %+v (%q): %+v", v, name, err)
 			}
 			pemKeyHeader = "EC PRIVATE KEY"
 		case *rsa.PrivateKey:
 			keyX509 = x509.MarshalPKCS1PrivateKey(privateKey.(*rsa.PrivateKey))
 			pemKeyHeader = "RSA PRIVATE KEY"
 		default:
-			return fmt.Errorf("marshalling private key type %+v (%q): key type is not supported", v, id.Name)
+			return "", "", 0, fmt.Errorf("marshalling private key type %+v (%q): key type is not supported", v, name)
 		}
 	}
 
@@ -255,7 +277,7 @@ func dataSourceArmKeyVaultCertificateDataRead(d *pluginsdk.ResourceData, meta in
 	var keyPEM bytes.Buffer
 	err = pem.Encode(&keyPEM, keyBlock)
 	if err != nil {
-		return fmt.Errorf("encoding Key Vault Certificate Key: %+v", err)
+		return "", "", 0, fmt.Errorf("encoding Key Vault Certificate Key: %+v", err)
 	}
 
 	certs := ""
@@ -269,14 +291,10 @@ func dataSourceArmKeyVaultCertificateDataRead(d *pluginsdk.ResourceData, meta in
 		var certPEM bytes.Buffer
 		err = pem.Encode(&certPEM, certBlock)
 		if err != nil {
-			return fmt.Errorf("encoding Key Vault Certificate PEM: %+v", err)
+			return "", "", 0, fmt.Errorf("encoding Key Vault Certificate PEM: %+v", err)
 		}
 		certs += certPEM.String()
 	}
 
-	d.Set("pem", certs)
-	d.Set("key", keyPEM.String())
-	d.Set("certificates_count", len(pemCerts))
-
-	return tags.FlattenAndSet(d, cert.Tags)
+	return certs, keyPEM.String(), len(pemCerts), nil
 }
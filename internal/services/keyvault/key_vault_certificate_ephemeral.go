@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &KeyVaultCertificateEphemeralResource{}
+
+func NewKeyVaultCertificateEphemeralResource() ephemeral.EphemeralResource {
+	return &KeyVaultCertificateEphemeralResource{}
+}
+
+type KeyVaultCertificateEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type KeyVaultCertificateEphemeralResourceModel struct {
+	Name              types.String `tfsdk:"name"`
+	KeyVaultId        types.String `tfsdk:"key_vault_id"`
+	Version           types.String `tfsdk:"version"`
+	Hex               types.String `tfsdk:"hex"`
+	Pem               types.String `tfsdk:"pem"`
+	Key               types.String `tfsdk:"key"`
+	CertificatesCount types.Int64  `tfsdk:"certificates_count"`
+	ExpirationDate    types.String `tfsdk:"expiration_date"`
+	NotBeforeDate     types.String `tfsdk:"not_before_date"`
+}
+
+func (e *KeyVaultCertificateEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_certificate"
+}
+
+func (e *KeyVaultCertificateEphemeralResource) Configure(_ context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	e.Defaults(request, response)
+}
+
+func (e *KeyVaultCertificateEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: keyVaultValidate.NestedItemName,
+					},
+				},
+			},
+
+			"key_vault_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: commonids.ValidateKeyVaultID,
+					},
+				},
+			},
+
+			"version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+
+			"hex": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"pem": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"certificates_count": schema.Int64Attribute{
+				Computed: true,
+			},
+
+			"expiration_date": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"not_before_date": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *KeyVaultCertificateEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	if !e.ClientIsConfigured(&response.Diagnostics) {
+		return
+	}
+
+	keyVaultsClient := e.Client.KeyVault
+	client := e.Client.KeyVault.ManagementClient
+
+	ctx, cancel := e.OpenContext(ctx)
+	defer cancel()
+
+	var data KeyVaultCertificateEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	keyVaultId, err := commonids.ParseKeyVaultID(data.KeyVaultId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("parsing `key_vault_id`", err.Error())
+		return
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("looking up Base URI for Certificate %q in %s", data.Name.ValueString(), *keyVaultId), err.Error())
+		return
+	}
+
+	cert, err := client.GetCertificate(ctx, *keyVaultBaseUri, data.Name.ValueString(), data.Version.ValueString())
+	if err != nil {
+		if utils.ResponseWasNotFound(cert.Response) {
+			response.Diagnostics.AddError(fmt.Sprintf("retrieving Certificate %q (Key Vault URI %q)", data.Name.ValueString(), *keyVaultBaseUri), "the Certificate was not found")
+			return
+		}
+		response.Diagnostics.AddError(fmt.Sprintf("retrieving Certificate %q (Key Vault URI %q)", data.Name.ValueString(), *keyVaultBaseUri), err.Error())
+		return
+	}
+
+	if cert.ID == nil {
+		response.Diagnostics.AddError(fmt.Sprintf("retrieving Certificate %q (Key Vault URI %q)", data.Name.ValueString(), *keyVaultBaseUri), "`id` was nil")
+		return
+	}
+
+	// the version may differ when one isn't specified, so parse the returned id
+	id, err := parse.ParseNestedItemID(*cert.ID)
+	if err != nil {
+		response.Diagnostics.AddError("parsing Certificate ID", err.Error())
+		return
+	}
+
+	data.Version = types.StringValue(id.Version)
+
+	certificateData := ""
+	if contents := cert.Cer; contents != nil {
+		certificateData = strings.ToUpper(hex.EncodeToString(*contents))
+	}
+	data.Hex = types.StringValue(certificateData)
+
+	data.ExpirationDate = types.StringNull()
+	data.NotBeforeDate = types.StringNull()
+	if attributes := cert.Attributes; attributes != nil {
+		if notBefore := attributes.NotBefore; notBefore != nil {
+			data.NotBeforeDate = types.StringValue(time.Time(*notBefore).Format(time.RFC3339))
+		}
+		if expires := attributes.Expires; expires != nil {
+			data.ExpirationDate = types.StringValue(time.Time(*expires).Format(time.RFC3339))
+		}
+	}
+
+	secret, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("retrieving the Secret for Certificate %q (Key Vault URI %q)", id.Name, id.KeyVaultBaseUrl), err.Error())
+		return
+	}
+
+	certs, key, certificatesCount, err := convertKeyVaultCertificateSecretToPEM(id.Name, secret.ContentType, secret.Value)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("converting Certificate %q (Key Vault URI %q)", id.Name, id.KeyVaultBaseUrl), err.Error())
+		return
+	}
+
+	data.Pem = types.StringValue(certs)
+	data.Key = types.StringValue(key)
+	data.CertificatesCount = types.Int64Value(int64(certificatesCount))
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
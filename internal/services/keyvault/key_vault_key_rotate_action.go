@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+)
+
+var _ action.ActionWithConfigure = &KeyVaultKeyRotateAction{}
+
+func NewKeyVaultKeyRotateAction() action.Action {
+	return &KeyVaultKeyRotateAction{}
+}
+
+type KeyVaultKeyRotateAction struct {
+	sdk.ActionMetadata
+}
+
+type KeyVaultKeyRotateActionModel struct {
+	KeyVaultKeyId types.String `tfsdk:"key_vault_key_id"`
+}
+
+func (a *KeyVaultKeyRotateAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_key_rotate"
+}
+
+func (a *KeyVaultKeyRotateAction) Configure(_ context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(request, response)
+}
+
+func (a *KeyVaultKeyRotateAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Rotates a Key Vault Key, creating a new version of the Key.",
+		Attributes: map[string]schema.Attribute{
+			"key_vault_key_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Key Vault Key which should be rotated, either with or without a version.",
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *KeyVaultKeyRotateAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.KeyVault.ManagementClient
+
+	ctx, cancel := a.InvokeContext(ctx)
+	defer cancel()
+
+	var data KeyVaultKeyRotateActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parse.ParseOptionallyVersionedNestedItemID(data.KeyVaultKeyId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("parsing `key_vault_key_id`", err.Error())
+		return
+	}
+	if id.NestedItemType != parse.NestedItemTypeKey {
+		response.Diagnostics.AddAttributeError(path.Root("key_vault_key_id"), "invalid `key_vault_key_id`", fmt.Sprintf("expected the ID of a Key but got the ID of a %q", string(id.NestedItemType)))
+		return
+	}
+
+	a.SendProgress(response, "rotating Key %q (Key Vault %q)..", id.Name, id.KeyVaultBaseUrl)
+
+	resp, err := client.RotateKey(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("rotating Key %q (Key Vault %q)", id.Name, id.KeyVaultBaseUrl), err.Error())
+		return
+	}
+
+	version := ""
+	if resp.Key != nil && resp.Key.Kid != nil {
+		if rotatedId, err := parse.ParseNestedItemID(*resp.Key.Kid); err == nil {
+			version = rotatedId.Version
+		}
+	}
+
+	a.SendProgress(response, "rotated Key %q (Key Vault %q) - the new version is %q", id.Name, id.KeyVaultBaseUrl, version)
+}
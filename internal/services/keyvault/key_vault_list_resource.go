@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var (
+	_ list.ListResourceWithConfigure        = &KeyVaultListResource{}
+	_ list.ListResourceWithRawV5Schemas     = &KeyVaultListResource{}
+	_ sdk.ListResourceWithPluginSdkResource = &KeyVaultListResource{}
+)
+
+func NewKeyVaultListResource() list.ListResource {
+	return &KeyVaultListResource{}
+}
+
+type KeyVaultListResource struct {
+	sdk.ListResourceMetadata
+}
+
+func (r *KeyVaultListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault"
+}
+
+func (r *KeyVaultListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	r.Defaults(request, response)
+}
+
+func (r *KeyVaultListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = sdk.ListResourceFilterSchema(true)
+}
+
+func (r *KeyVaultListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	r.PluginSdkRawV5Schemas(ctx, response)
+}
+
+func (r *KeyVaultListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var filter sdk.ListResourceWithResourceGroupFilterModel
+	if diags := request.Config.Get(ctx, &filter); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = r.PluginSdkListResults(ctx, request, func(ctx context.Context) ([]resourceids.ResourceId, error) {
+		subscriptionId := commonids.NewSubscriptionID(r.SubscriptionId)
+		items, err := r.Client.KeyVault.ListKeyVaults(ctx, subscriptionId, filter.ResourceGroupName.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]resourceids.ResourceId, 0)
+		for _, item := range items {
+			if item.Id == nil || !filter.Matches(ctx, pointer.From(item.Location), item.Tags) {
+				continue
+			}
+
+			id, err := commonids.ParseKeyVaultIDInsensitively(*item.Id)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+
+		return ids, nil
+	})
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/nameavailability"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
@@ -43,10 +44,12 @@ func resourceKeyVault() *pluginsdk.Resource {
 		Update: resourceKeyVaultUpdate,
 		Delete: resourceKeyVaultDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingIdentity(&commonids.KeyVaultId{}, pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := commonids.ParseKeyVaultID(id)
 			return err
-		}),
+		})),
+
+		Identity: pluginsdk.ResourceIdentityForResourceId(&commonids.KeyVaultId{}),
 
 		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -54,6 +57,13 @@ func resourceKeyVault() *pluginsdk.Resource {
 			1: migration.KeyVaultV1ToV2{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			client := v.(*clients.Client)
+			subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+			location := location.Normalize(d.Get("location").(string))
+			return nameavailability.ValidateDuringPlan(ctx, d, client.Features, "Key Vault", checkKeyVaultNameAvailability(client.KeyVault.VaultsClient, subscriptionId, location, client.Features.KeyVault.RecoverSoftDeletedKeyVaults))
+		}),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -246,6 +256,41 @@ func resourceKeyVault() *pluginsdk.Resource {
 	return resource
 }
 
+func checkKeyVaultNameAvailability(client *vaults.VaultsClient, subscriptionId commonids.SubscriptionId, location string, recoverSoftDeleted bool) nameavailability.CheckFunc {
+	return func(ctx context.Context, name string) (*nameavailability.Result, error) {
+		input := vaults.VaultCheckNameAvailabilityParameters{
+			Name: name,
+			Type: vaults.TypeMicrosoftPointKeyVaultVaults,
+		}
+		resp, err := client.CheckNameAvailability(ctx, subscriptionId, input)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Model == nil || resp.Model.NameAvailable == nil {
+			return nil, fmt.Errorf("model was nil")
+		}
+
+		result := nameavailability.Result{
+			Available: *resp.Model.NameAvailable,
+			Message:   pointer.From(resp.Model.Message),
+		}
+
+		// a Soft-Deleted Key Vault within this Subscription holds on to the name, but is recovered during creation when opted in
+		if !result.Available && recoverSoftDeleted && location != "" {
+			deletedVaultId := vaults.NewDeletedVaultID(subscriptionId.SubscriptionId, location, name)
+			softDeletedKeyVault, err := client.GetDeleted(ctx, deletedVaultId)
+			if err != nil && !response.WasNotFound(softDeletedKeyVault.HttpResponse) && !response.WasStatusCode(softDeletedKeyVault.HttpResponse, http.StatusForbidden) {
+				return nil, fmt.Errorf("checking for the presence of an existing Soft-Deleted Key Vault %q (Location %q): %+v", name, location, err)
+			}
+			if err == nil {
+				result.Available = true
+			}
+		}
+
+		return &result, nil
+	}
+}
+
 func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	managementClient := meta.(*clients.Client).KeyVault.ManagementClient // TODO: Remove in 4.0
@@ -304,7 +349,7 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	if !response.WasNotFound(softDeletedKeyVault.HttpResponse) && !response.WasStatusCode(softDeletedKeyVault.HttpResponse, http.StatusForbidden) {
 		if !meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeyVaults {
 			// this exists but the users opted out so they must import this it out-of-band
-			return fmt.Errorf(optedOutOfRecoveringSoftDeletedKeyVaultErrorFmt(id.VaultName, location))
+			return fmt.Errorf("%s", optedOutOfRecoveringSoftDeletedKeyVaultErrorFmt(id.VaultName, location))
 		}
 
 		recoverSoftDeletedKeyVault = true
@@ -714,6 +759,11 @@ func resourceKeyVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	d.Set("name", id.VaultName)
 	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if err := pluginsdk.SetResourceIdentity(d, id); err != nil {
+		return err
+	}
+
 	d.Set("vault_uri", vaultUri)
 
 	publicNetworkAccessEnabled := true
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &KeyVaultSecretEphemeralResource{}
+
+func NewKeyVaultSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &KeyVaultSecretEphemeralResource{}
+}
+
+type KeyVaultSecretEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type KeyVaultSecretEphemeralResourceModel struct {
+	Name           types.String `tfsdk:"name"`
+	KeyVaultId     types.String `tfsdk:"key_vault_id"`
+	Version        types.String `tfsdk:"version"`
+	Value          types.String `tfsdk:"value"`
+	ContentType    types.String `tfsdk:"content_type"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	NotBeforeDate  types.String `tfsdk:"not_before_date"`
+}
+
+func (e *KeyVaultSecretEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_secret"
+}
+
+func (e *KeyVaultSecretEphemeralResource) Configure(_ context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	e.Defaults(request, response)
+}
+
+func (e *KeyVaultSecretEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: keyVaultValidate.NestedItemName,
+					},
+				},
+			},
+
+			"key_vault_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: commonids.ValidateKeyVaultID,
+					},
+				},
+			},
+
+			"version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+
+			"value": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"content_type": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"expiration_date": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"not_before_date": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *KeyVaultSecretEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	if !e.ClientIsConfigured(&response.Diagnostics) {
+		return
+	}
+
+	keyVaultsClient := e.Client.KeyVault
+	client := e.Client.KeyVault.ManagementClient
+
+	ctx, cancel := e.OpenContext(ctx)
+	defer cancel()
+
+	var data KeyVaultSecretEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	keyVaultId, err := commonids.ParseKeyVaultID(data.KeyVaultId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("parsing `key_vault_id`", err.Error())
+		return
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("looking up Base URI for Secret %q in %s", data.Name.ValueString(), *keyVaultId), err.Error())
+		return
+	}
+
+	resp, err := client.GetSecret(ctx, *keyVaultBaseUri, data.Name.ValueString(), data.Version.ValueString())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			response.Diagnostics.AddError(fmt.Sprintf("retrieving Secret %q (Key Vault URI %q)", data.Name.ValueString(), *keyVaultBaseUri), "the Secret was not found")
+			return
+		}
+		response.Diagnostics.AddError(fmt.Sprintf("retrieving Secret %q (Key Vault URI %q)", data.Name.ValueString(), *keyVaultBaseUri), err.Error())
+		return
+	}
+
+	if resp.ID == nil {
+		response.Diagnostics.AddError(fmt.Sprintf("retrieving Secret %q (Key Vault URI %q)", data.Name.ValueString(), *keyVaultBaseUri), "`id` was nil")
+		return
+	}
+
+	// the version may differ when one isn't specified, so parse the returned id
+	id, err := parse.ParseNestedItemID(*resp.ID)
+	if err != nil {
+		response.Diagnostics.AddError("parsing Secret ID", err.Error())
+		return
+	}
+
+	data.Version = types.StringValue(id.Version)
+	data.Value = types.StringPointerValue(resp.Value)
+	data.ContentType = types.StringPointerValue(resp.ContentType)
+	data.ExpirationDate = types.StringNull()
+	data.NotBeforeDate = types.StringNull()
+	if attributes := resp.Attributes; attributes != nil {
+		if notBefore := attributes.NotBefore; notBefore != nil {
+			data.NotBeforeDate = types.StringValue(time.Time(*notBefore).Format(time.RFC3339))
+		}
+		if expires := attributes.Expires; expires != nil {
+			data.ExpirationDate = types.StringValue(time.Time(*expires).Format(time.RFC3339))
+		}
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
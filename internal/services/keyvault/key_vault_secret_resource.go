@@ -56,9 +56,25 @@ func resourceKeyVaultSecret() *pluginsdk.Resource {
 			"key_vault_id": commonschema.ResourceIDReferenceRequiredForceNew(&commonids.KeyVaultId{}),
 
 			"value": {
-				Type:      pluginsdk.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"value", "value_wo"},
+			},
+
+			"value_wo": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"value", "value_wo"},
+				RequiredWith: []string{"value_wo_version"},
+			},
+
+			"value_wo_version": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"value_wo"},
 			},
 
 			"content_type": {
@@ -133,7 +149,10 @@ func resourceKeyVaultSecretCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return tf.ImportAsExistsError("azurerm_key_vault_secret", *existing.ID)
 	}
 
-	value := d.Get("value").(string)
+	value, err := expandKeyVaultSecretValue(d)
+	if err != nil {
+		return err
+	}
 	contentType := d.Get("content_type").(string)
 	t := d.Get("tags").(map[string]interface{})
 
@@ -242,7 +261,10 @@ func resourceKeyVaultSecretUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		return nil
 	}
 
-	value := d.Get("value").(string)
+	value, err := expandKeyVaultSecretValue(d)
+	if err != nil {
+		return err
+	}
 	contentType := d.Get("content_type").(string)
 	t := d.Get("tags").(map[string]interface{})
 
@@ -260,7 +282,7 @@ func resourceKeyVaultSecretUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		secretAttributes.Expires = &expirationUnixTime
 	}
 
-	if d.HasChange("value") {
+	if d.HasChanges("value", "value_wo_version") {
 		// for changing the value of the secret we need to create a new version
 		parameters := keyvault.SecretSetParameters{
 			Value:            utils.String(value),
@@ -356,7 +378,10 @@ func resourceKeyVaultSecretRead(d *pluginsdk.ResourceData, meta interface{}) err
 	}
 
 	d.Set("name", respID.Name)
-	d.Set("value", resp.Value)
+	// when `value_wo` is used the value of the Secret mustn't be persisted into the state
+	if _, ok := d.GetOk("value_wo_version"); !ok {
+		d.Set("value", resp.Value)
+	}
 	d.Set("version", respID.Version)
 	d.Set("content_type", resp.ContentType)
 	d.Set("versionless_id", id.VersionlessID())
@@ -377,6 +402,19 @@ func resourceKeyVaultSecretRead(d *pluginsdk.ResourceData, meta interface{}) err
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
+// expandKeyVaultSecretValue returns the value for this Secret, from either `value` or the Write-Only `value_wo`
+func expandKeyVaultSecretValue(d *pluginsdk.ResourceData) (string, error) {
+	valueWo, err := pluginsdk.GetWriteOnlyString(d, "value_wo")
+	if err != nil {
+		return "", err
+	}
+	if valueWo != "" {
+		return valueWo, nil
+	}
+
+	return d.Get("value").(string), nil
+}
+
 func resourceKeyVaultSecretDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
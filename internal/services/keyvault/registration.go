@@ -4,6 +4,9 @@
 package keyvault
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -11,8 +14,11 @@ import (
 type Registration struct{}
 
 var (
-	_ sdk.TypedServiceRegistrationWithAGitHubLabel   = Registration{}
-	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.TypedServiceRegistrationWithAGitHubLabel           = Registration{}
+	_ sdk.UntypedServiceRegistrationWithAGitHubLabel         = Registration{}
+	_ sdk.FrameworkServiceRegistrationWithEphemeralResources = Registration{}
+	_ sdk.FrameworkServiceRegistrationWithListResources      = Registration{}
+	_ sdk.FrameworkServiceRegistrationWithActions            = Registration{}
 )
 
 func (r Registration) AssociatedGitHubLabel() string {
@@ -71,3 +77,25 @@ func (r Registration) Resources() []sdk.Resource {
 		KeyVaultCertificateContactsResource{},
 	}
 }
+
+// EphemeralResources returns the Ephemeral Resources supported by this Service
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewKeyVaultCertificateEphemeralResource,
+		NewKeyVaultSecretEphemeralResource,
+	}
+}
+
+// ListResources returns the List Resources supported by this Service
+func (r Registration) ListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewKeyVaultListResource,
+	}
+}
+
+// Actions returns the Actions supported by this Service
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{
+		NewKeyVaultKeyRotateAction,
+	}
+}
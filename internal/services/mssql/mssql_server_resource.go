@@ -83,15 +83,31 @@ func resourceMsSqlServer() *pluginsdk.Resource {
 				Computed:     true,
 				ForceNew:     true,
 				AtLeastOneOf: []string{"administrator_login", "azuread_administrator.0.azuread_authentication_only"},
-				RequiredWith: []string{"administrator_login", "administrator_login_password"},
 			},
 
 			"administrator_login_password": {
-				Type:         pluginsdk.TypeString,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				AtLeastOneOf:  []string{"administrator_login_password", "administrator_login_password_wo", "azuread_administrator.0.azuread_authentication_only"},
+				ConflictsWith: []string{"administrator_login_password_wo"},
+				RequiredWith:  []string{"administrator_login", "administrator_login_password"},
+			},
+
+			"administrator_login_password_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				AtLeastOneOf:  []string{"administrator_login_password", "administrator_login_password_wo", "azuread_administrator.0.azuread_authentication_only"},
+				ConflictsWith: []string{"administrator_login_password"},
+				RequiredWith:  []string{"administrator_login", "administrator_login_password_wo_version"},
+			},
+
+			"administrator_login_password_wo_version": {
+				Type:         pluginsdk.TypeInt,
 				Optional:     true,
-				Sensitive:    true,
-				AtLeastOneOf: []string{"administrator_login_password", "azuread_administrator.0.azuread_authentication_only"},
-				RequiredWith: []string{"administrator_login", "administrator_login_password"},
+				RequiredWith: []string{"administrator_login_password_wo"},
 			},
 
 			"azuread_administrator": {
@@ -200,6 +216,8 @@ func resourceMsSqlServer() *pluginsdk.Resource {
 			pluginsdk.CustomizeDiffShim(msSqlMinimumTLSVersionDiff),
 
 			pluginsdk.CustomizeDiffShim(msSqlPasswordChangeWhenAADAuthOnly),
+
+			pluginsdk.CustomizeDiffShim(msSqlAdministratorLoginRequiresPassword),
 		),
 	}
 }
@@ -245,6 +263,14 @@ func resourceMsSqlServerCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		props.Properties.AdministratorLoginPassword = utils.String(v.(string))
 	}
 
+	passwordWo, err := pluginsdk.GetWriteOnlyString(d, "administrator_login_password_wo")
+	if err != nil {
+		return err
+	}
+	if passwordWo != "" {
+		props.Properties.AdministratorLoginPassword = pointer.To(passwordWo)
+	}
+
 	// NOTE: You must set the admin before setting the values of the admin...
 	if azureADAdministrator, ok := d.GetOk("azuread_administrator"); ok {
 		props.Properties.Administrators = expandMsSqlServerAdministrators(azureADAdministrator.([]interface{}))
@@ -372,9 +398,22 @@ func resourceMsSqlServerUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 			payload.Properties.RestrictOutboundNetworkAccess = pointer.To(servers.ServerNetworkAccessFlagEnabled)
 		}
 
+		// when switching between `administrator_login_password` and `administrator_login_password_wo` both change,
+		// as such the password is only sent when it's been specified - rather than being cleared
 		if d.HasChange("administrator_login_password") {
-			adminPassword := d.Get("administrator_login_password").(string)
-			payload.Properties.AdministratorLoginPassword = pointer.To(adminPassword)
+			if adminPassword := d.Get("administrator_login_password").(string); adminPassword != "" {
+				payload.Properties.AdministratorLoginPassword = pointer.To(adminPassword)
+			}
+		}
+
+		if d.HasChange("administrator_login_password_wo_version") {
+			adminPassword, err := pluginsdk.GetWriteOnlyString(d, "administrator_login_password_wo")
+			if err != nil {
+				return err
+			}
+			if adminPassword != "" {
+				payload.Properties.AdministratorLoginPassword = pointer.To(adminPassword)
+			}
 		}
 
 		if d.HasChange("minimum_tls_version") {
@@ -702,10 +741,35 @@ func msSqlMinimumTLSVersionDiff(ctx context.Context, d *pluginsdk.ResourceDiff,
 	return
 }
 
+// msSqlAdministratorLoginRequiresPassword ensures that either `administrator_login_password` or `administrator_login_password_wo`
+// is specified alongside `administrator_login` - since Write-Only fields aren't available in the Plan this can't be
+// expressed using `RequiredWith`, as such the raw configuration is checked instead.
+func msSqlAdministratorLoginRequiresPassword(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	if config.GetAttr("administrator_login").IsNull() {
+		return nil
+	}
+
+	for _, field := range []string{"administrator_login_password", "administrator_login_password_wo"} {
+		if !config.GetAttr(field).IsNull() {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("one of `administrator_login_password` or `administrator_login_password_wo` must be specified when `administrator_login` is specified")
+}
+
 func msSqlPasswordChangeWhenAADAuthOnly(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) (err error) {
 	old, _ := d.GetChange("azuread_administrator.0.azuread_authentication_only")
 	if old.(bool) && d.HasChange("administrator_login_password") {
 		err = fmt.Errorf("`administrator_login_password` cannot be changed once `azuread_administrator.0.azuread_authentication_only = true`")
 	}
+	if old.(bool) && d.HasChange("administrator_login_password_wo_version") {
+		err = fmt.Errorf("`administrator_login_password_wo_version` cannot be changed once `azuread_administrator.0.azuread_authentication_only = true`")
+	}
 	return
 }
@@ -29,29 +29,31 @@ import (
 )
 
 type MsSqlManagedInstanceModel struct {
-	AdministratorLogin           string                              `tfschema:"administrator_login"`
-	AdministratorLoginPassword   string                              `tfschema:"administrator_login_password"`
-	Collation                    string                              `tfschema:"collation"`
-	DnsZonePartnerId             string                              `tfschema:"dns_zone_partner_id"`
-	DnsZone                      string                              `tfschema:"dns_zone"`
-	Fqdn                         string                              `tfschema:"fqdn"`
-	Identity                     []identity.SystemOrUserAssignedList `tfschema:"identity"`
-	LicenseType                  string                              `tfschema:"license_type"`
-	Location                     string                              `tfschema:"location"`
-	MaintenanceConfigurationName string                              `tfschema:"maintenance_configuration_name"`
-	MinimumTlsVersion            string                              `tfschema:"minimum_tls_version"`
-	Name                         string                              `tfschema:"name"`
-	ProxyOverride                string                              `tfschema:"proxy_override"`
-	PublicDataEndpointEnabled    bool                                `tfschema:"public_data_endpoint_enabled"`
-	ResourceGroupName            string                              `tfschema:"resource_group_name"`
-	SkuName                      string                              `tfschema:"sku_name"`
-	StorageAccountType           string                              `tfschema:"storage_account_type"`
-	StorageSizeInGb              int64                               `tfschema:"storage_size_in_gb"`
-	SubnetId                     string                              `tfschema:"subnet_id"`
-	TimezoneId                   string                              `tfschema:"timezone_id"`
-	VCores                       int64                               `tfschema:"vcores"`
-	ZoneRedundantEnabled         bool                                `tfschema:"zone_redundant_enabled"`
-	Tags                         map[string]string                   `tfschema:"tags"`
+	AdministratorLogin                  string                              `tfschema:"administrator_login"`
+	AdministratorLoginPassword          string                              `tfschema:"administrator_login_password"`
+	AdministratorLoginPasswordWo        string                              `tfschema:"administrator_login_password_wo,writeOnly"`
+	AdministratorLoginPasswordWoVersion int64                               `tfschema:"administrator_login_password_wo_version"`
+	Collation                           string                              `tfschema:"collation"`
+	DnsZonePartnerId                    string                              `tfschema:"dns_zone_partner_id"`
+	DnsZone                             string                              `tfschema:"dns_zone"`
+	Fqdn                                string                              `tfschema:"fqdn"`
+	Identity                            []identity.SystemOrUserAssignedList `tfschema:"identity"`
+	LicenseType                         string                              `tfschema:"license_type"`
+	Location                            string                              `tfschema:"location"`
+	MaintenanceConfigurationName        string                              `tfschema:"maintenance_configuration_name"`
+	MinimumTlsVersion                   string                              `tfschema:"minimum_tls_version"`
+	Name                                string                              `tfschema:"name"`
+	ProxyOverride                       string                              `tfschema:"proxy_override"`
+	PublicDataEndpointEnabled           bool                                `tfschema:"public_data_endpoint_enabled"`
+	ResourceGroupName                   string                              `tfschema:"resource_group_name"`
+	SkuName                             string                              `tfschema:"sku_name"`
+	StorageAccountType                  string                              `tfschema:"storage_account_type"`
+	StorageSizeInGb                     int64                               `tfschema:"storage_size_in_gb"`
+	SubnetId                            string                              `tfschema:"subnet_id"`
+	TimezoneId                          string                              `tfschema:"timezone_id"`
+	VCores                              int64                               `tfschema:"vcores"`
+	ZoneRedundantEnabled                bool                                `tfschema:"zone_redundant_enabled"`
+	Tags                                map[string]string                   `tfschema:"tags"`
 }
 
 var _ sdk.Resource = MsSqlManagedInstanceResource{}
@@ -109,9 +111,26 @@ func (r MsSqlManagedInstanceResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"administrator_login_password": {
 			Type:         schema.TypeString,
-			Required:     true,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"administrator_login_password", "administrator_login_password_wo"},
+		},
+
+		"administrator_login_password_wo": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			WriteOnly:    true,
 			Sensitive:    true,
 			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"administrator_login_password", "administrator_login_password_wo"},
+			RequiredWith: []string{"administrator_login_password_wo_version"},
+		},
+
+		"administrator_login_password_wo_version": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			RequiredWith: []string{"administrator_login_password_wo"},
 		},
 
 		"license_type": {
@@ -315,13 +334,18 @@ func (r MsSqlManagedInstanceResource) Create() sdk.ResourceFunc {
 
 			maintenanceConfigId := publicmaintenanceconfigurations.NewPublicMaintenanceConfigurationID(subscriptionId, model.MaintenanceConfigurationName)
 
+			adminPassword := model.AdministratorLoginPassword
+			if model.AdministratorLoginPasswordWo != "" {
+				adminPassword = model.AdministratorLoginPasswordWo
+			}
+
 			parameters := sql.ManagedInstance{
 				Sku:      sku,
 				Identity: r.expandIdentity(model.Identity),
 				Location: pointer.To(location.Normalize(model.Location)),
 				ManagedInstanceProperties: &sql.ManagedInstanceProperties{
 					AdministratorLogin:         pointer.To(model.AdministratorLogin),
-					AdministratorLoginPassword: pointer.To(model.AdministratorLoginPassword),
+					AdministratorLoginPassword: pointer.To(adminPassword),
 					Collation:                  pointer.To(model.Collation),
 					DNSZonePartner:             pointer.To(model.DnsZonePartnerId),
 					LicenseType:                sql.ManagedInstanceLicenseType(model.LicenseType),
@@ -420,10 +444,16 @@ func (r MsSqlManagedInstanceResource) Update() sdk.ResourceFunc {
 				properties.MaintenanceConfigurationID = pointer.To(maintenanceConfigId.ID())
 			}
 
-			if metadata.ResourceData.HasChange("administrator_login_password") {
+			// when switching between `administrator_login_password` and `administrator_login_password_wo` both change,
+			// as such the password is only sent when it's been specified - rather than being cleared
+			if metadata.ResourceData.HasChange("administrator_login_password") && state.AdministratorLoginPassword != "" {
 				properties.AdministratorLoginPassword = pointer.To(state.AdministratorLoginPassword)
 			}
 
+			if metadata.ResourceData.HasChange("administrator_login_password_wo_version") && state.AdministratorLoginPasswordWo != "" {
+				properties.AdministratorLoginPassword = pointer.To(state.AdministratorLoginPasswordWo)
+			}
+
 			metadata.Logger.Infof("Updating %s", id)
 
 			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, properties)
@@ -473,7 +503,8 @@ func (r MsSqlManagedInstanceResource) Read() sdk.ResourceFunc {
 				Tags:              tags.ToTypedObject(existing.Tags),
 
 				// This value is not returned, so we'll just set whatever is in the state/config
-				AdministratorLoginPassword: state.AdministratorLoginPassword,
+				AdministratorLoginPassword:          state.AdministratorLoginPassword,
+				AdministratorLoginPasswordWoVersion: state.AdministratorLoginPasswordWoVersion,
 				// This value is not returned, so we'll just set whatever is in the state/config
 				DnsZonePartnerId: state.DnsZonePartnerId,
 			}
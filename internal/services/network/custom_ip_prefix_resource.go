@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/customipprefixes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -39,7 +40,8 @@ type CustomIpPrefixModel struct {
 }
 
 var (
-	_ sdk.ResourceWithUpdate = CustomIpPrefixResource{}
+	_ sdk.ResourceWithUpdate   = CustomIpPrefixResource{}
+	_ sdk.ResourceWithIdentity = CustomIpPrefixResource{}
 )
 
 type CustomIpPrefixResource struct {
@@ -57,6 +59,11 @@ func (CustomIpPrefixResource) ModelObject() interface{} {
 func (CustomIpPrefixResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return customipprefixes.ValidateCustomIPPrefixID
 }
+
+func (CustomIpPrefixResource) Identity() resourceids.ResourceId {
+	return &customipprefixes.CustomIPPrefixId{}
+}
+
 func (r CustomIpPrefixResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
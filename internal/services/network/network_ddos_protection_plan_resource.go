@@ -83,7 +83,9 @@ func resourceNetworkDDoSProtectionPlanCreate(d *pluginsdk.ResourceData, meta int
 
 	locks.ByName(id.DdosProtectionPlanName, ddosProtectionPlanResourceName)
 	defer locks.UnlockByName(id.DdosProtectionPlanName, ddosProtectionPlanResourceName)
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, vnetsToLock, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
 	existing, err := client.Get(ctx, id)
@@ -128,7 +130,9 @@ func resourceNetworkDDoSProtectionPlanUpdate(d *pluginsdk.ResourceData, meta int
 
 	locks.ByName(id.DdosProtectionPlanName, ddosProtectionPlanResourceName)
 	defer locks.UnlockByName(id.DdosProtectionPlanName, ddosProtectionPlanResourceName)
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, vnetsToLock, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
 	existing, err := client.Get(ctx, *id)
@@ -230,7 +234,9 @@ func resourceNetworkDDoSProtectionPlanDelete(d *pluginsdk.ResourceData, meta int
 	locks.ByName(id.DdosProtectionPlanName, ddosProtectionPlanResourceName)
 	defer locks.UnlockByName(id.DdosProtectionPlanName, ddosProtectionPlanResourceName)
 
-	locks.MultipleByName(virtualNetworksNamesToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, virtualNetworksNamesToLock, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(virtualNetworksNamesToLock, VirtualNetworkResourceName)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
@@ -315,7 +315,9 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
+	if err := lockingDetails.lock(ctx); err != nil {
+		return err
+	}
 	defer lockingDetails.unlock()
 
 	if len(*ipConfigs) > 0 {
@@ -425,7 +427,9 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("determining locking details: %+v", err)
 		}
 
-		lockingDetails.lock()
+		if err := lockingDetails.lock(ctx); err != nil {
+			return err
+		}
 		defer lockingDetails.unlock()
 
 		// then map the fields managed in other resources back
@@ -602,7 +606,9 @@ func resourceNetworkInterfaceDelete(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
+	if err := lockingDetails.lock(ctx); err != nil {
+		return err
+	}
 	defer lockingDetails.unlock()
 
 	err = client.DeleteThenPoll(ctx, *id)
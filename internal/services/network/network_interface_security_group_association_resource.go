@@ -72,7 +72,9 @@ func resourceNetworkInterfaceSecurityGroupAssociationCreate(d *pluginsdk.Resourc
 		return err
 	}
 
-	locks.ByName(nsgId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+	if err := locks.ByNameWithContext(ctx, nsgId.NetworkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(nsgId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
 
 	read, err := client.Get(ctx, *nicId, networkinterfaces.DefaultGetOperationOptions())
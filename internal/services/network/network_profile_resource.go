@@ -130,10 +130,14 @@ func resourceNetworkProfileCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	locks.ByName(id.NetworkProfileName, azureNetworkProfileResourceName)
 	defer locks.UnlockByName(id.NetworkProfileName, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, vnetsToLock, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, subnetsToLock, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
 
 	payload := networkprofiles.NetworkProfile{
@@ -186,10 +190,14 @@ func resourceNetworkProfileUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	locks.ByName(id.NetworkProfileName, azureNetworkProfileResourceName)
 	defer locks.UnlockByName(id.NetworkProfileName, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, vnetsToLock, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, subnetsToLock, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
 
 	if d.HasChange("container_network_interface") {
@@ -282,10 +290,14 @@ func resourceNetworkProfileDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	locks.ByName(id.NetworkProfileName, azureNetworkProfileResourceName)
 	defer locks.UnlockByName(id.NetworkProfileName, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, vnetsToLock, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, subnetsToLock, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
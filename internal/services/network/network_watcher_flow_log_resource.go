@@ -224,7 +224,9 @@ func resourceNetworkWatcherFlowLogCreate(d *pluginsdk.ResourceData, meta interfa
 		return tf.ImportAsExistsError("azurerm_network_watcher_flow_log", id.ID())
 	}
 
-	locks.ByID(nsgId.ID())
+	if err := locks.ByIDWithContext(ctx, nsgId.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(nsgId.ID())
 
 	loc := d.Get("location").(string)
@@ -301,7 +303,9 @@ func resourceNetworkWatcherFlowLogUpdate(d *pluginsdk.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
-	locks.ByID(nsgId.ID())
+	if err := locks.ByIDWithContext(ctx, nsgId.ID()); err != nil {
+		return err
+	}
 	defer locks.UnlockByID(nsgId.ID())
 
 	if d.HasChange("storage_account_id") {
@@ -4,6 +4,7 @@
 package network
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -11,8 +12,9 @@ import (
 type Registration struct{}
 
 var (
-	_ sdk.TypedServiceRegistrationWithAGitHubLabel   = Registration{}
-	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.TypedServiceRegistrationWithAGitHubLabel      = Registration{}
+	_ sdk.UntypedServiceRegistrationWithAGitHubLabel    = Registration{}
+	_ sdk.FrameworkServiceRegistrationWithListResources = Registration{}
 )
 
 // Name is the name of this Service
@@ -173,3 +175,10 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_web_application_firewall_policy":           resourceWebApplicationFirewallPolicy(),
 	}
 }
+
+// ListResources returns the List Resources supported by this Service
+func (r Registration) ListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewVirtualNetworkListResource,
+	}
+}
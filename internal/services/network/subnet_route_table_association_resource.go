@@ -76,7 +76,9 @@ func resourceSubnetRouteTableAssociationCreate(d *pluginsdk.ResourceData, meta i
 	locks.ByName(routeTableId.RouteTableName, routeTableResourceName)
 	defer locks.UnlockByName(routeTableId.RouteTableName, routeTableResourceName)
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
 	subnet, err := client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
@@ -225,7 +227,9 @@ func resourceSubnetRouteTableAssociationDelete(d *pluginsdk.ResourceData, meta i
 	locks.ByName(parsedRouteTableId.RouteTableName, routeTableResourceName)
 	defer locks.UnlockByName(parsedRouteTableId.RouteTableName, routeTableResourceName)
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
 	// then re-retrieve it to ensure we've got the latest state
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/virtualnetworks"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var (
+	_ list.ListResourceWithConfigure        = &VirtualNetworkListResource{}
+	_ list.ListResourceWithRawV5Schemas     = &VirtualNetworkListResource{}
+	_ sdk.ListResourceWithPluginSdkResource = &VirtualNetworkListResource{}
+)
+
+func NewVirtualNetworkListResource() list.ListResource {
+	return &VirtualNetworkListResource{}
+}
+
+type VirtualNetworkListResource struct {
+	sdk.ListResourceMetadata
+}
+
+func (r *VirtualNetworkListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_virtual_network"
+}
+
+func (r *VirtualNetworkListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	r.Defaults(request, response)
+}
+
+func (r *VirtualNetworkListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = sdk.ListResourceFilterSchema(true)
+}
+
+func (r *VirtualNetworkListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	r.PluginSdkRawV5Schemas(ctx, response)
+}
+
+func (r *VirtualNetworkListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var filter sdk.ListResourceWithResourceGroupFilterModel
+	if diags := request.Config.Get(ctx, &filter); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = r.PluginSdkListResults(ctx, request, func(ctx context.Context) ([]resourceids.ResourceId, error) {
+		client := r.Client.Network.VirtualNetworks
+		subscriptionId := commonids.NewSubscriptionID(r.SubscriptionId)
+
+		items := make([]virtualnetworks.VirtualNetwork, 0)
+		if v := filter.ResourceGroupName.ValueString(); v != "" {
+			resourceGroupId := commonids.NewResourceGroupID(r.SubscriptionId, v)
+			resp, err := client.ListComplete(ctx, resourceGroupId)
+			if err != nil {
+				return nil, fmt.Errorf("listing Virtual Networks within %s: %+v", resourceGroupId, err)
+			}
+			items = resp.Items
+		} else {
+			resp, err := client.ListAllComplete(ctx, subscriptionId)
+			if err != nil {
+				return nil, fmt.Errorf("listing Virtual Networks within %s: %+v", subscriptionId, err)
+			}
+			items = resp.Items
+		}
+
+		ids := make([]resourceids.ResourceId, 0)
+		for _, item := range items {
+			if item.Id == nil || !filter.Matches(ctx, pointer.From(item.Location), item.Tags) {
+				continue
+			}
+
+			id, err := commonids.ParseVirtualNetworkIDInsensitively(*item.Id)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+
+		return ids, nil
+	})
+}
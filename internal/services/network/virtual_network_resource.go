@@ -43,10 +43,12 @@ func resourceVirtualNetwork() *pluginsdk.Resource {
 		Read:   resourceVirtualNetworkRead,
 		Update: resourceVirtualNetworkUpdate,
 		Delete: resourceVirtualNetworkDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingIdentity(&commonids.VirtualNetworkId{}, pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := commonids.ParseVirtualNetworkID(id)
 			return err
-		}),
+		})),
+
+		Identity: pluginsdk.ResourceIdentityForResourceId(&commonids.VirtualNetworkId{}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -377,7 +379,9 @@ func resourceVirtualNetworkCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, &networkSecurityGroupNames, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
 	if err := client.CreateOrUpdateThenPoll(ctx, id, vnet); err != nil {
@@ -422,6 +426,10 @@ func resourceVirtualNetworkRead(d *pluginsdk.ResourceData, meta interface{}) err
 	d.Set("name", id.VirtualNetworkName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
+	if err := pluginsdk.SetResourceIdentity(d, id); err != nil {
+		return err
+	}
+
 	if model := resp.Model; model != nil {
 		d.Set("location", location.NormalizeNilable(model.Location))
 		d.Set("edge_zone", flattenEdgeZoneModel(model.ExtendedLocation))
@@ -576,7 +584,9 @@ func resourceVirtualNetworkUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, &networkSecurityGroupNames, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
 	if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
@@ -614,7 +624,9 @@ func resourceVirtualNetworkDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("parsing Network Security Group ID's: %+v", err)
 	}
 
-	locks.MultipleByName(&nsgNames, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, &nsgNames, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(&nsgNames, VirtualNetworkResourceName)
 
 	locks.MultipleByName(&routeTableNames, routeTableResourceName)
@@ -116,7 +116,7 @@ func resourceRecoveryServicesBackupProtectedVMCreate(d *pluginsdk.ResourceData,
 					return fmt.Errorf("recovering soft deleted %s: %+v", id, err)
 				}
 			} else {
-				return fmt.Errorf(optedOutOfRecoveringSoftDeletedBackupProtectedVMFmt(parsedVmId.ID(), vaultName))
+				return fmt.Errorf("%s", optedOutOfRecoveringSoftDeletedBackupProtectedVMFmt(parsedVmId.ID(), vaultName))
 			}
 		}
 
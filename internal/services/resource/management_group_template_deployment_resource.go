@@ -100,6 +100,8 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 
+			"what_if_enabled": templateDeploymentWhatIfEnabledSchema(),
+
 			// Computed
 			"output_content": {
 				Type:     pluginsdk.TypeString,
@@ -107,7 +109,11 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(templateDeploymentWhatIfCustomizeDiff([]string{"management_group_id", "location"}, "", whatIfManagementGroupTemplateDeployment)),
 	}
 }
 
@@ -170,6 +176,10 @@ func managementGroupTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData,
 	}
 	log.Printf("[DEBUG] Validated Management Group Template Deployment %q..", id.DeploymentName)
 
+	if err := setTemplateDeploymentWhatIfChanges(ctx, client, d, "", whatIfManagementGroupTemplateDeployment); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Provisioning Management Group Template Deployment %q..", id.DeploymentName)
 	future, err := client.CreateOrUpdateAtManagementGroupScope(ctx, id.ManagementGroupName, id.DeploymentName, deployment)
 	if err != nil {
@@ -263,6 +273,10 @@ func managementGroupTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData,
 	}
 	log.Printf("[DEBUG] Validated Management Group Template Deployment %q..", id.DeploymentName)
 
+	if err := setTemplateDeploymentWhatIfChanges(ctx, client, d, "", whatIfManagementGroupTemplateDeployment); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Provisioning Management Group Template Deployment %q)..", id.DeploymentName)
 	future, err := client.CreateOrUpdateAtManagementGroupScope(ctx, id.ManagementGroupName, id.DeploymentName, deployment)
 	if err != nil {
@@ -391,3 +405,27 @@ func validateManagementGroupTemplateDeployment(ctx context.Context, id parse.Man
 
 	return nil
 }
+
+func whatIfManagementGroupTemplateDeployment(ctx context.Context, client *resources.DeploymentsClient, d templateDeploymentWhatIfData, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+	managementGroupId, err := mgParse.ManagementGroupID(d.Get("management_group_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	future, err := client.WhatIfAtManagementGroupScope(ctx, managementGroupId.Name, d.Get("name").(string), resources.ScopedDeploymentWhatIf{
+		Location:   utils.String(location.Normalize(d.Get("location").(string))),
+		Properties: &properties,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("requesting What-If: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for What-If: %+v", err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving What-If result: %+v", err)
+	}
+
+	return templateDeploymentWhatIfResult(result)
+}
@@ -4,14 +4,17 @@
 package resource
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 var (
-	_ sdk.TypedServiceRegistration   = Registration{}
-	_ sdk.UntypedServiceRegistration = Registration{}
+	_ sdk.TypedServiceRegistration                       = Registration{}
+	_ sdk.TypedServiceRegistrationWithFrameworkResources = Registration{}
+	_ sdk.UntypedServiceRegistration                     = Registration{}
+	_ sdk.FrameworkServiceRegistrationWithListResources  = Registration{}
 )
 
 type Registration struct{}
@@ -71,8 +74,21 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		ResourceManagementPrivateLinkAssociationResource{},
 		ResourceProviderRegistrationResource{},
-		ResourceManagementPrivateLinkResource{},
 		ResourceDeploymentScriptAzurePowerShellResource{},
 		ResourceDeploymentScriptAzureCliResource{},
 	}
 }
+
+// FrameworkResources returns a list of Resources supported by this Service which are served by the Plugin Framework
+func (r Registration) FrameworkResources() []sdk.Resource {
+	return []sdk.Resource{
+		ResourceManagementPrivateLinkResource{},
+	}
+}
+
+// ListResources returns the List Resources supported by this Service
+func (r Registration) ListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewResourceGroupListResource,
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var (
+	_ list.ListResourceWithConfigure        = &ResourceGroupListResource{}
+	_ list.ListResourceWithRawV5Schemas     = &ResourceGroupListResource{}
+	_ sdk.ListResourceWithPluginSdkResource = &ResourceGroupListResource{}
+)
+
+func NewResourceGroupListResource() list.ListResource {
+	return &ResourceGroupListResource{}
+}
+
+type ResourceGroupListResource struct {
+	sdk.ListResourceMetadata
+}
+
+func (r *ResourceGroupListResource) Metadata(_ context.Context, _ frameworkresource.MetadataRequest, response *frameworkresource.MetadataResponse) {
+	response.TypeName = "azurerm_resource_group"
+}
+
+func (r *ResourceGroupListResource) Configure(_ context.Context, request frameworkresource.ConfigureRequest, response *frameworkresource.ConfigureResponse) {
+	r.Defaults(request, response)
+}
+
+func (r *ResourceGroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = sdk.ListResourceFilterSchema(false)
+}
+
+func (r *ResourceGroupListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	r.PluginSdkRawV5Schemas(ctx, response)
+}
+
+func (r *ResourceGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var filter sdk.ListResourceFilterModel
+	if diags := request.Config.Get(ctx, &filter); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = r.PluginSdkListResults(ctx, request, func(ctx context.Context) ([]resourceids.ResourceId, error) {
+		client := r.Client.Resource.ResourceGroupsClient
+		subscriptionId := commonids.NewSubscriptionID(r.SubscriptionId)
+
+		resp, err := client.ListComplete(ctx, subscriptionId, resourcegroups.DefaultListOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("listing Resource Groups within %s: %+v", subscriptionId, err)
+		}
+
+		ids := make([]resourceids.ResourceId, 0)
+		for _, item := range resp.Items {
+			if item.Id == nil || !filter.Matches(ctx, item.Location, item.Tags) {
+				continue
+			}
+
+			id, err := commonids.ParseResourceGroupIDInsensitively(*item.Id)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+
+		return ids, nil
+	})
+}
@@ -100,6 +100,8 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 
+			"what_if_enabled": templateDeploymentWhatIfEnabledSchema(),
+
 			// Computed
 			"output_content": {
 				Type:     pluginsdk.TypeString,
@@ -107,32 +109,37 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
 
 		// this is needed to fix https://github.com/hashicorp/terraform-provider-azurerm/issues/12828
 		// On a change to `template_content` or `parameters_content`, we'll set `output_content` to empty
 		// The adverse effect of this is that any change to `template_content` will also cause any resource referencing `output_content` to update
-		CustomizeDiff: func(ctx context.Context, d *pluginsdk.ResourceDiff, i interface{}) error {
-			if d.HasChange("template_content") {
-				o, n := d.GetChange("template_content")
-
-				// the json has to be normalized and then compared against to see if a change has occurred
-				if !strings.EqualFold(o.(string), utils.NormalizeJson(n)) {
-					return d.SetNewComputed("output_content")
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, i interface{}) error {
+				if d.HasChange("template_content") {
+					o, n := d.GetChange("template_content")
+
+					// the json has to be normalized and then compared against to see if a change has occurred
+					if !strings.EqualFold(o.(string), utils.NormalizeJson(n)) {
+						return d.SetNewComputed("output_content")
+					}
 				}
-			}
 
-			if d.HasChange("parameters_content") {
-				o, n := d.GetChange("parameters_content")
+				if d.HasChange("parameters_content") {
+					o, n := d.GetChange("parameters_content")
 
-				// the json has to be normalized and then compared against to see if a change has occurred
-				if !strings.EqualFold(o.(string), utils.NormalizeJson(n)) {
-					return d.SetNewComputed("output_content")
+					// the json has to be normalized and then compared against to see if a change has occurred
+					if !strings.EqualFold(o.(string), utils.NormalizeJson(n)) {
+						return d.SetNewComputed("output_content")
+					}
 				}
-			}
 
-			return nil
-		},
+				return nil
+			},
+			templateDeploymentWhatIfCustomizeDiff([]string{"resource_group_name"}, "deployment_mode", whatIfResourceGroupTemplateDeployment),
+		),
 	}
 }
 
@@ -190,6 +197,10 @@ func resourceGroupTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, me
 	}
 	log.Printf("[DEBUG] Validated Template Deployment %q (Resource Group %q)..", id.DeploymentName, id.ResourceGroup)
 
+	if err := setTemplateDeploymentWhatIfChanges(ctx, client, d, "deployment_mode", whatIfResourceGroupTemplateDeployment); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Provisioning Template Deployment %q (Resource Group %q)..", id.DeploymentName, id.ResourceGroup)
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.DeploymentName, deployment)
 	if err != nil {
@@ -284,6 +295,10 @@ func resourceGroupTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, me
 	}
 	log.Printf("[DEBUG] Validated Template Deployment %q (Resource Group %q)..", id.DeploymentName, id.ResourceGroup)
 
+	if err := setTemplateDeploymentWhatIfChanges(ctx, client, d, "deployment_mode", whatIfResourceGroupTemplateDeployment); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Provisioning Template Deployment %q (Resource Group %q)..", id.DeploymentName, id.ResourceGroup)
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.DeploymentName, deployment)
 	if err != nil {
@@ -433,3 +448,21 @@ func validateResourceGroupTemplateDeployment(ctx context.Context, id parse.Resou
 
 	return nil
 }
+
+func whatIfResourceGroupTemplateDeployment(ctx context.Context, client *resources.DeploymentsClient, d templateDeploymentWhatIfData, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+	future, err := client.WhatIf(ctx, d.Get("resource_group_name").(string), d.Get("name").(string), resources.DeploymentWhatIf{
+		Properties: &properties,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("requesting What-If: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for What-If: %+v", err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving What-If result: %+v", err)
+	}
+
+	return templateDeploymentWhatIfResult(result)
+}
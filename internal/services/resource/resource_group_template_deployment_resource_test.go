@@ -8,6 +8,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -164,6 +168,36 @@ func TestAccResourceGroupTemplateDeployment_singleItemUpdatingTemplate(t *testin
 	})
 }
 
+func TestAccResourceGroupTemplateDeployment_whatIf(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_template_deployment", "test")
+	r := ResourceGroupTemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.singleItemWithPublicIPWhatIfConfig(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("what_if_enabled", "what_if_changes"),
+		{
+			Config: r.singleItemWithPublicIPWhatIfConfig(data, "second"),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				// the predicted changes should be shown in the plan
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectKnownValue(data.ResourceName, tfjsonpath.New("what_if_changes").AtSliceIndex(0).AtMapKey("change_type"), knownvalue.StringExact("Modify")),
+				},
+			},
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("what_if_changes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("what_if_changes.0.change_type").HasValue("Modify"),
+			),
+		},
+		data.ImportStep("what_if_enabled", "what_if_changes"),
+	})
+}
+
 func TestAccResourceGroupTemplateDeployment_withOutputs(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_resource_group_template_deployment", "test")
 	r := ResourceGroupTemplateDeploymentResource{}
@@ -723,6 +757,49 @@ TEMPLATE
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, tagValue)
 }
 
+func (ResourceGroupTemplateDeploymentResource) singleItemWithPublicIPWhatIfConfig(data acceptance.TestData, tagValue string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+  what_if_enabled     = true
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": [
+    {
+      "type": "Microsoft.Network/publicIPAddresses",
+      "apiVersion": "2015-06-15",
+      "name": "acctestpip-%d",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "publicIPAllocationMethod": "Dynamic"
+      },
+      "tags": {
+        "Hello": %q
+      }
+    }
+  ]
+}
+TEMPLATE
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, tagValue)
+}
+
 func (ResourceGroupTemplateDeploymentResource) withOutputsConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tracing"
 )
 
 var (
@@ -143,7 +144,7 @@ func (r ResourceProviderRegistrationResource) Create() sdk.ResourceFunc {
 
 			log.Printf("[DEBUG] Waiting for %s to finish registering..", resourceId)
 			pollerType := custompollers.NewResourceProviderRegistrationPoller(client, resourceId)
-			poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+			poller := pollers.NewPoller(tracing.WrapPoller(pollerType), 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
 			if err := poller.PollUntilDone(ctx); err != nil {
 				return fmt.Errorf("waiting for %s to be registered: %s", resourceId, err)
 			}
@@ -212,7 +213,7 @@ func (r ResourceProviderRegistrationResource) Update() sdk.ResourceFunc {
 
 			log.Printf("[DEBUG] Waiting for %s to finish registering..", resourceId)
 			pollerType := custompollers.NewResourceProviderRegistrationPoller(client, *resourceId)
-			poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+			poller := pollers.NewPoller(tracing.WrapPoller(pollerType), 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
 			if err := poller.PollUntilDone(ctx); err != nil {
 				return fmt.Errorf("waiting for %s to be registered: %s", resourceId, err)
 			}
@@ -312,7 +313,7 @@ func (r ResourceProviderRegistrationResource) Delete() sdk.ResourceFunc {
 
 			log.Printf("[DEBUG] Waiting for %s to finish unregistering..", *id)
 			pollerType := custompollers.NewResourceProviderUnregistrationPoller(client, *id)
-			poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+			poller := pollers.NewPoller(tracing.WrapPoller(pollerType), 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
 			if err := poller.PollUntilDone(ctx); err != nil {
 				return fmt.Errorf("waiting for %s to become unregistered: %+v", *id, err)
 			}
@@ -91,6 +91,8 @@ func subscriptionTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 
+			"what_if_enabled": templateDeploymentWhatIfEnabledSchema(),
+
 			// Computed
 			"output_content": {
 				Type:     pluginsdk.TypeString,
@@ -98,7 +100,11 @@ func subscriptionTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(templateDeploymentWhatIfCustomizeDiff([]string{"location"}, "", whatIfSubscriptionTemplateDeployment)),
 	}
 }
 
@@ -157,6 +163,10 @@ func subscriptionTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, met
 	}
 	log.Printf("[DEBUG] Validated Subscription Template Deployment %q..", id.DeploymentName)
 
+	if err := setTemplateDeploymentWhatIfChanges(ctx, client, d, "", whatIfSubscriptionTemplateDeployment); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Provisioning Subscription Template Deployment %q..", id.DeploymentName)
 	future, err := client.CreateOrUpdateAtSubscriptionScope(ctx, id.DeploymentName, deployment)
 	if err != nil {
@@ -248,6 +258,10 @@ func subscriptionTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, met
 	}
 	log.Printf("[DEBUG] Validated Subscription Template Deployment %q..", id.DeploymentName)
 
+	if err := setTemplateDeploymentWhatIfChanges(ctx, client, d, "", whatIfSubscriptionTemplateDeployment); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Provisioning Subscription Template Deployment %q)..", id.DeploymentName)
 	future, err := client.CreateOrUpdateAtSubscriptionScope(ctx, id.DeploymentName, deployment)
 	if err != nil {
@@ -374,3 +388,22 @@ func validateSubscriptionTemplateDeployment(ctx context.Context, id parse.Subscr
 
 	return nil
 }
+
+func whatIfSubscriptionTemplateDeployment(ctx context.Context, client *resources.DeploymentsClient, d templateDeploymentWhatIfData, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+	future, err := client.WhatIfAtSubscriptionScope(ctx, d.Get("name").(string), resources.DeploymentWhatIf{
+		Location:   utils.String(location.Normalize(d.Get("location").(string))),
+		Properties: &properties,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("requesting What-If: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for What-If: %+v", err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving What-If result: %+v", err)
+	}
+
+	return templateDeploymentWhatIfResult(result)
+}
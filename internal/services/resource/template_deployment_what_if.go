@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// templateDeploymentWhatIfData is the subset of the ResourceData/ResourceDiff used to run the What-If operation, since
+// this runs both during the plan and immediately prior to deploying the Template Deployment
+type templateDeploymentWhatIfData interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+}
+
+// templateDeploymentWhatIfFunc runs the What-If operation for the Template Deployment at the relevant scope
+type templateDeploymentWhatIfFunc func(ctx context.Context, client *resources.DeploymentsClient, d templateDeploymentWhatIfData, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error)
+
+func templateDeploymentWhatIfEnabledSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+func templateDeploymentWhatIfChangesSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"resource_id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"change_type": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"changed_properties": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// templateDeploymentWhatIfCustomizeDiff runs the What-If operation during the plan when `what_if_enabled` is set and the
+// Template Deployment is new or changing - setting the predicted changes to the resources within the template into
+// `what_if_changes`, so that the real impact of a change is shown in the plan and can be reviewed prior to applying it.
+//
+// Where the What-If operation can't be run during the plan (for example as the scope or template isn't known yet, or
+// the Resource Group doesn't exist until it's created during the apply) `what_if_changes` is planned as unknown, and
+// is then populated by setTemplateDeploymentWhatIfChanges prior to the deployment.
+//
+// The scopeFields (e.g. `resource_group_name`) must be known for the What-If operation to run. Only Resource Group
+// Template Deployments support a `deployment_mode`, so the modeField is optional - when omitted the Template Deployment
+// is previewed in Incremental mode.
+func templateDeploymentWhatIfCustomizeDiff(scopeFields []string, modeField string, whatIf templateDeploymentWhatIfFunc) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+		if !diff.Get("what_if_enabled").(bool) {
+			if len(diff.Get("what_if_changes").([]interface{})) > 0 {
+				return diff.SetNew("what_if_changes", []interface{}{})
+			}
+			return nil
+		}
+
+		if diff.Id() != "" && !diff.HasChanges(templateDeploymentWhatIfFields(modeField)...) {
+			return nil
+		}
+
+		// the predicted changes can't be determined until the scope and the template/parameters are known
+		for _, field := range append([]string{"name"}, scopeFields...) {
+			if !diff.NewValueKnown(field) {
+				return diff.SetNewComputed("what_if_changes")
+			}
+		}
+		if !templateDeploymentWhatIfPropertiesKnown(diff, modeField) {
+			return diff.SetNewComputed("what_if_changes")
+		}
+
+		properties, err := expandTemplateDeploymentWhatIfProperties(diff, modeField)
+		if err != nil {
+			return err
+		}
+
+		client := meta.(*clients.Client).Resource.DeploymentsClient
+		result, err := whatIf(ctx, client, diff, *properties)
+		if err != nil {
+			// this is best-effort, for example the Resource Group may not exist until it's created during the apply
+			log.Printf("[WARN] running the What-If operation for the Template Deployment %q: %+v", diff.Get("name").(string), err)
+			return diff.SetNewComputed("what_if_changes")
+		}
+
+		changes := flattenTemplateDeploymentWhatIfChanges(result)
+		for _, summary := range summarizeTemplateDeploymentWhatIfChanges(changes) {
+			log.Printf("[WARN] the What-If operation for the Template Deployment %q predicts: %s", diff.Get("name").(string), summary)
+		}
+
+		return diff.SetNew("what_if_changes", changes)
+	}
+}
+
+// setTemplateDeploymentWhatIfChanges runs the What-If operation immediately prior to deploying the Template Deployment
+// when `what_if_changes` was planned as unknown by templateDeploymentWhatIfCustomizeDiff, setting the predicted changes
+// into `what_if_changes`. Where the predicted changes were determined during the plan these are retained as-is, since
+// the value must match the plan.
+func setTemplateDeploymentWhatIfChanges(ctx context.Context, client *resources.DeploymentsClient, d *pluginsdk.ResourceData, modeField string, whatIf templateDeploymentWhatIfFunc) error {
+	if d.Id() != "" && !d.HasChanges(templateDeploymentWhatIfFields(modeField)...) {
+		return nil
+	}
+
+	if plan := d.GetRawPlan(); !plan.IsNull() && plan.IsKnown() && plan.GetAttr("what_if_changes").IsKnown() {
+		return nil
+	}
+
+	changes := make([]interface{}, 0)
+	if d.Get("what_if_enabled").(bool) {
+		properties, err := expandTemplateDeploymentWhatIfProperties(d, modeField)
+		if err != nil {
+			return err
+		}
+
+		result, err := whatIf(ctx, client, d, *properties)
+		if err != nil {
+			// the What-If operation is best-effort, so shouldn't prevent the deployment
+			log.Printf("[WARN] running the What-If operation for the Template Deployment %q: %+v", d.Get("name").(string), err)
+		} else {
+			changes = flattenTemplateDeploymentWhatIfChanges(result)
+		}
+	}
+
+	return d.Set("what_if_changes", changes)
+}
+
+// templateDeploymentWhatIfFields returns the fields which, when changed, cause the What-If operation to run
+func templateDeploymentWhatIfFields(modeField string) []string {
+	fields := []string{"template_content", "template_spec_version_id", "parameters_content", "debug_level", "what_if_enabled"}
+	if modeField != "" {
+		fields = append(fields, modeField)
+	}
+	return fields
+}
+
+// templateDeploymentWhatIfPropertiesKnown returns whether the template and parameters used to build the What-If
+// request are known during the plan
+func templateDeploymentWhatIfPropertiesKnown(diff *pluginsdk.ResourceDiff, modeField string) bool {
+	fields := []string{"template_spec_version_id", "parameters_content", "debug_level"}
+	if modeField != "" {
+		fields = append(fields, modeField)
+	}
+	for _, field := range fields {
+		if !diff.NewValueKnown(field) {
+			return false
+		}
+	}
+
+	if _, ok := diff.GetOk("template_spec_version_id"); !ok {
+		return diff.NewValueKnown("template_content")
+	}
+
+	return true
+}
+
+// expandTemplateDeploymentWhatIfProperties builds the What-If request from the configuration
+func expandTemplateDeploymentWhatIfProperties(d templateDeploymentWhatIfData, modeField string) (*resources.DeploymentWhatIfProperties, error) {
+	mode := resources.DeploymentModeIncremental
+	if modeField != "" {
+		mode = resources.DeploymentMode(d.Get(modeField).(string))
+	}
+
+	properties := resources.DeploymentWhatIfProperties{
+		DebugSetting: expandTemplateDeploymentDebugSetting(d.Get("debug_level").(string)),
+		Mode:         mode,
+		WhatIfSettings: &resources.DeploymentWhatIfSettings{
+			ResultFormat: resources.WhatIfResultFormatFullResourcePayloads,
+		},
+	}
+
+	if templateSpecVersionID, ok := d.GetOk("template_spec_version_id"); ok {
+		properties.TemplateLink = &resources.TemplateLink{
+			ID: utils.String(templateSpecVersionID.(string)),
+		}
+	} else {
+		template, err := expandTemplateDeploymentBody(d.Get("template_content").(string))
+		if err != nil {
+			return nil, fmt.Errorf("expanding `template_content`: %+v", err)
+		}
+		properties.Template = template
+	}
+
+	if v, ok := d.GetOk("parameters_content"); ok && v != "" {
+		parameters, err := expandTemplateDeploymentBody(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("expanding `parameters_content`: %+v", err)
+		}
+		properties.Parameters = parameters
+	}
+
+	return &properties, nil
+}
+
+// templateDeploymentWhatIfResult returns the result of the What-If operation, or the error returned by the operation
+func templateDeploymentWhatIfResult(result resources.WhatIfOperationResult) (*resources.WhatIfOperationResult, error) {
+	if result.Error != nil {
+		if result.Error.Message != nil {
+			return nil, fmt.Errorf("%s", *result.Error.Message)
+		}
+		return nil, fmt.Errorf("%+v", *result.Error)
+	}
+
+	return &result, nil
+}
+
+func flattenTemplateDeploymentWhatIfChanges(input *resources.WhatIfOperationResult) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil || input.WhatIfOperationProperties == nil || input.Changes == nil {
+		return output
+	}
+
+	for _, change := range *input.Changes {
+		// resources which aren't changing are omitted to keep the predicted changes focused on the real impact
+		if change.ChangeType == resources.ChangeTypeNoChange || change.ChangeType == resources.ChangeTypeIgnore {
+			continue
+		}
+
+		resourceId := ""
+		if change.ResourceID != nil {
+			resourceId = *change.ResourceID
+		}
+
+		output = append(output, map[string]interface{}{
+			"resource_id":        resourceId,
+			"change_type":        string(change.ChangeType),
+			"changed_properties": flattenTemplateDeploymentWhatIfPropertyChanges(change.Delta, ""),
+		})
+	}
+
+	sort.SliceStable(output, func(i, j int) bool {
+		return output[i].(map[string]interface{})["resource_id"].(string) < output[j].(map[string]interface{})["resource_id"].(string)
+	})
+
+	return output
+}
+
+func flattenTemplateDeploymentWhatIfPropertyChanges(input *[]resources.WhatIfPropertyChange, prefix string) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, change := range *input {
+		path := prefix
+		if change.Path != nil {
+			if path != "" {
+				path += "."
+			}
+			path += *change.Path
+		}
+
+		if change.Children != nil && len(*change.Children) > 0 {
+			output = append(output, flattenTemplateDeploymentWhatIfPropertyChanges(change.Children, path)...)
+			continue
+		}
+
+		output = append(output, path)
+	}
+
+	return output
+}
+
+// summarizeTemplateDeploymentWhatIfChanges returns a summary of the number of resources being created/modified/deleted,
+// followed by each of the predicted changes
+func summarizeTemplateDeploymentWhatIfChanges(changes []interface{}) []string {
+	counts := make(map[string]int)
+	lines := make([]string, 0)
+	for _, raw := range changes {
+		change := raw.(map[string]interface{})
+		changeType := change["change_type"].(string)
+		counts[changeType]++
+
+		line := fmt.Sprintf("%s %s", changeType, change["resource_id"].(string))
+		if properties := change["changed_properties"].([]interface{}); len(properties) > 0 {
+			paths := make([]string, 0)
+			for _, p := range properties {
+				paths = append(paths, p.(string))
+			}
+			line = fmt.Sprintf("%s (%s)", line, strings.Join(paths, ", "))
+		}
+		lines = append(lines, line)
+	}
+
+	summary := fmt.Sprintf("%d to create, %d to modify, %d to delete, %d to deploy", counts[string(resources.ChangeTypeCreate)], counts[string(resources.ChangeTypeModify)], counts[string(resources.ChangeTypeDelete)], counts[string(resources.ChangeTypeDeploy)])
+	return append([]string{summary}, lines...)
+}
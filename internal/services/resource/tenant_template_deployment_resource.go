@@ -91,6 +91,8 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 
+			"what_if_enabled": templateDeploymentWhatIfEnabledSchema(),
+
 			// Computed
 			"output_content": {
 				Type:     pluginsdk.TypeString,
@@ -98,7 +100,11 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(templateDeploymentWhatIfCustomizeDiff([]string{"location"}, "", whatIfTenantTemplateDeployment)),
 	}
 }
 
@@ -156,6 +162,10 @@ func tenantTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, meta inte
 	}
 	log.Printf("[DEBUG] Validated Tenant Template Deployment %q..", id.DeploymentName)
 
+	if err := setTemplateDeploymentWhatIfChanges(ctx, client, d, "", whatIfTenantTemplateDeployment); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Provisioning Tenant Template Deployment %q..", id.DeploymentName)
 	future, err := client.CreateOrUpdateAtTenantScope(ctx, id.DeploymentName, deployment)
 	if err != nil {
@@ -249,6 +259,10 @@ func tenantTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, meta inte
 	}
 	log.Printf("[DEBUG] Validated Tenant Template Deployment %q..", id.DeploymentName)
 
+	if err := setTemplateDeploymentWhatIfChanges(ctx, client, d, "", whatIfTenantTemplateDeployment); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Provisioning Tenant Template Deployment %q)..", id.DeploymentName)
 	future, err := client.CreateOrUpdateAtTenantScope(ctx, id.DeploymentName, deployment)
 	if err != nil {
@@ -375,3 +389,22 @@ func validateTenantTemplateDeployment(ctx context.Context, id parse.TenantTempla
 
 	return nil
 }
+
+func whatIfTenantTemplateDeployment(ctx context.Context, client *resources.DeploymentsClient, d templateDeploymentWhatIfData, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+	future, err := client.WhatIfAtTenantScope(ctx, d.Get("name").(string), resources.ScopedDeploymentWhatIf{
+		Location:   utils.String(location.Normalize(d.Get("location").(string))),
+		Properties: &properties,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("requesting What-If: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for What-If: %+v", err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving What-If result: %+v", err)
+	}
+
+	return templateDeploymentWhatIfResult(result)
+}
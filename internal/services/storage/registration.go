@@ -4,13 +4,21 @@
 package storage
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 type Registration struct{}
 
-var _ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+var (
+	_ sdk.UntypedServiceRegistrationWithAGitHubLabel         = Registration{}
+	_ sdk.FrameworkServiceRegistrationWithEphemeralResources = Registration{}
+	_ sdk.FrameworkServiceRegistrationWithListResources      = Registration{}
+	_ sdk.FrameworkServiceRegistrationWithActions            = Registration{}
+)
 
 func (r Registration) AssociatedGitHubLabel() string {
 	return "service/storage"
@@ -87,3 +95,24 @@ func (r Registration) Resources() []sdk.Resource {
 		SyncServerEndpointResource{},
 	}
 }
+
+// EphemeralResources returns the Ephemeral Resources supported by this Service
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewStorageAccountSasEphemeralResource,
+	}
+}
+
+// ListResources returns the List Resources supported by this Service
+func (r Registration) ListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewStorageAccountListResource,
+	}
+}
+
+// Actions returns the Actions supported by this Service
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{
+		NewStorageAccountKeyRegenerateAction,
+	}
+}
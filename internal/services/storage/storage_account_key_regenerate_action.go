@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+)
+
+var _ action.ActionWithConfigure = &StorageAccountKeyRegenerateAction{}
+
+func NewStorageAccountKeyRegenerateAction() action.Action {
+	return &StorageAccountKeyRegenerateAction{}
+}
+
+type StorageAccountKeyRegenerateAction struct {
+	sdk.ActionMetadata
+}
+
+type StorageAccountKeyRegenerateActionModel struct {
+	StorageAccountId types.String `tfsdk:"storage_account_id"`
+	KeyName          types.String `tfsdk:"key_name"`
+}
+
+func (a *StorageAccountKeyRegenerateAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_storage_account_key_regenerate"
+}
+
+func (a *StorageAccountKeyRegenerateAction) Configure(_ context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(request, response)
+}
+
+func (a *StorageAccountKeyRegenerateAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Regenerates one of the Access Keys for a Storage Account.",
+		Attributes: map[string]schema.Attribute{
+			"storage_account_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Storage Account.",
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: commonids.ValidateStorageAccountID,
+					},
+				},
+			},
+
+			"key_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Access Key which should be regenerated.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"key1",
+						"key2",
+						"kerb1",
+						"kerb2",
+					),
+				},
+			},
+		},
+	}
+}
+
+func (a *StorageAccountKeyRegenerateAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.Storage.ResourceManager.StorageAccounts
+
+	ctx, cancel := a.InvokeContext(ctx)
+	defer cancel()
+
+	var data StorageAccountKeyRegenerateActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := commonids.ParseStorageAccountID(data.StorageAccountId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("parsing `storage_account_id`", err.Error())
+		return
+	}
+
+	keyName := data.KeyName.ValueString()
+	a.SendProgress(response, "regenerating the Access Key %q for %s..", keyName, *id)
+
+	input := storageaccounts.StorageAccountRegenerateKeyParameters{
+		KeyName: keyName,
+	}
+	if _, err := client.RegenerateKey(ctx, *id, input); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("regenerating the Access Key %q for %s", keyName, *id), err.Error())
+		return
+	}
+
+	a.SendProgress(response, "regenerated the Access Key %q for %s", keyName, *id)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var (
+	_ list.ListResourceWithConfigure        = &StorageAccountListResource{}
+	_ list.ListResourceWithRawV5Schemas     = &StorageAccountListResource{}
+	_ sdk.ListResourceWithPluginSdkResource = &StorageAccountListResource{}
+)
+
+func NewStorageAccountListResource() list.ListResource {
+	return &StorageAccountListResource{}
+}
+
+type StorageAccountListResource struct {
+	sdk.ListResourceMetadata
+}
+
+func (r *StorageAccountListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_storage_account"
+}
+
+func (r *StorageAccountListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	r.Defaults(request, response)
+}
+
+func (r *StorageAccountListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = sdk.ListResourceFilterSchema(true)
+}
+
+func (r *StorageAccountListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	r.PluginSdkRawV5Schemas(ctx, response)
+}
+
+func (r *StorageAccountListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var filter sdk.ListResourceWithResourceGroupFilterModel
+	if diags := request.Config.Get(ctx, &filter); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = r.PluginSdkListResults(ctx, request, func(ctx context.Context) ([]resourceids.ResourceId, error) {
+		client := r.Client.Storage.ResourceManager.StorageAccounts
+		subscriptionId := commonids.NewSubscriptionID(r.SubscriptionId)
+
+		items := make([]storageaccounts.StorageAccount, 0)
+		if v := filter.ResourceGroupName.ValueString(); v != "" {
+			resourceGroupId := commonids.NewResourceGroupID(r.SubscriptionId, v)
+			resp, err := client.ListByResourceGroupComplete(ctx, resourceGroupId)
+			if err != nil {
+				return nil, fmt.Errorf("listing Storage Accounts within %s: %+v", resourceGroupId, err)
+			}
+			items = resp.Items
+		} else {
+			resp, err := client.ListComplete(ctx, subscriptionId)
+			if err != nil {
+				return nil, fmt.Errorf("listing Storage Accounts within %s: %+v", subscriptionId, err)
+			}
+			items = resp.Items
+		}
+
+		ids := make([]resourceids.ResourceId, 0)
+		for _, item := range items {
+			if item.Id == nil || !filter.Matches(ctx, item.Location, item.Tags) {
+				continue
+			}
+
+			id, err := commonids.ParseStorageAccountIDInsensitively(*item.Id)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+
+		return ids, nil
+	})
+}
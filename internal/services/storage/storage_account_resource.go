@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/nameavailability"
 	keyVaultClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -78,10 +79,12 @@ func resourceStorageAccount() *pluginsdk.Resource {
 			3: migration.AccountV3ToV4{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingIdentity(&commonids.StorageAccountId{}, pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := commonids.ParseStorageAccountID(id)
 			return err
-		}),
+		})),
+
+		Identity: pluginsdk.ResourceIdentityForResourceId(&commonids.StorageAccountId{}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -1270,6 +1273,11 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				}
 				return false
 			}),
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				client := v.(*clients.Client)
+				subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+				return nameavailability.ValidateDuringPlan(ctx, d, client.Features, "Storage Account", checkStorageAccountNameAvailability(client.Storage.ResourceManager.StorageAccounts, subscriptionId))
+			}),
 		),
 	}
 
@@ -1289,6 +1297,27 @@ func resourceStorageAccount() *pluginsdk.Resource {
 	return resource
 }
 
+func checkStorageAccountNameAvailability(client *storageaccounts.StorageAccountsClient, subscriptionId commonids.SubscriptionId) nameavailability.CheckFunc {
+	return func(ctx context.Context, name string) (*nameavailability.Result, error) {
+		input := storageaccounts.StorageAccountCheckNameAvailabilityParameters{
+			Name: name,
+			Type: storageaccounts.TypeMicrosoftPointStorageStorageAccounts,
+		}
+		resp, err := client.CheckNameAvailability(ctx, subscriptionId, input)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Model == nil || resp.Model.NameAvailable == nil {
+			return nil, fmt.Errorf("model was nil")
+		}
+
+		return &nameavailability.Result{
+			Available: *resp.Model.NameAvailable,
+			Message:   pointer.From(resp.Model.Message),
+		}, nil
+	}
+}
+
 func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	tenantId := meta.(*clients.Client).Account.TenantId
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -2037,6 +2066,10 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 	d.Set("name", id.StorageAccountName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
+	if err := pluginsdk.SetResourceIdentity(d, id); err != nil {
+		return err
+	}
+
 	supportLevel := storageAccountServiceSupportLevel{
 		supportBlob:          false,
 		supportQueue:         false,
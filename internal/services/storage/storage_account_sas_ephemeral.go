@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/storage"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &StorageAccountSasEphemeralResource{}
+
+func NewStorageAccountSasEphemeralResource() ephemeral.EphemeralResource {
+	return &StorageAccountSasEphemeralResource{}
+}
+
+// StorageAccountSasEphemeralResource generates an ACCOUNT SAS : https://docs.microsoft.com/en-us/rest/api/storageservices/Constructing-an-Account-SAS
+// not Service SAS
+type StorageAccountSasEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type StorageAccountSasEphemeralResourceModel struct {
+	ConnectionString types.String `tfsdk:"connection_string"`
+	HttpsOnly        types.Bool   `tfsdk:"https_only"`
+	IPAddresses      types.String `tfsdk:"ip_addresses"`
+	SignedVersion    types.String `tfsdk:"signed_version"`
+	ResourceTypes    types.List   `tfsdk:"resource_types"`
+	Services         types.List   `tfsdk:"services"`
+	Start            types.String `tfsdk:"start"`
+	Expiry           types.String `tfsdk:"expiry"`
+	Permissions      types.List   `tfsdk:"permissions"`
+	Sas              types.String `tfsdk:"sas"`
+}
+
+func (e *StorageAccountSasEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "azurerm_storage_account_sas"
+}
+
+func (e *StorageAccountSasEphemeralResource) Configure(_ context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	e.Defaults(request, response)
+}
+
+func (e *StorageAccountSasEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	boolBlock := func(names ...string) schema.ListNestedBlock {
+		attributes := make(map[string]schema.Attribute, len(names))
+		for _, name := range names {
+			attributes[name] = schema.BoolAttribute{
+				Required: true,
+			}
+		}
+
+		return schema.ListNestedBlock{
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeBetween(1, 1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: attributes,
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"connection_string": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"https_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Defaults to `true`.",
+			},
+
+			"ip_addresses": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validation.Any(validation.IsIPv4Address, validation.IsIPv4Range),
+					},
+				},
+			},
+
+			"signed_version": schema.StringAttribute{
+				Optional: true,
+			},
+
+			// Always in UTC and must be ISO-8601 format
+			"start": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validate.ISO8601DateTime,
+					},
+				},
+			},
+
+			// Always in UTC and must be ISO-8601 format
+			"expiry": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validate.ISO8601DateTime,
+					},
+				},
+			},
+
+			"sas": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"resource_types": boolBlock("service", "container", "object"),
+
+			"services": boolBlock("blob", "queue", "table", "file"),
+
+			"permissions": boolBlock("read", "write", "delete", "list", "add", "create", "update", "process", "tag", "filter"),
+		},
+	}
+}
+
+func (e *StorageAccountSasEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	if !e.ClientIsConfigured(&response.Diagnostics) {
+		return
+	}
+
+	var data StorageAccountSasEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	signedVersion := "2017-07-29"
+	if features.FourPointOhBeta() {
+		signedVersion = "2022-11-02"
+	}
+	if v := data.SignedVersion.ValueString(); v != "" {
+		signedVersion = v
+	}
+
+	signedProtocol := "https"
+	if !data.HttpsOnly.IsNull() && !data.HttpsOnly.ValueBool() {
+		signedProtocol = "https,http"
+	}
+
+	resourceTypes := BuildResourceTypesString(e.expandBoolBlock(ctx, data.ResourceTypes, response))
+	services := BuildServicesString(e.expandBoolBlock(ctx, data.Services, response))
+	permissions := BuildPermissionsString(e.expandBoolBlock(ctx, data.Permissions, response))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	kvp, err := storage.ParseAccountSASConnectionString(data.ConnectionString.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("parsing `connection_string`", err.Error())
+		return
+	}
+
+	// TODO: implement support for signedEncryptionScope
+	signedEncryptionScope := ""
+	sasToken, err := storage.ComputeAccountSASToken(kvp[connStringAccountNameKey], kvp[connStringAccountKeyKey], permissions, services, resourceTypes,
+		data.Start.ValueString(), data.Expiry.ValueString(), signedProtocol, data.IPAddresses.ValueString(), signedVersion, signedEncryptionScope)
+	if err != nil {
+		response.Diagnostics.AddError("computing the Account SAS Token", err.Error())
+		return
+	}
+
+	data.HttpsOnly = types.BoolValue(signedProtocol == "https")
+	data.SignedVersion = types.StringValue(signedVersion)
+	data.Sas = types.StringValue(sasToken)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+// expandBoolBlock converts a block containing only Boolean attributes into the map used by the Build*String functions
+func (e *StorageAccountSasEphemeralResource) expandBoolBlock(ctx context.Context, input types.List, response *ephemeral.OpenResponse) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	var blocks []types.Object
+	response.Diagnostics.Append(input.ElementsAs(ctx, &blocks, false)...)
+	if len(blocks) == 0 {
+		return output
+	}
+
+	for k, v := range blocks[0].Attributes() {
+		if b, ok := v.(types.Bool); ok {
+			output[k] = b.ValueBool()
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import "strings"
+
+// Config contains the Provider-level tagging configuration, as defined in the
+// `default_tags` and `ignore_tags` blocks within the Provider block.
+type Config struct {
+	// DefaultTags are merged into the tags for every taggable Resource, with the
+	// values defined on the Resource taking precedence.
+	DefaultTags map[string]string
+
+	// IgnoreKeys is a list of Tag Keys which are ignored (case-insensitively) when
+	// reading the tags for a Resource, e.g. Tags managed by Azure Policy.
+	IgnoreKeys []string
+
+	// IgnoreKeyPrefixes is a list of prefixes, where Tag Keys starting with any of
+	// these (case-insensitively) are ignored when reading the tags for a Resource.
+	IgnoreKeyPrefixes []string
+}
+
+// IsEmpty returns whether any default or ignored tags have been configured.
+func (c Config) IsEmpty() bool {
+	return len(c.DefaultTags) == 0 && len(c.IgnoreKeys) == 0 && len(c.IgnoreKeyPrefixes) == 0
+}
+
+// IsIgnored returns whether the specified Tag Key should be ignored.
+func (c Config) IsIgnored(key string) bool {
+	for _, v := range c.IgnoreKeys {
+		if v != "" && strings.EqualFold(key, v) {
+			return true
+		}
+	}
+
+	for _, v := range c.IgnoreKeyPrefixes {
+		if v != "" && strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MergeDefaults returns the Default Tags merged with the tags defined on the Resource,
+// where the values defined on the Resource take precedence over the Default Tags.
+func (c Config) MergeDefaults(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(c.DefaultTags)+len(input))
+
+	for k, v := range c.DefaultTags {
+		output[k] = v
+	}
+
+	for k, v := range input {
+		// Tag Keys are case-insensitive in Azure, so the casing used on the Resource wins
+		for existing := range output {
+			if existing != k && strings.EqualFold(existing, k) {
+				delete(output, existing)
+			}
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+// RemoveDefaults returns the tags with any Default Tags (matching both the key and value)
+// removed, leaving only the tags which were defined on the Resource. Tags which are present
+// in `configured` (e.g. the tags previously defined on the Resource) are always retained.
+func (c Config) RemoveDefaults(input map[string]interface{}, configured map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+
+	for k, v := range input {
+		if _, ok := configured[k]; ok {
+			output[k] = v
+			continue
+		}
+
+		if defaultValue, ok := c.DefaultTags[k]; ok {
+			if value, err := TagValueToString(v); err == nil && value == defaultValue {
+				continue
+			}
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+// RemoveIgnored returns the tags with any Ignored Tag Keys removed.
+func (c Config) RemoveIgnored(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+
+	for k, v := range input {
+		if c.IsIgnored(k) {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+// MergeIgnored returns the tags with any Ignored Tags from `existing` (e.g. the tags currently assigned to the
+// Resource in Azure) carried over, unless the same Tag Key is defined in `input`. Since updating the tags for a
+// Resource replaces them, this ensures that tags managed outside of Terraform aren't removed.
+func (c Config) MergeIgnored(input map[string]interface{}, existing map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(input)+len(existing))
+
+	for k, v := range input {
+		output[k] = v
+	}
+
+	for k, v := range existing {
+		if !c.IsIgnored(k) {
+			continue
+		}
+
+		exists := false
+		for key := range input {
+			if strings.EqualFold(key, k) {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			output[k] = v
+		}
+	}
+
+	return output
+}
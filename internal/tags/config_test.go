@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConfigIsIgnored(t *testing.T) {
+	config := Config{
+		IgnoreKeys:        []string{"CreatedBy", ""},
+		IgnoreKeyPrefixes: []string{"hidden-", ""},
+	}
+
+	testData := map[string]bool{
+		"CreatedBy":       true,
+		"createdby":       true,
+		"CreatedByPolicy": false,
+		"hidden-link":     true,
+		"Hidden-Title":    true,
+		"environment":     false,
+		"":                false,
+	}
+
+	for key, expected := range testData {
+		if actual := config.IsIgnored(key); actual != expected {
+			t.Fatalf("expected IsIgnored(%q) to be %t but got %t", key, expected, actual)
+		}
+	}
+}
+
+func TestConfigMergeDefaults(t *testing.T) {
+	testData := []struct {
+		name     string
+		defaults map[string]string
+		input    map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "no defaults",
+			input:    map[string]interface{}{"env": "prod"},
+			expected: map[string]interface{}{"env": "prod"},
+		},
+		{
+			name:     "no tags on the resource",
+			defaults: map[string]string{"owner": "team"},
+			expected: map[string]interface{}{"owner": "team"},
+		},
+		{
+			name:     "merged",
+			defaults: map[string]string{"owner": "team"},
+			input:    map[string]interface{}{"env": "prod"},
+			expected: map[string]interface{}{"env": "prod", "owner": "team"},
+		},
+		{
+			name:     "resource takes precedence",
+			defaults: map[string]string{"owner": "team", "env": "dev"},
+			input:    map[string]interface{}{"env": "prod"},
+			expected: map[string]interface{}{"env": "prod", "owner": "team"},
+		},
+		{
+			name:     "resource takes precedence case-insensitively",
+			defaults: map[string]string{"owner": "team", "Env": "dev"},
+			input:    map[string]interface{}{"env": "prod"},
+			expected: map[string]interface{}{"env": "prod", "owner": "team"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := Config{DefaultTags: v.defaults}.MergeDefaults(v.input)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestConfigRemoveDefaults(t *testing.T) {
+	testData := []struct {
+		name       string
+		defaults   map[string]string
+		input      map[string]interface{}
+		configured map[string]interface{}
+		expected   map[string]interface{}
+	}{
+		{
+			name:     "no defaults",
+			input:    map[string]interface{}{"env": "prod"},
+			expected: map[string]interface{}{"env": "prod"},
+		},
+		{
+			name:     "defaults removed",
+			defaults: map[string]string{"owner": "team"},
+			input:    map[string]interface{}{"env": "prod", "owner": "team"},
+			expected: map[string]interface{}{"env": "prod"},
+		},
+		{
+			name:     "default with a different value is retained",
+			defaults: map[string]string{"owner": "team"},
+			input:    map[string]interface{}{"env": "prod", "owner": "someone-else"},
+			expected: map[string]interface{}{"env": "prod", "owner": "someone-else"},
+		},
+		{
+			name:       "configured default is retained",
+			defaults:   map[string]string{"owner": "team"},
+			input:      map[string]interface{}{"env": "prod", "owner": "team"},
+			configured: map[string]interface{}{"owner": "team"},
+			expected:   map[string]interface{}{"env": "prod", "owner": "team"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := Config{DefaultTags: v.defaults}.RemoveDefaults(v.input, v.configured)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestConfigRemoveIgnored(t *testing.T) {
+	config := Config{
+		IgnoreKeys:        []string{"CreatedBy"},
+		IgnoreKeyPrefixes: []string{"hidden-"},
+	}
+
+	input := map[string]interface{}{
+		"createdBy":   "policy",
+		"hidden-link": "something",
+		"env":         "prod",
+	}
+	expected := map[string]interface{}{
+		"env": "prod",
+	}
+
+	actual := config.RemoveIgnored(input)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestConfigMergeIgnored(t *testing.T) {
+	config := Config{
+		IgnoreKeys:        []string{"CreatedBy"},
+		IgnoreKeyPrefixes: []string{"hidden-"},
+	}
+
+	input := map[string]interface{}{
+		"env":       "prod",
+		"createdby": "terraform",
+	}
+	existing := map[string]interface{}{
+		"CreatedBy":   "policy",
+		"hidden-link": "something",
+		"env":         "dev",
+		"removed":     "value",
+	}
+	expected := map[string]interface{}{
+		"env":         "prod",
+		"createdby":   "terraform",
+		"hidden-link": "something",
+	}
+
+	actual := config.MergeIgnored(input, existing)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// ResourceIdentityForResourceId returns the Resource Identity Schema for the Resource ID Type specified
+// in `id`. The Identity contains a field for each of the Subscription, Resource Group, Scope, Constant and
+// User Specified segments within the Resource ID - where the last user specified segment is exposed
+// as `name` and any parent segments are exposed using the snake_cased name of the segment, for
+// example `virtual_network_name`.
+func ResourceIdentityForResourceId(id resourceids.ResourceId) *ResourceIdentity {
+	return &ResourceIdentity{
+		Version: 0,
+		SchemaFunc: func() map[string]*Schema {
+			out := make(map[string]*Schema)
+			for _, key := range identityKeysForResourceId(id) {
+				field := &Schema{
+					Type:              TypeString,
+					RequiredForImport: true,
+				}
+				if key == "subscription_id" {
+					// the Subscription ID can be inferred from the Provider when it's not specified
+					field.RequiredForImport = false
+					field.OptionalForImport = true
+				}
+				out[key] = field
+			}
+			return out
+		},
+	}
+}
+
+// ResourceIdentityValues returns the values for the Resource Identity of the Resource ID specified in `id`,
+// keyed by the field names defined in ResourceIdentityForResourceId.
+func ResourceIdentityValues(id resourceids.ResourceId) (map[string]string, error) {
+	parsed, err := resourceids.NewParserFromResourceIdType(id).Parse(id.ID(), false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %+v", id, err)
+	}
+
+	return identityValuesFromParseResult(id, *parsed)
+}
+
+// SetResourceIdentity sets the Resource Identity for the Resource ID specified in `id` on the ResourceData `d`,
+// the Resource must define an Identity using ResourceIdentityForResourceId for the same Resource ID Type.
+func SetResourceIdentity(d *ResourceData, id resourceids.ResourceId) error {
+	values, err := ResourceIdentityValues(id)
+	if err != nil {
+		return err
+	}
+
+	return setResourceIdentityValues(d, values)
+}
+
+// SetResourceIdentityFromId parses the ID of the ResourceData `d` as the Resource ID Type specified in `idType`
+// and sets the Resource Identity from it - as such this can be used where the ID isn't parsed into a typed ID.
+func SetResourceIdentityFromId(d *ResourceData, idType resourceids.ResourceId) error {
+	parsed, err := resourceids.NewParserFromResourceIdType(idType).Parse(d.Id(), true)
+	if err != nil {
+		return fmt.Errorf("parsing %q: %+v", d.Id(), err)
+	}
+
+	values, err := identityValuesFromParseResult(idType, *parsed)
+	if err != nil {
+		return err
+	}
+
+	return setResourceIdentityValues(d, values)
+}
+
+// ResourceIdFromIdentity returns the Resource ID for the Resource ID Type specified in `idType` using the values
+// from the Resource Identity of the ResourceData `d` - where the Subscription ID isn't specified in the Identity
+// `defaultSubscriptionId` is used.
+func ResourceIdFromIdentity(d *ResourceData, idType resourceids.ResourceId, defaultSubscriptionId string) (string, error) {
+	identity, err := d.Identity()
+	if err != nil {
+		return "", fmt.Errorf("retrieving the Resource Identity: %+v", err)
+	}
+
+	values := make(map[string]string)
+	for _, key := range identityKeysForResourceId(idType) {
+		if v, ok := identity.GetOk(key); ok {
+			values[key] = v.(string)
+		}
+	}
+
+	return ResourceIdFromIdentityValues(idType, values, defaultSubscriptionId)
+}
+
+// ResourceIdFromIdentityValues returns the Resource ID for the Resource ID Type specified in `idType` using the
+// Resource Identity `values`, keyed by the field names defined in ResourceIdentityForResourceId - where the
+// Subscription ID isn't specified `defaultSubscriptionId` is used.
+func ResourceIdFromIdentityValues(idType resourceids.ResourceId, values map[string]string, defaultSubscriptionId string) (string, error) {
+	keys := identityKeysForResourceId(idType)
+	components := make([]string, 0)
+	for _, segment := range idType.Segments() {
+		switch segment.Type {
+		case resourceids.StaticSegmentType, resourceids.ResourceProviderSegmentType:
+			if segment.FixedValue == nil {
+				return "", fmt.Errorf("the segment %q defined a fixed segment with no value", segment.Name)
+			}
+			components = append(components, *segment.FixedValue)
+			continue
+		}
+
+		key := keys[segment.Name]
+		value := values[key]
+		if value == "" {
+			if segment.Type == resourceids.SubscriptionIdSegmentType && defaultSubscriptionId != "" {
+				components = append(components, defaultSubscriptionId)
+				continue
+			}
+			return "", fmt.Errorf("`%s` must be specified in the Resource Identity", key)
+		}
+
+		// a Scope is itself a Resource ID, so has a leading slash
+		components = append(components, strings.TrimPrefix(value, "/"))
+	}
+
+	return "/" + strings.Join(components, "/"), nil
+}
+
+// setResourceIdentityValues sets `values` into the Resource Identity for the ResourceData `d`. Since Azure APIs can
+// return a different casing for the Resource ID, an existing value which differs only by casing is overwritten -
+// however an error is returned when an existing value has otherwise changed, since this refers to a different resource.
+func setResourceIdentityValues(d *ResourceData, values map[string]string) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource Identity: %+v", err)
+	}
+
+	for key, value := range values {
+		if existing, ok := identity.GetOk(key); ok && existing.(string) != "" && existing.(string) != value {
+			if !strings.EqualFold(existing.(string), value) {
+				return fmt.Errorf("the Resource ID %q no longer matches the Resource Identity - `%s` was %q but is now %q", d.Id(), key, existing.(string), value)
+			}
+
+			log.Printf("[WARN] the casing of `%s` in the Resource Identity for %q has changed from %q to %q - updating the Resource Identity", key, d.Id(), existing.(string), value)
+		}
+
+		if err := identity.Set(key, value); err != nil {
+			return fmt.Errorf("setting `%s` in the Resource Identity: %+v", key, err)
+		}
+	}
+
+	return nil
+}
+
+// identityValuesFromParseResult returns the values for each of the Identity fields for the Resource ID Type `idType`
+// from the parsed Resource ID `parsed`.
+func identityValuesFromParseResult(idType resourceids.ResourceId, parsed resourceids.ParseResult) (map[string]string, error) {
+	keys := identityKeysForResourceId(idType)
+	out := make(map[string]string, len(keys))
+	for segmentName, key := range keys {
+		value, ok := parsed.Parsed[segmentName]
+		if !ok {
+			return nil, fmt.Errorf("the segment %q was not found in %q", segmentName, parsed.RawInput)
+		}
+		out[key] = value
+	}
+	return out, nil
+}
+
+// identityKeysForResourceId returns a map of the Segment Name to the Identity field name for each of the
+// Segments within `id` which are exposed in the Resource Identity.
+func identityKeysForResourceId(id resourceids.ResourceId) map[string]string {
+	segments := id.Segments()
+
+	nameSegmentIndex := -1
+	for i, segment := range segments {
+		if segment.Type == resourceids.UserSpecifiedSegmentType || segment.Type == resourceids.ResourceGroupSegmentType {
+			nameSegmentIndex = i
+		}
+	}
+
+	out := make(map[string]string)
+	for i, segment := range segments {
+		switch segment.Type {
+		case resourceids.ConstantSegmentType, resourceids.SubscriptionIdSegmentType, resourceids.ResourceGroupSegmentType, resourceids.ScopeSegmentType, resourceids.UserSpecifiedSegmentType:
+			key := convertToSnakeCase(segment.Name)
+			if i == nameSegmentIndex {
+				key = "name"
+			}
+			out[segment.Name] = key
+		}
+	}
+	return out
+}
+
+func convertToSnakeCase(input string) string {
+	out := strings.Builder{}
+	for i, char := range input {
+		if unicode.IsUpper(char) {
+			if i > 0 {
+				out.WriteRune('_')
+			}
+			char = unicode.ToLower(char)
+		}
+		out.WriteRune(char)
+	}
+	return out.String()
+}
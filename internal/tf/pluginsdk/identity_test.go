@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceIdentityForResourceId(t *testing.T) {
+	identity := ResourceIdentityForResourceId(&commonids.SubnetId{})
+	identitySchema := identity.SchemaFunc()
+
+	expected := map[string]bool{
+		"subscription_id":      false,
+		"resource_group_name":  true,
+		"virtual_network_name": true,
+		"name":                 true,
+	}
+	if len(identitySchema) != len(expected) {
+		t.Fatalf("expected %d fields but got %d", len(expected), len(identitySchema))
+	}
+	for key, requiredForImport := range expected {
+		field, ok := identitySchema[key]
+		if !ok {
+			t.Fatalf("expected the field %q to be present", key)
+		}
+		if field.RequiredForImport != requiredForImport {
+			t.Fatalf("expected `RequiredForImport` for %q to be %t but got %t", key, requiredForImport, field.RequiredForImport)
+		}
+	}
+}
+
+func TestResourceIdFromIdentity(t *testing.T) {
+	testData := []struct {
+		name     string
+		identity map[string]string
+		expected string
+		error    bool
+	}{
+		{
+			name: "all fields",
+			identity: map[string]string{
+				"subscription_id":      "12345678-1234-9876-4563-123456789012",
+				"resource_group_name":  "group1",
+				"virtual_network_name": "network1",
+				"name":                 "subnet1",
+			},
+			expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+		},
+		{
+			name: "default subscription",
+			identity: map[string]string{
+				"resource_group_name":  "group1",
+				"virtual_network_name": "network1",
+				"name":                 "subnet1",
+			},
+			expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+		},
+		{
+			name: "missing parent name",
+			identity: map[string]string{
+				"resource_group_name": "group1",
+				"name":                "subnet1",
+			},
+			error: true,
+		},
+	}
+
+	identitySchema := ResourceIdentityForResourceId(&commonids.SubnetId{}).SchemaFunc()
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		d := schema.TestResourceDataWithIdentityRaw(t, map[string]*schema.Schema{}, identitySchema, v.identity)
+		actual, err := ResourceIdFromIdentity(d, &commonids.SubnetId{}, "00000000-0000-0000-0000-000000000000")
+		if err != nil {
+			if v.error {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.error {
+			t.Fatalf("expected an error but got %q", actual)
+		}
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestSetResourceIdentityDetectsDrift(t *testing.T) {
+	identitySchema := ResourceIdentityForResourceId(&commonids.ResourceGroupId{}).SchemaFunc()
+	d := schema.TestResourceDataWithIdentityRaw(t, map[string]*schema.Schema{}, identitySchema, map[string]string{
+		"subscription_id": "12345678-1234-9876-4563-123456789012",
+		"name":            "group1",
+	})
+
+	id := commonids.NewResourceGroupID("12345678-1234-9876-4563-123456789012", "group1")
+	if err := SetResourceIdentity(d, &id); err != nil {
+		t.Fatalf("unexpected error when the Identity matches: %+v", err)
+	}
+
+	recased := commonids.NewResourceGroupID("12345678-1234-9876-4563-123456789012", "Group1")
+	if err := SetResourceIdentity(d, &recased); err != nil {
+		t.Fatalf("unexpected error when the casing of the Identity has changed: %+v", err)
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		t.Fatalf("retrieving the Identity: %+v", err)
+	}
+	if actual := identity.Get("name").(string); actual != "Group1" {
+		t.Fatalf("expected the `name` in the Identity to be updated to %q but got %q", "Group1", actual)
+	}
+
+	different := commonids.NewResourceGroupID("12345678-1234-9876-4563-123456789012", "group2")
+	if err := SetResourceIdentity(d, &different); err == nil {
+		t.Fatalf("expected an error when the Identity refers to a different resource")
+	}
+}
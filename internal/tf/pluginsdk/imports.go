@@ -5,8 +5,10 @@ package pluginsdk
 
 import (
 	"context"
+	"fmt"
 	"log"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -45,3 +47,35 @@ func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc Im
 		},
 	}
 }
+
+// defaultSubscriptionIdProvider is implemented by the Provider Meta, allowing the Subscription ID to be
+// omitted from the Resource Identity at import time.
+type defaultSubscriptionIdProvider interface {
+	DefaultSubscriptionId() string
+}
+
+// ImporterValidatingIdentity allows the resource to be imported using either the Resource ID, which is
+// validated by `importer`, or the Resource Identity for the Resource ID Type `idType` (as defined by
+// ResourceIdentityForResourceId) - which is converted into the Resource ID prior to calling `importer`.
+func ImporterValidatingIdentity(idType resourceids.ResourceId, importer *schema.ResourceImporter) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
+			if d.Id() == "" {
+				subscriptionId := ""
+				if v, ok := meta.(defaultSubscriptionIdProvider); ok {
+					subscriptionId = v.DefaultSubscriptionId()
+				}
+
+				id, err := ResourceIdFromIdentity(d, idType, subscriptionId)
+				if err != nil {
+					return []*ResourceData{d}, fmt.Errorf("building the Resource ID from the Resource Identity: %+v", err)
+				}
+
+				log.Printf("[DEBUG] Importing Resource - built the Resource ID %q from the Resource Identity", id)
+				d.SetId(id)
+			}
+
+			return importer.StateContext(ctx, d, meta)
+		},
+	}
+}
@@ -18,6 +18,7 @@ type (
 	Resource               = schema.Resource
 	ResourceData           = schema.ResourceData
 	ResourceDiff           = schema.ResourceDiff
+	ResourceIdentity       = schema.ResourceIdentity
 	SchemaDiffSuppressFunc = schema.SchemaDiffSuppressFunc
 	StateUpgrader          = schema.StateUpgrader
 	SchemaValidateFunc     = func(interface{}, string) ([]string, []error)
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// GetWriteOnly retrieves the value of the top-level Write-Only attribute 'name' from the raw configuration.
+//
+// Write-Only attributes are never persisted into the Plan or State, as such their values aren't available
+// via Get/GetOk and must be read from the configuration instead. A null value is returned when the
+// attribute hasn't been specified.
+func GetWriteOnly(d *ResourceData, name string, attributeType cty.Type) (cty.Value, error) {
+	value, diags := d.GetRawConfigAt(cty.GetAttrPath(name))
+	if diags.HasError() {
+		return cty.NullVal(attributeType), fmt.Errorf("retrieving Write-Only attribute `%s`: %+v", name, flattenDiagnostics(diags))
+	}
+
+	if !value.Type().Equals(attributeType) {
+		return cty.NullVal(attributeType), fmt.Errorf("retrieving Write-Only attribute `%s`: expected the type %s but got %s", name, attributeType.FriendlyName(), value.Type().FriendlyName())
+	}
+
+	return value, nil
+}
+
+// GetWriteOnlyString retrieves the value of the top-level Write-Only String attribute 'name' from the
+// raw configuration, returning an empty string when the attribute hasn't been specified.
+func GetWriteOnlyString(d *ResourceData, name string) (string, error) {
+	value, err := GetWriteOnly(d, name, cty.String)
+	if err != nil {
+		return "", err
+	}
+
+	if value.IsNull() || !value.IsKnown() {
+		return "", nil
+	}
+
+	return value.AsString(), nil
+}
+
+func flattenDiagnostics(input diag.Diagnostics) string {
+	output := ""
+	for _, v := range input {
+		if output != "" {
+			output += "\n"
+		}
+		output += fmt.Sprintf("%s: %s", v.Summary, v.Detail)
+	}
+	return output
+}
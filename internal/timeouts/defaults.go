@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"path"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// Values contains a timeout for each operation, where a nil value means the timeout defined on the Resource is used.
+type Values struct {
+	Create *time.Duration
+	Read   *time.Duration
+	Update *time.Duration
+	Delete *time.Duration
+}
+
+// IsEmpty returns whether none of the timeouts have been set.
+func (v Values) IsEmpty() bool {
+	return v.Create == nil && v.Read == nil && v.Update == nil && v.Delete == nil
+}
+
+// merge returns these Values, overridden by any timeouts set in other.
+func (v Values) merge(other Values) Values {
+	if other.Create != nil {
+		v.Create = other.Create
+	}
+	if other.Read != nil {
+		v.Read = other.Read
+	}
+	if other.Update != nil {
+		v.Update = other.Update
+	}
+	if other.Delete != nil {
+		v.Delete = other.Delete
+	}
+	return v
+}
+
+// ResourceTypeDefaults contains the default timeouts for the Resource Types matching Name, which supports `*`
+// wildcards - for example `azurerm_kubernetes_cluster*`.
+type ResourceTypeDefaults struct {
+	Name string
+	Values
+}
+
+// Matches returns whether the specified Resource Type is matched by Name.
+func (r ResourceTypeDefaults) Matches(resourceType string) bool {
+	matched, err := path.Match(r.Name, resourceType)
+	return err == nil && matched
+}
+
+// Defaults contains the Provider-level default timeouts, as defined in the `default_timeouts` block within the
+// Provider block. These replace the default timeouts defined on each Resource, but not any timeouts defined in
+// the `timeouts` block of a Resource.
+type Defaults struct {
+	// Values are the default timeouts for all Resources.
+	Values
+
+	// ResourceTypes are the default timeouts for specific Resource Types, taking precedence over Values. Where a
+	// Resource Type is matched by multiple entries, the latter entries take precedence.
+	ResourceTypes []ResourceTypeDefaults
+}
+
+// IsEmpty returns whether any default timeouts have been configured.
+func (d Defaults) IsEmpty() bool {
+	if !d.Values.IsEmpty() {
+		return false
+	}
+
+	for _, v := range d.ResourceTypes {
+		if !v.Values.IsEmpty() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ForResourceType returns the default timeouts which apply to the specified Resource Type.
+func (d Defaults) ForResourceType(resourceType string) Values {
+	output := d.Values
+	for _, v := range d.ResourceTypes {
+		if v.Matches(resourceType) {
+			output = output.merge(v.Values)
+		}
+	}
+	return output
+}
+
+// ApplyTo returns a copy of the specified timeouts overridden by these Values. Only the operations which the
+// Resource supports (that is, which are non-nil in input) are overridden, since Terraform only allows those to be
+// configured within the `timeouts` block.
+func (v Values) ApplyTo(input *pluginsdk.ResourceTimeout) *pluginsdk.ResourceTimeout {
+	if input == nil {
+		return nil
+	}
+
+	output := *input
+	if output.Create != nil && v.Create != nil {
+		output.Create = v.Create
+	}
+	if output.Read != nil && v.Read != nil {
+		output.Read = v.Read
+	}
+	if output.Update != nil && v.Update != nil {
+		output.Update = v.Update
+	}
+	if output.Delete != nil && v.Delete != nil {
+		output.Delete = v.Delete
+	}
+	return &output
+}
+
+// the Timeouts defined on each Resource are retained the first time the defaults are applied, such that the
+// defaults can be re-applied (e.g. when the Provider is configured multiple times within the test suite)
+var (
+	resourceTimeouts     = map[*pluginsdk.Resource]*pluginsdk.ResourceTimeout{}
+	resourceTimeoutsLock sync.Mutex
+)
+
+// ApplyDefaults replaces the default timeouts defined on each of the specified Resources with the Provider-level
+// default timeouts. Since Terraform determines the timeouts for a Resource when planning (which happens after the
+// Provider has been configured) these are then used for both Typed and Untyped Resources, unless the timeouts have
+// been overridden in the `timeouts` block of the Resource.
+func ApplyDefaults(resources map[string]*pluginsdk.Resource, defaults Defaults) {
+	resourceTimeoutsLock.Lock()
+	defer resourceTimeoutsLock.Unlock()
+
+	for resourceType, resource := range resources {
+		if resource == nil || resource.Timeouts == nil {
+			continue
+		}
+
+		original, ok := resourceTimeouts[resource]
+		if !ok {
+			// there's nothing to do (or revert) for this Resource, so it's left as-is
+			if defaults.IsEmpty() {
+				continue
+			}
+			original = resource.Timeouts
+			resourceTimeouts[resource] = original
+		}
+
+		resource.Timeouts = defaults.ForResourceType(resourceType).ApplyTo(original)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func duration(d time.Duration) *time.Duration {
+	return &d
+}
+
+func TestDefaultsForResourceType(t *testing.T) {
+	defaults := Defaults{
+		Values: Values{
+			Create: duration(time.Hour),
+			Delete: duration(time.Hour),
+		},
+		ResourceTypes: []ResourceTypeDefaults{
+			{
+				Name: "azurerm_kubernetes_cluster*",
+				Values: Values{
+					Create: duration(3 * time.Hour),
+					Update: duration(3 * time.Hour),
+				},
+			},
+			{
+				Name: "azurerm_kubernetes_cluster_node_pool",
+				Values: Values{
+					Update: duration(2 * time.Hour),
+				},
+			},
+		},
+	}
+
+	testData := []struct {
+		resourceType string
+		expected     Values
+	}{
+		{
+			resourceType: "azurerm_resource_group",
+			expected: Values{
+				Create: duration(time.Hour),
+				Delete: duration(time.Hour),
+			},
+		},
+		{
+			resourceType: "azurerm_kubernetes_cluster",
+			expected: Values{
+				Create: duration(3 * time.Hour),
+				Update: duration(3 * time.Hour),
+				Delete: duration(time.Hour),
+			},
+		},
+		{
+			resourceType: "azurerm_kubernetes_cluster_node_pool",
+			expected: Values{
+				Create: duration(3 * time.Hour),
+				Update: duration(2 * time.Hour),
+				Delete: duration(time.Hour),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.resourceType)
+		actual := defaults.ForResourceType(v.resourceType)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %s but got %s", formatValues(v.expected), formatValues(actual))
+		}
+	}
+}
+
+func TestApplyDefaults(t *testing.T) {
+	resource := &pluginsdk.Resource{
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: duration(30 * time.Minute),
+			Read:   duration(5 * time.Minute),
+			Delete: duration(30 * time.Minute),
+		},
+	}
+	resources := map[string]*pluginsdk.Resource{
+		"azurerm_example": resource,
+	}
+
+	ApplyDefaults(resources, Defaults{
+		Values: Values{
+			Create: duration(time.Hour),
+			Update: duration(time.Hour),
+		},
+	})
+	if *resource.Timeouts.Create != time.Hour {
+		t.Fatalf("expected the Create timeout to be 1h but got %s", *resource.Timeouts.Create)
+	}
+	if *resource.Timeouts.Read != 5*time.Minute {
+		t.Fatalf("expected the Read timeout to be 5m but got %s", *resource.Timeouts.Read)
+	}
+	if resource.Timeouts.Update != nil {
+		t.Fatalf("expected the Update timeout to be nil since the Resource doesn't support Update but got %s", *resource.Timeouts.Update)
+	}
+
+	// re-applying the defaults should use the timeouts originally defined on the Resource
+	ApplyDefaults(resources, Defaults{})
+	if *resource.Timeouts.Create != 30*time.Minute {
+		t.Fatalf("expected the Create timeout to be reverted to 30m but got %s", *resource.Timeouts.Create)
+	}
+}
+
+func formatValues(input Values) string {
+	format := func(v *time.Duration) string {
+		if v == nil {
+			return "nil"
+		}
+		return v.String()
+	}
+	return "create=" + format(input.Create) + " read=" + format(input.Read) + " update=" + format(input.Update) + " delete=" + format(input.Delete)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"fmt"
+	"path"
+	"time"
+)
+
+// ValidateDuration validates that the specified value is a duration greater than zero, for example `30m`.
+func ValidateDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration, for example `30m` or `2h`: %+v", k, err))
+		return
+	}
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than zero, got %q", k, v))
+	}
+
+	return
+}
+
+// ValidateResourceTypePattern validates that the specified value is a Resource Type, which supports `*` wildcards.
+func ValidateResourceTypePattern(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+	if _, err := path.Match(v, ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid pattern, got %q: %+v", k, v, err))
+	}
+
+	return
+}
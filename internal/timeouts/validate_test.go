@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"testing"
+)
+
+func TestValidateDuration(t *testing.T) {
+	testData := map[string]bool{
+		"":      false,
+		"30":    false,
+		"0s":    false,
+		"-5m":   false,
+		"30m":   true,
+		"1h30m": true,
+	}
+
+	for input, valid := range testData {
+		_, errors := ValidateDuration(input, "create")
+		if (len(errors) == 0) != valid {
+			t.Fatalf("expected %q to be valid=%t but got %+v", input, valid, errors)
+		}
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/model"
+)
+
+// validate the example configurations (`hcl`/`terraform` code fences) within a document against the provider schema
+
+type exampleDiff struct {
+	checkBase
+	msg string
+}
+
+func newExampleDiff(line int, key, msg string) exampleDiff {
+	return exampleDiff{
+		checkBase: newCheckBase(line, key, &model.Field{Name: key, Line: line, Pos: model.PosExample}),
+		msg:       msg,
+	}
+}
+
+func (e exampleDiff) String() string {
+	return fmt.Sprintf("%s %s", e.checkBase.Str(), e.msg)
+}
+
+// Fix the examples can't be fixed automatically, so the line is returned as-is
+func (e exampleDiff) Fix(line string) (string, error) {
+	return line, nil
+}
+
+var _ Checker = (*exampleDiff)(nil)
+
+// metaArguments are the arguments which Terraform supports for all resources and data sources
+var metaArguments = map[string]struct{}{
+	"count":      {},
+	"depends_on": {},
+	"for_each":   {},
+	"provider":   {},
+}
+
+// metaBlocks are the blocks which Terraform supports for all resources (`timeouts` is checked separately, since
+// it's only supported when the resource defines timeouts)
+var metaBlocks = map[string]struct{}{
+	"connection":  {},
+	"lifecycle":   {},
+	"provisioner": {},
+}
+
+var (
+	providerSchema     *schema.Provider
+	providerSchemaOnce sync.Once
+)
+
+func azurermProvider() *schema.Provider {
+	providerSchemaOnce.Do(func() {
+		providerSchema = provider.AzureProvider()
+	})
+	return providerSchema
+}
+
+// exampleBlock is a code fence containing an example configuration
+type exampleBlock struct {
+	line    int // the line of the opening fence, 0 based
+	content string
+}
+
+// extractExamples returns the `hcl` and `terraform` code fences within the markdown document
+func extractExamples(content string) (res []exampleBlock) {
+	var current *exampleBlock
+	var sb strings.Builder
+	for idx, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if current == nil {
+			if lang := strings.TrimPrefix(trimmed, "```"); lang != trimmed && (strings.EqualFold(lang, "hcl") || strings.EqualFold(lang, "terraform")) {
+				current = &exampleBlock{line: idx}
+				sb.Reset()
+			}
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "```") {
+			current.content = sb.String()
+			res = append(res, *current)
+			current = nil
+			continue
+		}
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+	return
+}
+
+// checkExamples validates each of the example configurations within the markdown file
+func checkExamples(mdFile string) (res []Checker) {
+	content, err := os.ReadFile(mdFile)
+	if err != nil {
+		return nil
+	}
+
+	p := azurermProvider()
+	for _, example := range extractExamples(string(content)) {
+		res = append(res, checkExample(example, p.ResourcesMap, p.DataSourcesMap)...)
+	}
+	return
+}
+
+func checkExample(example exampleBlock, resources, dataSources map[string]*schema.Resource) (res []Checker) {
+	// the position within the example is 1 based, and the example starts on the line after the opening fence
+	lineOf := func(pos hcl.Pos) int {
+		return example.line + pos.Line
+	}
+
+	file, diags := hclsyntax.ParseConfig([]byte(example.content), "example.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		for _, diag := range diags {
+			line := example.line + 1
+			if diag.Subject != nil {
+				line = lineOf(diag.Subject.Start)
+			}
+			res = append(res, newExampleDiff(line, "example", fmt.Sprintf("is not valid HCL: %s", diag.Error())))
+		}
+		return
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return
+	}
+
+	for _, block := range body.Blocks {
+		if len(block.Labels) != 2 || (block.Type != "resource" && block.Type != "data") {
+			continue
+		}
+
+		resourceType, name := block.Labels[0], block.Labels[1]
+		// only the resources within this provider can be validated
+		if !strings.HasPrefix(resourceType, "azurerm_") {
+			continue
+		}
+
+		kind, schemas := "resource", resources
+		if block.Type == "data" {
+			kind, schemas = "data source", dataSources
+		}
+		key := fmt.Sprintf("%s.%s", resourceType, name)
+		if block.Type == "data" {
+			key = "data." + key
+		}
+
+		r, ok := schemas[resourceType]
+		if !ok {
+			res = append(res, newExampleDiff(lineOf(block.TypeRange.Start), key, fmt.Sprintf("uses the unknown %s `%s`", kind, resourceType)))
+			continue
+		}
+
+		res = append(res, checkExampleBody([]byte(example.content), block.Body, r.Schema, key, lineOf, true, r.Timeouts != nil)...)
+	}
+
+	return
+}
+
+func checkExampleBody(src []byte, body *hclsyntax.Body, s map[string]*schema.Schema, key string, lineOf func(hcl.Pos) int, topLevel, hasTimeouts bool) (res []Checker) {
+	configured := make(map[string]struct{})
+
+	names := make([]string, 0, len(body.Attributes))
+	for name := range body.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		attr := body.Attributes[name]
+		path := key + "." + name
+		configured[name] = struct{}{}
+
+		if _, ok := metaArguments[name]; ok && topLevel {
+			continue
+		}
+
+		prop, ok := s[name]
+		switch {
+		case !ok:
+			res = append(res, newExampleDiff(lineOf(attr.NameRange.Start), path, "is not a valid argument"))
+		case prop.Computed && !prop.Optional && !prop.Required:
+			res = append(res, newExampleDiff(lineOf(attr.NameRange.Start), path, "is Computed only so cannot be set"))
+		case isBlockSchema(prop) && prop.ConfigMode != schema.SchemaConfigModeAttr:
+			res = append(res, newExampleDiff(lineOf(attr.NameRange.Start), path, "is a block, not an argument"))
+		}
+	}
+
+	for _, block := range body.Blocks {
+		name := block.Type
+		content := block.Body
+		if name == "dynamic" && len(block.Labels) == 1 {
+			// the content of a dynamic block is validated as the block it generates
+			name = block.Labels[0]
+			content = nil
+			for _, b := range block.Body.Blocks {
+				if b.Type == "content" {
+					content = b.Body
+				}
+			}
+		}
+		path := key + "." + name
+		configured[name] = struct{}{}
+
+		if topLevel {
+			if _, ok := metaBlocks[name]; ok {
+				continue
+			}
+			if name == "timeouts" {
+				if !hasTimeouts {
+					res = append(res, newExampleDiff(lineOf(block.TypeRange.Start), path, "is not supported, since there are no timeouts for this resource"))
+				}
+				continue
+			}
+		}
+
+		prop, ok := s[name]
+		if !ok {
+			res = append(res, newExampleDiff(lineOf(block.TypeRange.Start), path, "is not a valid block"))
+			continue
+		}
+		if !isBlockSchema(prop) {
+			res = append(res, newExampleDiff(lineOf(block.TypeRange.Start), path, "is an argument, not a block"))
+			continue
+		}
+		if prop.Computed && !prop.Optional && !prop.Required {
+			res = append(res, newExampleDiff(lineOf(block.TypeRange.Start), path, "is Computed only so cannot be set"))
+			continue
+		}
+
+		if content != nil {
+			res = append(res, checkExampleBody(src, content, prop.Elem.(*schema.Resource).Schema, path, lineOf, false, false)...)
+		}
+	}
+
+	// examples are often abbreviated using `# ...`, in which case the Required arguments may have been omitted
+	if strings.Contains(string(body.SrcRange.SliceBytes(src)), "...") {
+		return
+	}
+
+	requiredNames := make([]string, 0)
+	for name, prop := range s {
+		if prop.Required {
+			requiredNames = append(requiredNames, name)
+		}
+	}
+	sort.Strings(requiredNames)
+
+	for _, name := range requiredNames {
+		if _, ok := configured[name]; !ok {
+			res = append(res, newExampleDiff(lineOf(body.SrcRange.Start), key+"."+name, "is Required but is not set"))
+		}
+	}
+
+	return
+}
+
+func isBlockSchema(s *schema.Schema) bool {
+	if s.Type != schema.TypeList && s.Type != schema.TypeSet {
+		return false
+	}
+	_, ok := s.Elem.(*schema.Resource)
+	return ok
+}
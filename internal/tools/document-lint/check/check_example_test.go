@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testExampleSchemas() (resources, dataSources map[string]*schema.Resource) {
+	resources = map[string]*schema.Resource{
+		"azurerm_example": {
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"sku": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"id_value": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"network": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"subnet_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+			Timeouts: &schema.ResourceTimeout{},
+		},
+	}
+	dataSources = map[string]*schema.Resource{
+		"azurerm_example": {
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+	return
+}
+
+func TestExtractExamples(t *testing.T) {
+	content := "# Title\n\n```hcl\nresource \"azurerm_example\" \"a\" {}\n```\n\n```shell\nterraform import\n```\n\n```terraform\nlocals {}\n```\n"
+	examples := extractExamples(content)
+	if len(examples) != 2 {
+		t.Fatalf("expected 2 examples but got %d: %+v", len(examples), examples)
+	}
+	if examples[0].line != 2 || examples[1].line != 10 {
+		t.Fatalf("expected the examples to start on lines 2 and 10 but got %d and %d", examples[0].line, examples[1].line)
+	}
+	if !strings.Contains(examples[0].content, "azurerm_example") {
+		t.Fatalf("expected the first example to contain the resource but got %q", examples[0].content)
+	}
+}
+
+func TestCheckExample(t *testing.T) {
+	resources, dataSources := testExampleSchemas()
+
+	valid := `
+data "azurerm_example" "test" {
+  name = "existing"
+}
+
+resource "azurerm_example" "test" {
+  name     = "example"
+  sku      = data.azurerm_example.test.name
+  count    = 1
+
+  dynamic "network" {
+    for_each = ["a"]
+    content {
+      subnet_id = network.value
+    }
+  }
+
+  timeouts {
+    create = "1h"
+  }
+
+  lifecycle {
+    ignore_changes = [sku]
+  }
+}
+
+resource "azurerm_example" "abbreviated" {
+  # ...
+}
+
+resource "random_string" "test" {
+  length = 8
+}
+`
+	if res := checkExample(exampleBlock{line: 10, content: valid}, resources, dataSources); len(res) > 0 {
+		t.Fatalf("expected no issues but got: %+v", res)
+	}
+
+	invalid := `
+resource "azurerm_example" "test" {
+  sku      = "Basic"
+  sku_name = "Basic"
+  id_value = "foo"
+
+  network {
+    subnet = "foo"
+  }
+}
+
+resource "azurerm_missing" "test" {
+}
+
+data "azurerm_example" "test" {
+  name {
+  }
+}
+`
+	res := checkExample(exampleBlock{line: 10, content: invalid}, resources, dataSources)
+	expected := map[string]string{
+		"azurerm_example.test.sku_name":          "is not a valid argument",
+		"azurerm_example.test.id_value":          "is Computed only",
+		"azurerm_example.test.name":              "is Required",
+		"azurerm_example.test.network.subnet":    "is not a valid argument",
+		"azurerm_example.test.network.subnet_id": "is Required",
+		"azurerm_missing.test":                   "unknown resource",
+		"data.azurerm_example.test.name":         "is an argument, not a block",
+	}
+	if len(res) != len(expected) {
+		t.Fatalf("expected %d issues but got %d: %+v", len(expected), len(res), res)
+	}
+	for _, item := range res {
+		msg, ok := expected[item.Key()]
+		if !ok || !strings.Contains(item.String(), msg) {
+			t.Errorf("unexpected issue %q", item.String())
+		}
+		if item.ShouldSkip() {
+			t.Errorf("expected %q not to be skipped", item.String())
+		}
+	}
+
+	// `sku_name` is on the 4th line of the example, which starts on the line after the fence
+	for _, item := range res {
+		if item.Key() == "azurerm_example.test.sku_name" && item.Line() != 14 {
+			t.Errorf("expected `sku_name` to be on line 14 but got %d", item.Line())
+		}
+	}
+}
+
+func TestCheckExampleInvalidHCL(t *testing.T) {
+	resources, dataSources := testExampleSchemas()
+
+	res := checkExample(exampleBlock{line: 0, content: "resource \"azurerm_example\" \"test\" {\n  name = \n}\n"}, resources, dataSources)
+	if len(res) == 0 || !strings.Contains(res[0].String(), "is not valid HCL") {
+		t.Fatalf("expected a parse error but got: %+v", res)
+	}
+}
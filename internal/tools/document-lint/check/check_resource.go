@@ -95,4 +95,6 @@ func (r *ResourceDiff) DiffAll() {
 
 	timeouts := diffTimeout(r.tf, r.md)
 	r.Diff = append(r.Diff, timeouts...)
+
+	r.Diff = append(r.Diff, checkExamples(r.MDFile)...)
 }
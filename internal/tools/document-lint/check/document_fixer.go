@@ -115,6 +115,11 @@ func (f *Fixer) TryFix() (err error) {
 			continue
 		}
 
+		// the example configurations have to be fixed manually
+		if _, ok := item.(exampleDiff); ok {
+			continue
+		}
+
 		// mdField is nil for no document exists or page title mismatch
 		if item.ShouldSkip() {
 			continue
@@ -73,7 +73,7 @@ func AzurermAllResources(service, skipService string, resources, skipResources s
 		if shouldSkipRP(name) {
 			continue
 		}
-		for _, svc := range r.Resources() {
+		for _, svc := range provider.TypedResourcesForService(r) {
 			if shouldSKipResource(svc.ResourceType()) {
 				continue
 			}
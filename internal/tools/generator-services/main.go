@@ -295,7 +295,7 @@ func (githubIssueLabelsGenerator) run(outputFileName string, _ map[string]struct
 		}
 
 		var names []string
-		for _, resource := range service.Resources() {
+		for _, resource := range provider.TypedResourcesForService(service) {
 			names = append(names, resource.ResourceType())
 		}
 
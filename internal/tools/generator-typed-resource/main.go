@@ -0,0 +1,1874 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// NOTE: since we're using `go run` for these tools all of the code needs to live within the main.go
+
+const (
+	providerModule = "github.com/hashicorp/terraform-provider-azurerm"
+	sdkModule      = "github.com/hashicorp/go-azure-sdk/resource-manager"
+)
+
+func main() {
+	service := flag.String("service", "", "The name of the Service within `hashicorp/go-azure-sdk`, e.g. `dnsresolver`")
+	apiVersion := flag.String("api-version", "", "The API Version of the Service, e.g. `2022-07-01`")
+	sdkPackageName := flag.String("package", "", "The name of the SDK Package containing the Resource, e.g. `dnsresolvers`")
+	sdkResourceName := flag.String("resource", "", "The name of the Resource within the SDK Package, used to find the Resource ID, e.g. `DnsResolver`")
+	resourceName := flag.String("name", "", "The name of the Resource which should be generated, e.g. `azurerm_private_dns_resolver`")
+	servicePackagePath := flag.String("path", "", "The relative path to the Service Package, e.g. `./internal/services/privatednsresolver`")
+	rootDir := flag.String("root-dir", ".", "The path to the project root")
+	brandName := flag.String("brand-name", "", "The friendly/brand name of this Resource (e.g. Private DNS Resolver). Required when `-website-path` is set.")
+	websitePath := flag.String("website-path", "", "The relative path to the website folder, when specified the documentation is scaffolded via `website-scaffold`")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	quitWithError := func(message string) {
+		log.Print(message)
+		os.Exit(1)
+	}
+
+	if *service == "" || *apiVersion == "" || *sdkPackageName == "" || *sdkResourceName == "" {
+		quitWithError("The SDK Package must be specified via `-service`, `-api-version`, `-package` and `-resource`")
+		return
+	}
+
+	if !strings.HasPrefix(*resourceName, "azurerm_") {
+		quitWithError("The name of the Resource must be specified via `-name` and start with `azurerm_`")
+		return
+	}
+
+	if *servicePackagePath == "" {
+		quitWithError("The Relative Path to the Service Package must be specified via `-path`")
+		return
+	}
+
+	if *websitePath != "" && *brandName == "" {
+		quitWithError("The friendly/brand name of the Resource must be specified via `-brand-name` when `-website-path` is set")
+		return
+	}
+
+	input := generatorInput{
+		rootDir:            *rootDir,
+		sdkImportPath:      path.Join(sdkModule, *service, *apiVersion, *sdkPackageName),
+		sdkResourceName:    *sdkResourceName,
+		resourceName:       *resourceName,
+		servicePackagePath: *servicePackagePath,
+		brandName:          *brandName,
+		websitePath:        *websitePath,
+	}
+	if err := run(input); err != nil {
+		panic(err)
+	}
+}
+
+type generatorInput struct {
+	rootDir            string
+	sdkImportPath      string
+	sdkResourceName    string
+	resourceName       string
+	servicePackagePath string
+	brandName          string
+	websitePath        string
+}
+
+func run(input generatorInput) error {
+	pkg, err := loadSDKPackage(input.rootDir, input.sdkImportPath)
+	if err != nil {
+		return fmt.Errorf("loading the SDK Package %q: %+v", input.sdkImportPath, err)
+	}
+
+	resource, err := newResourceDefinition(pkg, input.sdkResourceName, input.resourceName)
+	if err != nil {
+		return fmt.Errorf("building the Resource Definition: %+v", err)
+	}
+
+	registrationFilePath := filepath.Join(input.servicePackagePath, "registration.go")
+	servicePackageName, err := parsePackageName(registrationFilePath)
+	if err != nil {
+		return fmt.Errorf("determining the Service Package Name from %q: %+v", registrationFilePath, err)
+	}
+	resource.servicePackageName = *servicePackageName
+
+	// check the Resource can be registered before generating anything
+	registration, err := os.ReadFile(registrationFilePath)
+	if err != nil {
+		return fmt.Errorf("reading %q: %+v", registrationFilePath, err)
+	}
+	if _, err := findResourcesList(token.NewFileSet(), registrationFilePath, registration); err != nil {
+		return fmt.Errorf("parsing %q: %+v", registrationFilePath, err)
+	}
+
+	serviceClientPath := path.Join(providerModule, "internal/services", filepath.Base(filepath.Clean(input.servicePackagePath)), "client")
+	clientsFilePath := filepath.Join(input.rootDir, "internal", "clients", "client.go")
+	serviceClient, err := findServiceClient(clientsFilePath, serviceClientPath)
+	if err != nil {
+		return fmt.Errorf("finding the Service Client for %q in %q: %+v", serviceClientPath, clientsFilePath, err)
+	}
+	resource.serviceClient = *serviceClient
+
+	fileName := strings.TrimPrefix(input.resourceName, "azurerm_") + "_resource"
+	resourceFilePath := filepath.Join(input.servicePackagePath, fileName+".go")
+	testFilePath := filepath.Join(input.servicePackagePath, fileName+"_test.go")
+	for _, filePath := range []string{resourceFilePath, testFilePath} {
+		if _, err := os.Stat(filePath); err == nil {
+			return fmt.Errorf("%q already exists", filePath)
+		}
+	}
+
+	clientFilePath := filepath.Join(input.servicePackagePath, "client", "client.go")
+	clientField, err := registerClient(clientFilePath, pkg, resource.clientType)
+	if err != nil {
+		return fmt.Errorf("registering the %s in %q: %+v", resource.clientType, clientFilePath, err)
+	}
+	resource.clientField = *clientField
+
+	if err := goFmtAndWriteToFile(resourceFilePath, resource.code()); err != nil {
+		return fmt.Errorf("generating the Resource at %q: %+v", resourceFilePath, err)
+	}
+
+	if err := goFmtAndWriteToFile(testFilePath, resource.testCode()); err != nil {
+		return fmt.Errorf("generating the Resource Tests at %q: %+v", testFilePath, err)
+	}
+
+	if err := registerResource(registrationFilePath, resource.typeName+"Resource"); err != nil {
+		return fmt.Errorf("registering the Resource in %q: %+v", registrationFilePath, err)
+	}
+
+	log.Printf("Generated %q and %q - search these for `TODO` to complete the Resource", resourceFilePath, testFilePath)
+
+	if input.websitePath == "" {
+		return nil
+	}
+
+	// the documentation is generated from the schema, so `website-scaffold` needs to compile the provider including this Resource
+	cmd := exec.Command("go", "run", "./internal/tools/website-scaffold", "-name", input.resourceName, "-brand-name", input.brandName, "-type", "resource", "-resource-id", resource.exampleId, "-website-path", input.websitePath)
+	cmd.Dir = input.rootDir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("scaffolding the documentation: %+v", err)
+	}
+
+	return nil
+}
+
+// sdkPackage contains the types, functions and methods defined within a `hashicorp/go-azure-sdk` package
+type sdkPackage struct {
+	name       string
+	importPath string
+	dir        string
+
+	types     map[string]ast.Expr
+	funcs     map[string]*ast.FuncDecl
+	methods   map[string]map[string]*ast.FuncDecl
+	constants map[string][]string
+}
+
+func loadSDKPackage(rootDir, importPath string) (*sdkPackage, error) {
+	dir := filepath.Join(rootDir, "vendor", filepath.FromSlash(importPath))
+	if _, err := os.Stat(dir); err != nil {
+		// packages which aren't used by the provider yet won't be vendored, so fall back to the module cache
+		cmd := exec.Command("go", "list", "-mod=mod", "-m", "-f", "{{.Dir}}", sdkModule)
+		cmd.Dir = rootDir
+		out, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("locating %q: %+v", sdkModule, err)
+		}
+		dir = filepath.Join(strings.TrimSpace(string(out)), filepath.FromSlash(strings.TrimPrefix(importPath, sdkModule+"/")))
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", dir, err)
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected 1 package in %q but got %d", dir, len(pkgs))
+	}
+
+	out := sdkPackage{
+		importPath: importPath,
+		dir:        dir,
+		types:      make(map[string]ast.Expr),
+		funcs:      make(map[string]*ast.FuncDecl),
+		methods:    make(map[string]map[string]*ast.FuncDecl),
+		constants:  make(map[string][]string),
+	}
+
+	for name, p := range pkgs {
+		out.name = name
+
+		fileNames := make([]string, 0, len(p.Files))
+		for fileName := range p.Files {
+			fileNames = append(fileNames, fileName)
+		}
+		sort.Strings(fileNames)
+
+		for _, fileName := range fileNames {
+			for _, decl := range p.Files[fileName].Decls {
+				switch d := decl.(type) {
+				case *ast.FuncDecl:
+					if d.Recv == nil || len(d.Recv.List) == 0 {
+						out.funcs[d.Name.Name] = d
+						continue
+					}
+					receiver := types.ExprString(d.Recv.List[0].Type)
+					receiver = strings.TrimPrefix(receiver, "*")
+					if _, ok := out.methods[receiver]; !ok {
+						out.methods[receiver] = make(map[string]*ast.FuncDecl)
+					}
+					out.methods[receiver][d.Name.Name] = d
+
+				case *ast.GenDecl:
+					for _, spec := range d.Specs {
+						switch s := spec.(type) {
+						case *ast.TypeSpec:
+							out.types[s.Name.Name] = s.Type
+
+						case *ast.ValueSpec:
+							if d.Tok != token.CONST || s.Type == nil {
+								continue
+							}
+							typeName := types.ExprString(s.Type)
+							for _, v := range s.Values {
+								if lit, ok := v.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+									value, _ := strconv.Unquote(lit.Value)
+									out.constants[typeName] = append(out.constants[typeName], value)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return &out, nil
+}
+
+// structFields returns the fields within the named struct, in the order they're defined
+func (p sdkPackage) structFields(name string) []*ast.Field {
+	s, ok := p.types[name].(*ast.StructType)
+	if !ok {
+		return nil
+	}
+
+	out := make([]*ast.Field, 0)
+	for _, field := range s.Fields.List {
+		if len(field.Names) == 0 {
+			continue
+		}
+		out = append(out, field)
+	}
+	return out
+}
+
+func (p sdkPackage) isEnum(name string) bool {
+	_, ok := p.funcs["PossibleValuesFor"+name]
+	return ok
+}
+
+// idFields returns the names of the fields within the Resource ID, in the order they're passed to the constructor
+func (p sdkPackage) idFields(idType string) []string {
+	out := make([]string, 0)
+	for _, field := range p.structFields(idType) {
+		for _, name := range field.Names {
+			out = append(out, name.Name)
+		}
+	}
+	return out
+}
+
+// exampleId builds an example of the Resource ID from the values used in its `Segments` function
+func (p sdkPackage) exampleId(idType string) (*string, error) {
+	method, ok := p.methods[idType]["Segments"]
+	if !ok || method.Body == nil {
+		return nil, fmt.Errorf("the Resource ID %q has no `Segments` method", idType)
+	}
+
+	segments := make([]string, 0)
+	ast.Inspect(method.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		// static segments are `(name, value, example)` whereas user specified segments are `(name, example)`
+		valueIndex := 1
+		if selector.Sel.Name == "StaticSegment" || selector.Sel.Name == "ResourceProviderSegment" {
+			valueIndex = 2
+		}
+		if len(call.Args) > valueIndex {
+			if lit, ok := call.Args[valueIndex].(*ast.BasicLit); ok {
+				value, _ := strconv.Unquote(lit.Value)
+				segments = append(segments, strings.Trim(value, "/"))
+			}
+		}
+		return false
+	})
+
+	out := "/" + strings.Join(segments, "/")
+	return &out, nil
+}
+
+// sdkMethod is a method on the SDK Client which is called by the Resource
+type sdkMethod struct {
+	name        string
+	inputType   string
+	optionsType string
+}
+
+func (m sdkMethod) longRunning() bool {
+	return strings.HasSuffix(m.name, "ThenPoll")
+}
+
+// call returns the Go code calling this method, checking the error returned
+func (m sdkMethod) call(packageName, id, payload string) string {
+	args := []string{"ctx", id}
+	if m.inputType != "" {
+		args = append(args, payload)
+	}
+	if m.optionsType != "" {
+		args = append(args, fmt.Sprintf("%s.%s{}", packageName, m.optionsType))
+	}
+
+	if m.longRunning() {
+		return fmt.Sprintf("err := client.%s(%s); err != nil", m.name, strings.Join(args, ", "))
+	}
+	return fmt.Sprintf("_, err := client.%s(%s); err != nil", m.name, strings.Join(args, ", "))
+}
+
+func (p sdkPackage) findMethod(receiver string, names ...string) *sdkMethod {
+	for _, name := range names {
+		method, ok := p.methods[receiver][name]
+		if !ok {
+			continue
+		}
+
+		out := sdkMethod{
+			name: name,
+		}
+		// the parameters are `ctx`, `id`, and then optionally the `input` and `options`
+		for _, param := range method.Type.Params.List {
+			typeName := types.ExprString(param.Type)
+			if strings.HasSuffix(typeName, "OperationOptions") {
+				out.optionsType = typeName
+				continue
+			}
+			if _, ok := p.types[typeName].(*ast.StructType); ok && !strings.HasSuffix(typeName, "Id") {
+				out.inputType = typeName
+			}
+		}
+		return &out
+	}
+
+	return nil
+}
+
+type propertyKind string
+
+const (
+	propertyKindBool        propertyKind = "bool"
+	propertyKindEnum        propertyKind = "enum"
+	propertyKindFloat       propertyKind = "float64"
+	propertyKindInt         propertyKind = "int64"
+	propertyKindString      propertyKind = "string"
+	propertyKindStringList  propertyKind = "[]string"
+	propertyKindStringMap   propertyKind = "map[string]string"
+	propertyKindUnsupported propertyKind = "unsupported"
+)
+
+// property is a field within the SDK Model which is exposed as an Argument of the Resource
+type property struct {
+	// schemaName is the name of the Argument, e.g. `virtual_network_id`
+	schemaName string
+
+	// fieldName is the name of the field within both the SDK Model and the Typed Model, e.g. `VirtualNetworkId`
+	fieldName string
+
+	// topLevel specifies that the field is defined on the SDK Model, rather than within its `Properties`
+	topLevel bool
+
+	kind     propertyKind
+	enumType string
+	sdkType  string
+
+	// pointer specifies whether the field is a pointer within the SDK Model
+	pointer  bool
+	required bool
+	forceNew bool
+}
+
+func classifyProperty(p *sdkPackage, name string, expr ast.Expr, topLevel bool) property {
+	out := property{
+		schemaName: convertToSnakeCase(name),
+		fieldName:  name,
+		topLevel:   topLevel,
+		kind:       propertyKindUnsupported,
+		sdkType:    types.ExprString(expr),
+	}
+
+	if star, ok := expr.(*ast.StarExpr); ok {
+		out.pointer = true
+		expr = star.X
+	}
+	out.required = !out.pointer
+
+	switch t := expr.(type) {
+	case *ast.Ident:
+		switch t.Name {
+		case "bool":
+			out.kind = propertyKindBool
+		case "float64":
+			out.kind = propertyKindFloat
+		case "int64":
+			out.kind = propertyKindInt
+		case "string":
+			out.kind = propertyKindString
+		default:
+			if p.isEnum(t.Name) {
+				out.kind = propertyKindEnum
+				out.enumType = t.Name
+			}
+		}
+
+	case *ast.ArrayType:
+		if t.Len == nil && types.ExprString(t.Elt) == "string" {
+			out.kind = propertyKindStringList
+		}
+
+	case *ast.MapType:
+		if types.ExprString(t.Key) == "string" && types.ExprString(t.Value) == "string" {
+			out.kind = propertyKindStringMap
+		}
+	}
+
+	return out
+}
+
+// resourceDefinition describes the Typed Resource which is generated from the SDK Package
+type resourceDefinition struct {
+	pkg *sdkPackage
+
+	resourceName string
+	typeName     string
+
+	servicePackageName string
+	serviceClient      string
+	clientType         string
+	clientField        string
+
+	idType    string
+	idFields  []string
+	exampleId string
+
+	// parentIdType is the Resource ID of the parent resource within the same SDK Package, when this Resource is
+	// nested within another Resource (rather than a Resource Group)
+	parentIdType string
+
+	modelType              string
+	propertiesType         string
+	propertiesPointer      bool
+	hasLocation            bool
+	locationPointer        bool
+	hasTags                bool
+	properties             []property
+	unsupportedProperties  []property
+	patchPropertiesType    string
+	patchPropertiesPointer bool
+
+	get    sdkMethod
+	create sdkMethod
+	delete sdkMethod
+	// update is nil when the Resource can't be updated, and the same as `create` when it's updated using a PUT
+	update *sdkMethod
+}
+
+func newResourceDefinition(pkg *sdkPackage, sdkResourceName, resourceName string) (*resourceDefinition, error) {
+	out := resourceDefinition{
+		pkg:          pkg,
+		resourceName: resourceName,
+		typeName:     snake2Camel(strings.TrimPrefix(resourceName, "azurerm_")),
+		idType:       sdkResourceName + "Id",
+	}
+
+	out.idFields = pkg.idFields(out.idType)
+	if len(out.idFields) == 0 {
+		return nil, fmt.Errorf("the Resource ID %q was not found in %q", out.idType, pkg.importPath)
+	}
+	exampleId, err := pkg.exampleId(out.idType)
+	if err != nil {
+		return nil, err
+	}
+	out.exampleId = *exampleId
+
+	if !out.hasResourceGroupScope() {
+		out.parentIdType = out.findParentIdType()
+	}
+
+	for typeName := range pkg.types {
+		if _, ok := pkg.funcs[fmt.Sprintf("New%sWithBaseURI", typeName)]; ok && strings.HasSuffix(typeName, "Client") {
+			out.clientType = typeName
+		}
+	}
+	if out.clientType == "" {
+		return nil, fmt.Errorf("no Client was found in %q", pkg.importPath)
+	}
+
+	get := pkg.findMethod(out.clientType, "Get")
+	if get == nil {
+		return nil, fmt.Errorf("the %s has no `Get` method", out.clientType)
+	}
+	out.get = *get
+
+	create := pkg.findMethod(out.clientType, "CreateOrUpdateThenPoll", "CreateOrUpdate", "CreateThenPoll", "Create")
+	if create == nil || create.inputType == "" {
+		return nil, fmt.Errorf("the %s has no `CreateOrUpdate` or `Create` method", out.clientType)
+	}
+	out.create = *create
+	out.modelType = create.inputType
+
+	deleteMethod := pkg.findMethod(out.clientType, "DeleteThenPoll", "Delete")
+	if deleteMethod == nil {
+		return nil, fmt.Errorf("the %s has no `Delete` method", out.clientType)
+	}
+	out.delete = *deleteMethod
+
+	// patchFields contains the fields which can be updated - when updating using a PUT that's everything
+	patchFields := make(map[string]struct{})
+	patchPropertiesFields := make(map[string]struct{})
+	updateViaPut := strings.HasPrefix(out.create.name, "CreateOrUpdate")
+	if updateViaPut {
+		out.update = &out.create
+	} else if update := pkg.findMethod(out.clientType, "UpdateThenPoll", "Update"); update != nil && update.inputType != "" {
+		out.update = update
+		for _, field := range pkg.structFields(update.inputType) {
+			name := field.Names[0].Name
+			patchFields[name] = struct{}{}
+			if name != "Properties" {
+				continue
+			}
+			out.patchPropertiesType, out.patchPropertiesPointer = namedType(field.Type)
+			for _, f := range pkg.structFields(out.patchPropertiesType) {
+				patchPropertiesFields[f.Names[0].Name] = struct{}{}
+			}
+		}
+	}
+
+	canUpdate := func(p property) bool {
+		if updateViaPut {
+			return true
+		}
+		if p.topLevel {
+			_, ok := patchFields[p.fieldName]
+			return ok
+		}
+		_, ok := patchPropertiesFields[p.fieldName]
+		return ok
+	}
+
+	for _, field := range pkg.structFields(out.modelType) {
+		name := field.Names[0].Name
+		switch name {
+		case "Etag", "Id", "Name", "SystemData", "Type":
+			// these are either part of the Resource ID or are read-only
+			continue
+
+		case "Location":
+			out.hasLocation = true
+			_, out.locationPointer = field.Type.(*ast.StarExpr)
+			continue
+
+		case "Tags":
+			if types.ExprString(field.Type) == "*map[string]string" {
+				out.hasTags = true
+				continue
+			}
+
+		case "Properties":
+			out.propertiesType, out.propertiesPointer = namedType(field.Type)
+			for _, f := range pkg.structFields(out.propertiesType) {
+				out.addProperty(classifyProperty(pkg, f.Names[0].Name, f.Type, false), canUpdate)
+			}
+			continue
+		}
+
+		out.addProperty(classifyProperty(pkg, name, field.Type, true), canUpdate)
+	}
+
+	sort.Slice(out.properties, func(i, j int) bool {
+		return out.properties[i].schemaName < out.properties[j].schemaName
+	})
+	sort.Slice(out.unsupportedProperties, func(i, j int) bool {
+		return out.unsupportedProperties[i].schemaName < out.unsupportedProperties[j].schemaName
+	})
+
+	return &out, nil
+}
+
+func (r *resourceDefinition) addProperty(p property, canUpdate func(property) bool) {
+	// the provisioning state is read-only and exposed as the status of the long-running operation
+	if p.fieldName == "ProvisioningState" {
+		return
+	}
+
+	for _, arg := range r.idArguments() {
+		if arg.schemaName == p.schemaName {
+			p.kind = propertyKindUnsupported
+		}
+	}
+	if p.schemaName == "location" || p.schemaName == "tags" {
+		p.kind = propertyKindUnsupported
+	}
+	for _, existing := range r.properties {
+		if existing.schemaName == p.schemaName {
+			p.kind = propertyKindUnsupported
+		}
+	}
+
+	if p.kind == propertyKindUnsupported {
+		r.unsupportedProperties = append(r.unsupportedProperties, p)
+		return
+	}
+
+	p.forceNew = r.update == nil || !canUpdate(p)
+	r.properties = append(r.properties, p)
+}
+
+func (r resourceDefinition) hasResourceGroupScope() bool {
+	return len(r.idFields) == 3 && r.idFields[0] == "SubscriptionId" && r.idFields[1] == "ResourceGroupName"
+}
+
+// findParentIdType returns the Resource ID within the SDK Package which contains all but the last segment of this
+// Resource ID, e.g. `DnsResolverId` for the `InboundEndpointId`
+func (r resourceDefinition) findParentIdType() string {
+	parentFields := strings.Join(r.idFields[:len(r.idFields)-1], ",")
+
+	typeNames := make([]string, 0)
+	for typeName := range r.pkg.types {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+
+	for _, typeName := range typeNames {
+		if !strings.HasSuffix(typeName, "Id") || typeName == r.idType {
+			continue
+		}
+		if _, ok := r.pkg.funcs[fmt.Sprintf("Validate%sID", idName(typeName))]; !ok {
+			continue
+		}
+		if strings.Join(r.pkg.idFields(typeName), ",") == parentFields {
+			return typeName
+		}
+	}
+
+	return ""
+}
+
+// idArgument is an Argument which is used to build the Resource ID
+type idArgument struct {
+	schemaName string
+	fieldName  string
+
+	// schema is the Go code for the Schema of this Argument
+	schema string
+
+	// idFields are the fields within the Resource ID which are populated by this Argument
+	idFields []string
+
+	// configValue is the value used for this Argument in the Acceptance Tests
+	configValue string
+}
+
+func (r resourceDefinition) idArguments() []idArgument {
+	nameField := r.idFields[len(r.idFields)-1]
+	out := []idArgument{
+		{
+			schemaName: "name",
+			fieldName:  "Name",
+			schema: `{
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			}`,
+			idFields:    []string{nameField},
+			configValue: `"acctest-%[2]d"`,
+		},
+	}
+
+	if r.parentIdType != "" {
+		parentName := strings.TrimSuffix(r.parentIdType, "Id")
+		return append(out, idArgument{
+			schemaName: convertToSnakeCase(parentName) + "_id",
+			fieldName:  parentName + "Id",
+			schema: fmt.Sprintf(`{
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: %s.Validate%sID,
+			}`, r.pkg.name, idName(r.parentIdType)),
+			idFields:    r.idFields[:len(r.idFields)-1],
+			configValue: `"TODO"`,
+		})
+	}
+
+	for _, field := range r.idFields[:len(r.idFields)-1] {
+		switch field {
+		case "SubscriptionId":
+			// the Subscription ID comes from the provider configuration
+			continue
+
+		case "ResourceGroupName":
+			out = append(out, idArgument{
+				schemaName:  "resource_group_name",
+				fieldName:   field,
+				schema:      "commonschema.ResourceGroupName()",
+				idFields:    []string{field},
+				configValue: "azurerm_resource_group.test.name",
+			})
+
+		default:
+			out = append(out, idArgument{
+				schemaName: convertToSnakeCase(field),
+				fieldName:  field,
+				schema: `{
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				}`,
+				idFields:    []string{field},
+				configValue: `"TODO"`,
+			})
+		}
+	}
+
+	return out
+}
+
+func (r resourceDefinition) code() string {
+	resourceType := r.typeName + "Resource"
+	modelType := r.typeName + "Model"
+	idArguments := r.idArguments()
+
+	imports := []string{
+		"context",
+		"fmt",
+		"time",
+		"github.com/hashicorp/go-azure-helpers/lang/response",
+		r.pkg.importPath,
+		providerModule + "/internal/sdk",
+		providerModule + "/internal/tf/pluginsdk",
+		providerModule + "/internal/tf/validation",
+	}
+	usesPointer := r.hasTags || r.locationPointer
+	for _, p := range r.properties {
+		usesPointer = usesPointer || p.pointer
+	}
+	if usesPointer {
+		imports = append(imports, "github.com/hashicorp/go-azure-helpers/lang/pointer")
+	}
+	usesCommonSchema := r.hasLocation || r.hasTags
+	for _, arg := range idArguments {
+		usesCommonSchema = usesCommonSchema || strings.HasPrefix(arg.schema, "commonschema.")
+	}
+	if usesCommonSchema {
+		imports = append(imports, "github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema")
+	}
+	if r.hasLocation {
+		imports = append(imports, "github.com/hashicorp/go-azure-helpers/resourcemanager/location")
+	}
+
+	resourceInterface := "sdk.ResourceWithUpdate"
+	if r.update == nil {
+		resourceInterface = "sdk.Resource"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package %s
+
+import (
+%s
+)
+
+type %s struct {
+%s
+}
+
+var _ %[5]s = %[6]s{}
+
+type %[6]s struct{}
+
+func (r %[6]s) ResourceType() string {
+	return %[7]q
+}
+
+func (r %[6]s) ModelObject() interface{} {
+	return &%[3]s{}
+}
+
+func (r %[6]s) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return %[8]s.Validate%[9]sID
+}
+`, r.servicePackageName, formatImports(imports), modelType, r.modelFields(idArguments), resourceInterface, resourceType, r.resourceName, r.pkg.name, idName(r.idType))
+
+	fmt.Fprintf(&b, `
+func (r %s) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+%s
+	}
+}
+
+func (r %[1]s) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+`, resourceType, r.argumentsSchema(idArguments))
+
+	fmt.Fprintf(&b, `
+func (r %s) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.%s.%s
+
+			var model %s
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %%+v", err)
+			}
+
+%s
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %%s: %%+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := %s
+
+			if %s {
+				return fmt.Errorf("creating %%s: %%+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+`, resourceType, r.serviceClient, r.clientField, modelType, r.createId(idArguments), r.createPayload(), r.create.call(r.pkg.name, "id", "payload"))
+
+	fmt.Fprintf(&b, `
+func (r %s) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.%s.%s
+
+			id, err := %s.Parse%sID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %%s: %%+v", *id, err)
+			}
+
+			state := %s{
+%s
+			}
+
+%s
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+`, resourceType, r.serviceClient, r.clientField, r.pkg.name, idName(r.idType), modelType, r.readIdFields(idArguments), r.readProperties())
+
+	if r.update != nil {
+		fmt.Fprintf(&b, `
+func (r %s) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.%s.%s
+
+			id, err := %s.Parse%sID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model %s
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %%+v", err)
+			}
+
+%s
+
+			if %s {
+				return fmt.Errorf("updating %%s: %%+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+`, resourceType, r.serviceClient, r.clientField, r.pkg.name, idName(r.idType), modelType, r.updatePayload(), r.update.call(r.pkg.name, "*id", "payload"))
+	}
+
+	fmt.Fprintf(&b, `
+func (r %s) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.%s.%s
+
+			id, err := %s.Parse%sID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if %s {
+				return fmt.Errorf("deleting %%s: %%+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+`, resourceType, r.serviceClient, r.clientField, r.pkg.name, idName(r.idType), r.delete.call(r.pkg.name, "*id", ""))
+
+	return b.String()
+}
+
+func (r resourceDefinition) modelFields(idArguments []idArgument) string {
+	lines := make([]string, 0)
+	field := func(name, goType, schemaName string) {
+		lines = append(lines, fmt.Sprintf("%s %s `tfschema:%q`", name, goType, schemaName))
+	}
+
+	for _, arg := range idArguments {
+		field(arg.fieldName, "string", arg.schemaName)
+	}
+	if r.hasLocation {
+		field("Location", "string", "location")
+	}
+	for _, p := range r.properties {
+		goType := string(p.kind)
+		if p.kind == propertyKindEnum {
+			goType = "string"
+		}
+		field(p.fieldName, goType, p.schemaName)
+	}
+	if r.hasTags {
+		field("Tags", "map[string]string", "tags")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (r resourceDefinition) argumentsSchema(idArguments []idArgument) string {
+	blocks := make([]string, 0)
+	for _, arg := range idArguments {
+		blocks = append(blocks, fmt.Sprintf("%q: %s,", arg.schemaName, arg.schema))
+	}
+	if r.hasLocation {
+		blocks = append(blocks, `"location": commonschema.Location(),`)
+	}
+
+	for _, p := range r.properties {
+		lines := []string{
+			fmt.Sprintf("%q: {", p.schemaName),
+		}
+
+		switch p.kind {
+		case propertyKindBool:
+			lines = append(lines, "Type: pluginsdk.TypeBool,")
+		case propertyKindFloat:
+			lines = append(lines, "Type: pluginsdk.TypeFloat,")
+		case propertyKindInt:
+			lines = append(lines, "Type: pluginsdk.TypeInt,")
+		case propertyKindEnum, propertyKindString:
+			lines = append(lines, "Type: pluginsdk.TypeString,")
+		case propertyKindStringList:
+			lines = append(lines, "Type: pluginsdk.TypeList,")
+		case propertyKindStringMap:
+			lines = append(lines, "Type: pluginsdk.TypeMap,")
+		}
+
+		if p.required {
+			lines = append(lines, "Required: true,")
+		} else {
+			lines = append(lines, "Optional: true,")
+		}
+		if p.forceNew {
+			lines = append(lines, "ForceNew: true,")
+		}
+
+		switch p.kind {
+		case propertyKindEnum:
+			lines = append(lines, fmt.Sprintf("ValidateFunc: validation.StringInSlice(%s.PossibleValuesFor%s(), false),", r.pkg.name, p.enumType))
+		case propertyKindString:
+			lines = append(lines, "ValidateFunc: validation.StringIsNotEmpty,")
+		case propertyKindStringList, propertyKindStringMap:
+			lines = append(lines, `Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},`)
+		}
+
+		lines = append(lines, "},")
+		blocks = append(blocks, strings.Join(lines, "\n"))
+	}
+
+	if len(r.unsupportedProperties) > 0 {
+		lines := []string{
+			"// TODO: the following properties within the SDK Model need to be mapped manually:",
+		}
+		for _, p := range r.unsupportedProperties {
+			lines = append(lines, fmt.Sprintf("// - `%s` (%s)", p.fieldName, p.sdkType))
+		}
+		blocks = append(blocks, strings.Join(lines, "\n"))
+	}
+
+	if r.hasTags {
+		blocks = append(blocks, `"tags": commonschema.Tags(),`)
+	}
+
+	return strings.Join(blocks, "\n\n")
+}
+
+func (r resourceDefinition) createId(idArguments []idArgument) string {
+	if r.parentIdType != "" {
+		parent := idArguments[1]
+		args := make([]string, 0)
+		for _, field := range parent.idFields {
+			args = append(args, "parentId."+field)
+		}
+		args = append(args, "model.Name")
+
+		return fmt.Sprintf(`parentId, err := %[1]s.Parse%[2]sID(model.%[3]s)
+			if err != nil {
+				return err
+			}
+
+			id := %[1]s.New%[4]sID(%[5]s)`, r.pkg.name, idName(r.parentIdType), parent.fieldName, idName(r.idType), strings.Join(args, ", "))
+	}
+
+	args := make([]string, 0)
+	usesSubscriptionId := false
+	for _, field := range r.idFields {
+		if field == "SubscriptionId" {
+			usesSubscriptionId = true
+			args = append(args, "subscriptionId")
+			continue
+		}
+		for _, arg := range idArguments {
+			if arg.idFields[0] == field {
+				args = append(args, "model."+arg.fieldName)
+			}
+		}
+	}
+
+	out := fmt.Sprintf("id := %s.New%sID(%s)", r.pkg.name, idName(r.idType), strings.Join(args, ", "))
+	if usesSubscriptionId {
+		out = "subscriptionId := metadata.Client.Account.SubscriptionId\n" + out
+	}
+	return out
+}
+
+func (p property) expand(packageName, value string) string {
+	if p.kind == propertyKindEnum {
+		value = fmt.Sprintf("%s.%s(%s)", packageName, p.enumType, value)
+	}
+	if p.pointer {
+		value = fmt.Sprintf("pointer.To(%s)", value)
+	}
+	return value
+}
+
+func (p property) flatten(value string) string {
+	if p.pointer {
+		value = fmt.Sprintf("pointer.From(%s)", value)
+	}
+	if p.kind == propertyKindEnum {
+		value = fmt.Sprintf("string(%s)", value)
+	}
+	return value
+}
+
+func (r resourceDefinition) createPayload() string {
+	lines := []string{
+		fmt.Sprintf("%s.%s{", r.pkg.name, r.modelType),
+	}
+	if r.hasLocation {
+		if r.locationPointer {
+			lines = append(lines, "Location: pointer.To(location.Normalize(model.Location)),")
+		} else {
+			lines = append(lines, "Location: location.Normalize(model.Location),")
+		}
+	}
+	for _, p := range r.properties {
+		if p.topLevel {
+			lines = append(lines, fmt.Sprintf("%s: %s,", p.fieldName, p.expand(r.pkg.name, "model."+p.fieldName)))
+		}
+	}
+	if r.propertiesType != "" {
+		prefix := ""
+		if r.propertiesPointer {
+			prefix = "&"
+		}
+		lines = append(lines, fmt.Sprintf("Properties: %s%s.%s{", prefix, r.pkg.name, r.propertiesType))
+		for _, p := range r.properties {
+			if !p.topLevel {
+				lines = append(lines, fmt.Sprintf("%s: %s,", p.fieldName, p.expand(r.pkg.name, "model."+p.fieldName)))
+			}
+		}
+		lines = append(lines, "},")
+	}
+	if r.hasTags {
+		lines = append(lines, "Tags: pointer.To(model.Tags),")
+	}
+	lines = append(lines, "}")
+
+	return strings.Join(lines, "\n")
+}
+
+func (r resourceDefinition) updatePayload() string {
+	lines := make([]string, 0)
+
+	if r.update.name == r.create.name {
+		// the Resource is updated using a PUT, so the existing Resource is retrieved and then modified
+		lines = append(lines, `existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+			payload := *existing.Model`)
+		if r.propertiesType != "" && r.propertiesPointer {
+			lines = append(lines, fmt.Sprintf(`if payload.Properties == nil {
+				payload.Properties = &%s.%s{}
+			}`, r.pkg.name, r.propertiesType))
+		}
+	} else {
+		lines = append(lines, fmt.Sprintf("payload := %s.%s{}", r.pkg.name, r.update.inputType))
+		if r.patchPropertiesType != "" && r.patchPropertiesPointer {
+			for _, p := range r.properties {
+				if !p.topLevel && !p.forceNew {
+					lines = append(lines, fmt.Sprintf("payload.Properties = &%s.%s{}", r.pkg.name, r.patchPropertiesType))
+					break
+				}
+			}
+		}
+	}
+
+	for _, p := range r.properties {
+		if p.forceNew {
+			continue
+		}
+		target := "payload." + p.fieldName
+		if !p.topLevel {
+			target = "payload.Properties." + p.fieldName
+		}
+		lines = append(lines, fmt.Sprintf(`if metadata.ResourceData.HasChange(%q) {
+				%s = %s
+			}`, p.schemaName, target, p.expand(r.pkg.name, "model."+p.fieldName)))
+	}
+
+	if r.hasTags {
+		lines = append(lines, `if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}`)
+	}
+
+	return strings.Join(lines, "\n\n")
+}
+
+func (r resourceDefinition) readIdFields(idArguments []idArgument) string {
+	lines := make([]string, 0)
+	for _, arg := range idArguments {
+		if len(arg.idFields) == 1 {
+			lines = append(lines, fmt.Sprintf("%s: id.%s,", arg.fieldName, arg.idFields[0]))
+			continue
+		}
+
+		args := make([]string, 0)
+		for _, field := range arg.idFields {
+			args = append(args, "id."+field)
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s.New%sID(%s).ID(),", arg.fieldName, r.pkg.name, idName(r.parentIdType), strings.Join(args, ", ")))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r resourceDefinition) readProperties() string {
+	lines := []string{
+		"if model := resp.Model; model != nil {",
+	}
+	if r.hasLocation {
+		if r.locationPointer {
+			lines = append(lines, "state.Location = location.NormalizeNilable(model.Location)")
+		} else {
+			lines = append(lines, "state.Location = location.Normalize(model.Location)")
+		}
+	}
+	for _, p := range r.properties {
+		if p.topLevel {
+			lines = append(lines, fmt.Sprintf("state.%s = %s", p.fieldName, p.flatten("model."+p.fieldName)))
+		}
+	}
+
+	nested := make([]string, 0)
+	for _, p := range r.properties {
+		if !p.topLevel {
+			nested = append(nested, fmt.Sprintf("state.%s = %s", p.fieldName, p.flatten("props."+p.fieldName)))
+		}
+	}
+	if len(nested) > 0 {
+		if r.propertiesPointer {
+			lines = append(lines, "", "if props := model.Properties; props != nil {")
+			lines = append(lines, nested...)
+			lines = append(lines, "}")
+		} else {
+			lines = append(lines, "", "props := model.Properties")
+			lines = append(lines, nested...)
+		}
+	}
+
+	if r.hasTags {
+		lines = append(lines, "", "state.Tags = pointer.From(model.Tags)")
+	}
+	if len(lines) == 1 {
+		// there's nothing to set from the model
+		return ""
+	}
+	lines = append(lines, "}")
+
+	return strings.Join(lines, "\n")
+}
+
+// configValue returns the value used for this property in the Acceptance Tests
+func (r resourceDefinition) configValue(p property) string {
+	switch p.kind {
+	case propertyKindBool:
+		return "true"
+	case propertyKindEnum:
+		if values := r.pkg.constants[p.enumType]; len(values) > 0 {
+			return strconv.Quote(values[0])
+		}
+	case propertyKindFloat:
+		return "1.0"
+	case propertyKindInt:
+		return "1"
+	case propertyKindStringList:
+		return `["TODO"]`
+	case propertyKindStringMap:
+		return `{ key = "value" }`
+	}
+	return `"TODO"`
+}
+
+// configBlock returns the configuration for this Resource, aligning the arguments in the same way as `terraform fmt`
+func (r resourceDefinition) configBlock(label string, arguments [][2]string, tagsValue string) string {
+	width := 0
+	for _, arg := range arguments {
+		if len(arg[0]) > width {
+			width = len(arg[0])
+		}
+	}
+
+	lines := []string{
+		fmt.Sprintf("resource %q %q {", r.resourceName, label),
+	}
+	for _, arg := range arguments {
+		lines = append(lines, fmt.Sprintf("  %-*s = %s", width, arg[0], arg[1]))
+	}
+	if r.hasTags && tagsValue != "" {
+		lines = append(lines, "", "  tags = {", fmt.Sprintf("    key = %q", tagsValue), "  }")
+	}
+	lines = append(lines, "}")
+
+	return strings.Join(lines, "\n")
+}
+
+func (r resourceDefinition) testCode() string {
+	resourceType := r.typeName + "Resource"
+	testName := "TestAcc" + r.typeName
+	idArguments := r.idArguments()
+
+	basicArguments := make([][2]string, 0)
+	importArguments := make([][2]string, 0)
+	completeArguments := make([][2]string, 0)
+	for _, arg := range idArguments {
+		basicArguments = append(basicArguments, [2]string{arg.schemaName, arg.configValue})
+	}
+	if r.hasLocation {
+		basicArguments = append(basicArguments, [2]string{"location", "azurerm_resource_group.test.location"})
+	}
+	completeArguments = append(completeArguments, basicArguments...)
+	for _, p := range r.properties {
+		if p.required {
+			basicArguments = append(basicArguments, [2]string{p.schemaName, r.configValue(p)})
+		}
+		completeArguments = append(completeArguments, [2]string{p.schemaName, r.configValue(p)})
+	}
+	for _, arg := range basicArguments {
+		importArguments = append(importArguments, [2]string{arg[0], fmt.Sprintf("%s.test.%s", r.resourceName, arg[0])})
+	}
+
+	testCase := func(name, config, steps string) string {
+		return fmt.Sprintf(`
+func %s_%s(t *testing.T) {
+	data := acceptance.BuildTestData(t, %q, "test")
+	r := %s{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.%s(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		%s
+	})
+}
+`, testName, name, r.resourceName, resourceType, config, steps)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package %s_test
+
+import (
+%s
+)
+
+type %s struct{}
+`, r.servicePackageName, formatImports([]string{
+		"context",
+		"fmt",
+		"testing",
+		"github.com/hashicorp/go-azure-helpers/lang/response",
+		r.pkg.importPath,
+		providerModule + "/internal/acceptance",
+		providerModule + "/internal/acceptance/check",
+		providerModule + "/internal/clients",
+		providerModule + "/internal/tf/pluginsdk",
+		providerModule + "/utils",
+	}), resourceType)
+
+	b.WriteString(testCase("basic", "basic", "data.ImportStep(),"))
+	b.WriteString(testCase("requiresImport", "basic", "data.RequiresImportErrorStep(r.requiresImport),"))
+	b.WriteString(testCase("complete", "complete", "data.ImportStep(),"))
+	if r.update != nil {
+		b.WriteString(testCase("update", "basic", `data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),`))
+	}
+
+	fmt.Fprintf(&b, `
+func (r %s) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := %s.Parse%sID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.%s.%s.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %%s: %%+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r %[1]s) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`+"`"+`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%%[2]d"
+  location = "%%[1]s"
+}
+
+# TODO: add the dependencies of this Resource
+`+"`"+`, data.Locations.Primary, data.RandomInteger)
+}
+`, resourceType, r.pkg.name, idName(r.idType), r.serviceClient, r.clientField)
+
+	config := func(name, block string) string {
+		return fmt.Sprintf(`
+func (r %s) %s(data acceptance.TestData) string {
+	return fmt.Sprintf(`+"`"+`
+%%[1]s
+
+%s
+`+"`"+`, r.template(data), data.RandomInteger)
+}
+`, resourceType, name, block)
+	}
+
+	b.WriteString(config("basic", r.configBlock("test", basicArguments, "")))
+	b.WriteString(fmt.Sprintf(`
+func (r %s) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`+"`"+`
+%%s
+
+%s
+`+"`"+`, r.basic(data))
+}
+`, resourceType, r.configBlock("import", importArguments, "")))
+	b.WriteString(config("complete", r.configBlock("test", completeArguments, "value")))
+	if r.update != nil {
+		b.WriteString(config("update", "# TODO: update the properties which can be changed\n"+r.configBlock("test", completeArguments, "updated value")))
+	}
+
+	return b.String()
+}
+
+// registerResource adds the Resource to the list of Resources within the `registration.go`, in alphabetical order
+func registerResource(filePath, resourceType string) error {
+	contents, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	fset := token.NewFileSet()
+	resources, err := findResourcesList(fset, filePath, contents)
+	if err != nil {
+		return err
+	}
+
+	insertAt := fset.Position(resources.Rbrace).Offset
+	for _, elt := range resources.Elts {
+		existing := types.ExprString(elt)
+		if existing == resourceType+"{}" {
+			return nil
+		}
+		if existing > resourceType {
+			insertAt = fset.Position(elt.Pos()).Offset
+			break
+		}
+	}
+
+	updated := make([]byte, 0, len(contents))
+	updated = append(updated, contents[:insertAt]...)
+	updated = append(updated, []byte(resourceType+"{},\n")...)
+	updated = append(updated, contents[insertAt:]...)
+
+	formatted, err := format.Source(updated)
+	if err != nil {
+		return fmt.Errorf("formatting: %+v", err)
+	}
+
+	return os.WriteFile(filePath, formatted, 0o644)
+}
+
+// findResourcesList returns the list of Resources returned from the `Resources` method within the `registration.go`
+func findResourcesList(fset *token.FileSet, filePath string, contents []byte) (*ast.CompositeLit, error) {
+	file, err := parser.ParseFile(fset, filePath, contents, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources *ast.CompositeLit
+	for _, decl := range file.Decls {
+		f, ok := decl.(*ast.FuncDecl)
+		if !ok || f.Name.Name != "Resources" || f.Body == nil {
+			continue
+		}
+		ast.Inspect(f.Body, func(n ast.Node) bool {
+			if lit, ok := n.(*ast.CompositeLit); ok && resources == nil {
+				resources = lit
+				return false
+			}
+			return true
+		})
+	}
+	if resources == nil {
+		return nil, fmt.Errorf("the Service Registration doesn't support Typed Resources, the method `Resources() []sdk.Resource` was not found")
+	}
+
+	return resources, nil
+}
+
+// registerClient returns the name of the field within the Service Client for the SDK Client, adding it when necessary
+func registerClient(filePath string, pkg *sdkPackage, clientType string) (*string, error) {
+	contents, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filePath, contents, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	// the Service Client either contains the SDK Clients directly, or uses the "meta client" for the API Version
+	packageAlias := pkg.name
+	versionAlias := ""
+	versionImportPath := path.Dir(pkg.importPath)
+	for _, spec := range file.Imports {
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		switch importPath {
+		case pkg.importPath:
+			if spec.Name != nil {
+				packageAlias = spec.Name.Name
+			}
+		case versionImportPath:
+			versionAlias = path.Base(importPath)
+			if spec.Name != nil {
+				versionAlias = spec.Name.Name
+			}
+		}
+	}
+	metaClientType := fmt.Sprintf("*%s.Client", versionAlias)
+
+	var clientStruct *ast.StructType
+	var returnLit *ast.CompositeLit
+	var returnStmt *ast.ReturnStmt
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				if s, ok := spec.(*ast.TypeSpec); ok && s.Name.Name == "Client" {
+					clientStruct, _ = s.Type.(*ast.StructType)
+				}
+			}
+
+		case *ast.FuncDecl:
+			if d.Name.Name != "NewClient" || d.Body == nil {
+				continue
+			}
+			if versionAlias != "" && d.Type.Results != nil && types.ExprString(d.Type.Results.List[0].Type) == metaClientType {
+				return findMetaClientField(pkg, clientType, "")
+			}
+			ast.Inspect(d.Body, func(n ast.Node) bool {
+				ret, ok := n.(*ast.ReturnStmt)
+				if !ok || len(ret.Results) == 0 {
+					return true
+				}
+				if unary, ok := ret.Results[0].(*ast.UnaryExpr); ok {
+					if lit, ok := unary.X.(*ast.CompositeLit); ok && types.ExprString(lit.Type) == "Client" {
+						returnStmt = ret
+						returnLit = lit
+					}
+				}
+				return true
+			})
+		}
+	}
+	if clientStruct == nil {
+		return nil, fmt.Errorf("the `Client` struct was not found")
+	}
+
+	for _, field := range clientStruct.Fields.List {
+		if versionAlias != "" && types.ExprString(field.Type) == metaClientType {
+			// the meta client is either embedded or a named field
+			prefix := ""
+			if len(field.Names) > 0 {
+				prefix = field.Names[0].Name + "."
+			}
+			return findMetaClientField(pkg, clientType, prefix)
+		}
+	}
+
+	for _, field := range clientStruct.Fields.List {
+		if types.ExprString(field.Type) == fmt.Sprintf("*%s.%s", packageAlias, clientType) && len(field.Names) > 0 {
+			return &field.Names[0].Name, nil
+		}
+		for _, name := range field.Names {
+			if name.Name == clientType {
+				return nil, fmt.Errorf("the field %q already exists for a different SDK Package", clientType)
+			}
+		}
+	}
+
+	if returnLit == nil {
+		return nil, fmt.Errorf("the %s needs to be added to the `Client` manually, since `NewClient` doesn't return a `&Client{}`", clientType)
+	}
+
+	variableName := strings.ToLower(clientType[:1]) + clientType[1:]
+	edits := map[int]string{
+		fset.Position(clientStruct.Fields.Closing).Offset: fmt.Sprintf("%s *%s.%s\n", clientType, packageAlias, clientType),
+		fset.Position(returnStmt.Pos()).Offset: fmt.Sprintf(`%s, err := %s.New%sWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building %s client: %%+v", err)
+	}
+	o.Configure(%[1]s.Client, o.Authorizers.ResourceManager)
+
+	`, variableName, packageAlias, clientType, clientType),
+		fset.Position(returnLit.Rbrace).Offset: fmt.Sprintf("%s: %s,\n", clientType, variableName),
+	}
+	offsets := make([]int, 0, len(edits))
+	for offset := range edits {
+		offsets = append(offsets, offset)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(offsets)))
+
+	updated := contents
+	for _, offset := range offsets {
+		updated = append(updated[:offset:offset], append([]byte(edits[offset]), updated[offset:]...)...)
+	}
+
+	fset = token.NewFileSet()
+	file, err = parser.ParseFile(fset, filePath, updated, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the updated file: %+v", err)
+	}
+	astutil.AddImport(fset, file, "fmt")
+	astutil.AddImport(fset, file, pkg.importPath)
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, file); err != nil {
+		return nil, fmt.Errorf("formatting: %+v", err)
+	}
+	if err := os.WriteFile(filePath, buf.Bytes(), 0o644); err != nil {
+		return nil, err
+	}
+
+	return &clientType, nil
+}
+
+// findMetaClientField returns the name of the field for the SDK Client within the "meta client" for the API Version
+func findMetaClientField(pkg *sdkPackage, clientType, prefix string) (*string, error) {
+	filePath := filepath.Join(filepath.Dir(pkg.dir), "client.go")
+	file, err := parser.ParseFile(token.NewFileSet(), filePath, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the meta client: %+v", err)
+	}
+
+	for _, decl := range file.Decls {
+		d, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range d.Specs {
+			s, ok := spec.(*ast.TypeSpec)
+			if !ok || s.Name.Name != "Client" {
+				continue
+			}
+			st, ok := s.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			for _, field := range st.Fields.List {
+				if strings.HasSuffix(types.ExprString(field.Type), "."+clientType) && len(field.Names) > 0 {
+					out := prefix + field.Names[0].Name
+					return &out, nil
+				}
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("the %s was not found in the meta client %q", clientType, filePath)
+}
+
+// findServiceClient returns the name of the field within `internal/clients.Client` for the Service Client
+func findServiceClient(filePath, serviceClientPath string) (*string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filePath, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	alias := ""
+	for _, spec := range file.Imports {
+		if importPath, _ := strconv.Unquote(spec.Path.Value); importPath == serviceClientPath {
+			alias = path.Base(importPath)
+			if spec.Name != nil {
+				alias = spec.Name.Name
+			}
+		}
+	}
+	if alias == "" {
+		return nil, fmt.Errorf("the Service Client isn't imported - is this a new Service?")
+	}
+
+	for _, decl := range file.Decls {
+		d, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range d.Specs {
+			s, ok := spec.(*ast.TypeSpec)
+			if !ok || s.Name.Name != "Client" {
+				continue
+			}
+			st, ok := s.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			for _, field := range st.Fields.List {
+				if types.ExprString(field.Type) == fmt.Sprintf("*%s.Client", alias) && len(field.Names) > 0 {
+					return &field.Names[0].Name, nil
+				}
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no field of the type `*%s.Client` was found", alias)
+}
+
+func parsePackageName(filePath string) (*string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filePath, nil, parser.PackageClauseOnly)
+	if err != nil {
+		return nil, err
+	}
+	return &file.Name.Name, nil
+}
+
+// idName returns the name of the Resource ID used by its functions, e.g. `DnsResolver` for the `DnsResolverId`
+func idName(idType string) string {
+	return strings.TrimSuffix(idType, "Id")
+}
+
+// namedType returns the name of the type and whether it's a pointer
+func namedType(expr ast.Expr) (string, bool) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		return types.ExprString(star.X), true
+	}
+	return types.ExprString(expr), false
+}
+
+func formatImports(imports []string) string {
+	stdlib := make([]string, 0)
+	others := make([]string, 0)
+	for _, v := range imports {
+		if strings.Contains(v, ".") {
+			others = append(others, strconv.Quote(v))
+		} else {
+			stdlib = append(stdlib, strconv.Quote(v))
+		}
+	}
+	sort.Strings(stdlib)
+	sort.Strings(others)
+
+	return strings.Join(stdlib, "\n") + "\n\n" + strings.Join(others, "\n")
+}
+
+func goFmtAndWriteToFile(filePath, fileContents string) error {
+	formatted, err := format.Source([]byte(fileContents))
+	if err != nil {
+		return fmt.Errorf("formatting: %+v", err)
+	}
+
+	return os.WriteFile(filePath, formatted, 0o644)
+}
+
+func snake2Camel(input string) string {
+	out := ""
+	for _, seg := range strings.Split(input, "_") {
+		if seg == "" {
+			continue
+		}
+		out += strings.ToUpper(seg[:1]) + seg[1:]
+	}
+	return out
+}
+
+func convertToSnakeCase(input string) string {
+	splitIdxMap := map[int]struct{}{}
+	var lastChar rune
+	for idx, char := range input {
+		switch {
+		case idx == 0:
+			splitIdxMap[idx] = struct{}{}
+		case unicode.IsUpper(lastChar) == unicode.IsUpper(char):
+		case unicode.IsUpper(lastChar):
+			splitIdxMap[idx-1] = struct{}{}
+		case unicode.IsUpper(char):
+			splitIdxMap[idx] = struct{}{}
+		}
+		lastChar = char
+	}
+	splitIdx := make([]int, 0, len(splitIdxMap))
+	for idx := range splitIdxMap {
+		splitIdx = append(splitIdx, idx)
+	}
+	sort.Ints(splitIdx)
+
+	inputRunes := []rune(input)
+	out := make([]string, len(splitIdx))
+	for i := range splitIdx {
+		if i == len(splitIdx)-1 {
+			out[i] = strings.ToLower(string(inputRunes[splitIdx[i]:]))
+			continue
+		}
+		out[i] = strings.ToLower(string(inputRunes[splitIdx[i]:splitIdx[i+1]]))
+	}
+	return strings.Join(out, "_")
+}
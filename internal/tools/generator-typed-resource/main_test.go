@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// the tests use the vendored SDK packages, relative to this directory
+const testRootDir = "../../.."
+
+func testResourceDefinition(t *testing.T, importPath, sdkResourceName, resourceName string) *resourceDefinition {
+	pkg, err := loadSDKPackage(testRootDir, importPath)
+	if err != nil {
+		t.Fatalf("loading %q: %+v", importPath, err)
+	}
+
+	resource, err := newResourceDefinition(pkg, sdkResourceName, resourceName)
+	if err != nil {
+		t.Fatalf("building the Resource Definition: %+v", err)
+	}
+	resource.servicePackageName = "example"
+	resource.serviceClient = "Example"
+	resource.clientField = resource.clientType
+
+	return resource
+}
+
+func assertGeneratedCode(t *testing.T, name, code string, expected []string) {
+	formatted, err := format.Source([]byte(code))
+	if err != nil {
+		t.Fatalf("the generated %s isn't valid Go: %+v\n\n%s", name, err, code)
+	}
+	for _, v := range expected {
+		if !strings.Contains(string(formatted), v) {
+			t.Errorf("expected the generated %s to contain %q:\n\n%s", name, v, string(formatted))
+		}
+	}
+}
+
+func TestResourceUpdatedUsingPut(t *testing.T) {
+	resource := testResourceDefinition(t, sdkModule+"/dnsresolver/2022-07-01/inboundendpoints", "InboundEndpoint", "azurerm_private_dns_resolver_inbound_endpoint")
+
+	if resource.parentIdType != "DnsResolverId" {
+		t.Fatalf("expected the parent Resource ID to be `DnsResolverId` but got %q", resource.parentIdType)
+	}
+	if resource.exampleId != "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group/providers/Microsoft.Network/dnsResolvers/dnsResolverValue/inboundEndpoints/inboundEndpointValue" {
+		t.Fatalf("unexpected example Resource ID %q", resource.exampleId)
+	}
+
+	assertGeneratedCode(t, "Resource", resource.code(), []string{
+		"var _ sdk.ResourceWithUpdate = PrivateDnsResolverInboundEndpointResource{}",
+		"return inboundendpoints.ValidateInboundEndpointID",
+		`DnsResolverId string            ` + "`tfschema:\"dns_resolver_id\"`",
+		"ValidateFunc: inboundendpoints.ValidateDnsResolverID,",
+		"id := inboundendpoints.NewInboundEndpointID(parentId.SubscriptionId, parentId.ResourceGroupName, parentId.DnsResolverName, model.Name)",
+		"client.CreateOrUpdateThenPoll(ctx, id, payload, inboundendpoints.CreateOrUpdateOperationOptions{})",
+		"payload := *existing.Model",
+		"client.DeleteThenPoll(ctx, *id, inboundendpoints.DeleteOperationOptions{})",
+		"DnsResolverId: inboundendpoints.NewDnsResolverID(id.SubscriptionId, id.ResourceGroupName, id.DnsResolverName).ID(),",
+		"// - `IPConfigurations` ([]IPConfiguration)",
+	})
+
+	assertGeneratedCode(t, "Tests", resource.testCode(), []string{
+		"package example_test",
+		"func TestAccPrivateDnsResolverInboundEndpoint_update(t *testing.T) {",
+		"inboundendpoints.ParseInboundEndpointID(state.ID)",
+		`resource "azurerm_private_dns_resolver_inbound_endpoint" "import" {`,
+	})
+}
+
+func TestResourceUpdatedUsingPatch(t *testing.T) {
+	resource := testResourceDefinition(t, sdkModule+"/appconfiguration/2023-03-01/configurationstores", "ConfigurationStore", "azurerm_app_configuration")
+
+	createMode := property{}
+	publicNetworkAccess := property{}
+	for _, p := range resource.properties {
+		switch p.schemaName {
+		case "create_mode":
+			createMode = p
+		case "public_network_access":
+			publicNetworkAccess = p
+		}
+	}
+	if createMode.kind != propertyKindEnum || !createMode.forceNew {
+		t.Fatalf("expected `create_mode` to be a ForceNew enum but got %+v", createMode)
+	}
+	if publicNetworkAccess.kind != propertyKindEnum || publicNetworkAccess.forceNew {
+		t.Fatalf("expected `public_network_access` to be an updatable enum but got %+v", publicNetworkAccess)
+	}
+
+	assertGeneratedCode(t, "Resource", resource.code(), []string{
+		"ValidateFunc: validation.StringInSlice(configurationstores.PossibleValuesForPublicNetworkAccess(), false),",
+		"id := configurationstores.NewConfigurationStoreID(subscriptionId, model.ResourceGroupName, model.Name)",
+		`"resource_group_name": commonschema.ResourceGroupName(),`,
+		"client.CreateThenPoll(ctx, id, payload)",
+		"payload := configurationstores.ConfigurationStoreUpdateParameters{}",
+		"payload.Properties.PublicNetworkAccess = pointer.To(configurationstores.PublicNetworkAccess(model.PublicNetworkAccess))",
+		"client.UpdateThenPoll(ctx, *id, payload)",
+		"state.PublicNetworkAccess = string(pointer.From(props.PublicNetworkAccess))",
+	})
+
+	assertGeneratedCode(t, "Tests", resource.testCode(), []string{
+		"resource_group_name = azurerm_resource_group.test.name",
+	})
+}
+
+func TestRegisterResource(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "registration.go")
+	contents := `package example
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ExampleAlphaResource{},
+		ExampleGammaResource{},
+	}
+}
+`
+	if err := os.WriteFile(filePath, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// registering the same Resource twice is a no-op
+	for i := 0; i < 2; i++ {
+		if err := registerResource(filePath, "ExampleBetaResource"); err != nil {
+			t.Fatalf("registering the Resource: %+v", err)
+		}
+	}
+
+	actual, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := strings.Replace(contents, "\t\tExampleGammaResource{},", "\t\tExampleBetaResource{},\n\t\tExampleGammaResource{},", 1)
+	if string(actual) != expected {
+		t.Fatalf("expected:\n%s\n\ngot:\n%s", expected, string(actual))
+	}
+}
+
+func TestRegisterClient(t *testing.T) {
+	pkg, err := loadSDKPackage(testRootDir, sdkModule+"/dnsresolver/2022-07-01/dnsresolvers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filePath := filepath.Join(t.TempDir(), "client.go")
+	contents := `package client
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/inboundendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+type Client struct {
+	InboundEndpointsClient *inboundendpoints.InboundEndpointsClient
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	inboundEndpointsClient, err := inboundendpoints.NewInboundEndpointsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building InboundEndpointsClient client: %+v", err)
+	}
+	o.Configure(inboundEndpointsClient.Client, o.Authorizers.ResourceManager)
+
+	return &Client{
+		InboundEndpointsClient: inboundEndpointsClient,
+	}, nil
+}
+`
+	if err := os.WriteFile(filePath, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// the client is added the first time, and then found the second time
+	for i := 0; i < 2; i++ {
+		field, err := registerClient(filePath, pkg, "DnsResolversClient")
+		if err != nil {
+			t.Fatalf("registering the client: %+v", err)
+		}
+		if *field != "DnsResolversClient" {
+			t.Fatalf("expected the field `DnsResolversClient` but got %q", *field)
+		}
+	}
+
+	actual, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []string{
+		`"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsresolvers"`,
+		"DnsResolversClient     *dnsresolvers.DnsResolversClient",
+		"dnsResolversClient, err := dnsresolvers.NewDnsResolversClientWithBaseURI(o.Environment.ResourceManager)",
+		"o.Configure(dnsResolversClient.Client, o.Authorizers.ResourceManager)",
+		"DnsResolversClient:     dnsResolversClient,",
+	} {
+		if !strings.Contains(string(actual), v) {
+			t.Errorf("expected the client to contain %q:\n\n%s", v, string(actual))
+		}
+	}
+}
+
+func TestRegisterClientUsingMetaClient(t *testing.T) {
+	pkg, err := loadSDKPackage(testRootDir, sdkModule+"/analysisservices/2017-08-01/servers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filePath := filepath.Join(t.TempDir(), "client.go")
+	contents := `package client
+
+import (
+	analysisservices_v2017_08_01 "github.com/hashicorp/go-azure-sdk/resource-manager/analysisservices/2017-08-01"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+func NewClient(o *common.ClientOptions) (*analysisservices_v2017_08_01.Client, error) {
+	return nil, nil
+}
+`
+	if err := os.WriteFile(filePath, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	field, err := registerClient(filePath, pkg, "ServersClient")
+	if err != nil {
+		t.Fatalf("registering the client: %+v", err)
+	}
+	if *field != "Servers" {
+		t.Fatalf("expected the field `Servers` but got %q", *field)
+	}
+
+	actual, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(actual) != contents {
+		t.Fatalf("expected the client not to be modified but got:\n%s", string(actual))
+	}
+}
+
+func TestConvertToSnakeCase(t *testing.T) {
+	cases := map[string]string{
+		"Name":                      "name",
+		"DnsResolverId":             "dns_resolver_id",
+		"IPConfigurations":          "ip_configurations",
+		"SoftDeleteRetentionInDays": "soft_delete_retention_in_days",
+	}
+	for input, expected := range cases {
+		if actual := convertToSnakeCase(input); actual != expected {
+			t.Errorf("expected %q to be %q but got %q", input, expected, actual)
+		}
+	}
+}
@@ -5,114 +5,159 @@ package differ
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 	schema_rules "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/schema-rules"
 )
 
+const (
+	KindResource   = "resource"
+	KindDataSource = "data source"
+)
+
+// Violation is a breaking change detected between the base (released) schema and the current schema
+type Violation struct {
+	// Kind is either `resource` or `data source`
+	Kind string `json:"kind"`
+
+	// Name is the name of the Resource or Data Source, e.g. `azurerm_resource_group`
+	Name string `json:"name"`
+
+	// Property is the path to the property within the Resource or Data Source (e.g. `identity.type`), which is
+	// empty when the Resource or Data Source itself has been removed
+	Property string `json:"property,omitempty"`
+
+	// Rule is the name of the BreakingChangeRule which was violated
+	Rule string `json:"rule"`
+
+	// Message describes the breaking change
+	Message string `json:"message"`
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s %q: %s", v.Kind, v.Name, v.Message)
+}
+
 type Differ struct {
 	base    *providerjson.ProviderWrapper
 	current *providerjson.ProviderWrapper
 }
 
-func (d *Differ) Diff(fileName string, providerName string) []string {
+func (d *Differ) Diff(fileName string, providerName string) ([]Violation, error) {
 	if err := d.loadFromProvider(providerjson.LoadData(), providerName); err != nil {
-		return []string{err.Error()}
+		return nil, err
 	}
 
 	if err := d.loadFromFile(fileName); err != nil {
-		return []string{err.Error()}
+		return nil, err
 	}
 
 	if d.base.ProviderName != d.current.ProviderName {
-		return []string{fmt.Sprintf("provider name mismatch, expected %q, got %q", d.base.ProviderName, d.current.ProviderName)}
+		return nil, fmt.Errorf("provider name mismatch, expected %q, got %q", d.base.ProviderName, d.current.ProviderName)
 	}
 
-	violations := make([]string, 0)
+	violations := make([]Violation, 0)
+	violations = append(violations, compareResources(KindResource, d.base.ProviderSchema.ResourcesMap, d.current.ProviderSchema.ResourcesMap, schema_rules.ResourceRemoved, schema_rules.BreakingChangeRules)...)
+	violations = append(violations, compareResources(KindDataSource, d.base.ProviderSchema.DataSourcesMap, d.current.ProviderSchema.DataSourcesMap, schema_rules.DataSourceRemoved, schema_rules.BreakingChangeRulesDataSource)...)
 
-	for resource, rs := range d.current.ProviderSchema.ResourcesMap {
-		_, ok := d.base.ProviderSchema.ResourcesMap[resource]
-		if !ok {
-			// New resource, no breaking changes to worry about
-			continue
+	sort.SliceStable(violations, func(i, j int) bool {
+		if violations[i].Kind != violations[j].Kind {
+			return violations[i].Kind > violations[j].Kind // resources first
 		}
-		for propertyName, propertySchema := range rs.Schema {
-			// Get the same from the base (released) json
-			baseItem, ok := d.base.ProviderSchema.ResourcesMap[resource].Schema[propertyName]
-			if !ok {
-				// New property, could be breaking - Required etc
-				baseItem = providerjson.SchemaJSON{}
-			}
-			if errs := compareNodeResource(baseItem, propertySchema, propertyName); errs != nil {
-				violations = append(violations, errs...)
-			}
+		if violations[i].Name != violations[j].Name {
+			return violations[i].Name < violations[j].Name
 		}
-	}
+		return violations[i].Property < violations[j].Property
+	})
 
-	for dataSource, ds := range d.current.ProviderSchema.DataSourcesMap {
-		_, ok := d.base.ProviderSchema.DataSourcesMap[dataSource]
+	return violations, nil
+}
+
+func compareResources(kind string, base, current map[string]providerjson.ResourceJSON, removedRule string, rules []schema_rules.BreakingChangeRule) (violations []Violation) {
+	for name, b := range base {
+		c, ok := current[name]
 		if !ok {
-			// New data source, no breaking changes to worry about
+			violations = append(violations, Violation{
+				Kind:    kind,
+				Name:    name,
+				Rule:    removedRule,
+				Message: fmt.Sprintf("Cannot remove the %s %q", kind, name),
+			})
 			continue
 		}
-		for propertyName, propertySchema := range ds.Schema {
-			// Get the same from the base (released) json
-			baseItem, ok := d.base.ProviderSchema.DataSourcesMap[dataSource].Schema[propertyName]
-			if !ok {
-				// New property, could be breaking - Required etc
-				baseItem = providerjson.SchemaJSON{}
-			}
-			if errs := compareNodeDataSource(baseItem, propertySchema, propertyName); errs != nil {
-				violations = append(violations, errs...)
-			}
+		// New resources/data sources have no breaking changes to worry about, so only those in the base are checked
+		for _, v := range compareSchema(b.Schema, c.Schema, "", rules) {
+			v.Kind = kind
+			v.Name = name
+			violations = append(violations, v)
 		}
 	}
 
-	return violations
+	return
 }
 
-func compareNodeResource(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName string) (errs []string) {
-	if nodeIsBlock(base) {
-		newBaseRaw := base.Elem.(providerjson.ResourceJSON).Schema
-		newCurrent := current.Elem.(*providerjson.ResourceJSON).Schema
-		for k, newBase := range newBaseRaw {
-			errs = append(errs, compareNodeResource(newBase, newCurrent[k], k)...)
-		}
+func compareSchema(base, current map[string]providerjson.SchemaJSON, path string, rules []schema_rules.BreakingChangeRule) (violations []Violation) {
+	// both removed and new properties are checked, since either may be breaking - e.g. a new Required property
+	names := make(map[string]struct{})
+	for k := range base {
+		names[k] = struct{}{}
+	}
+	for k := range current {
+		names[k] = struct{}{}
 	}
 
-	for _, v := range schema_rules.BreakingChangeRules {
-		if err := v.Check(base, current, nodeName); err != nil {
-			errs = append(errs, *err)
-		}
+	for name := range names {
+		violations = append(violations, compareNode(base[name], current[name], propertyPath(path, name), rules)...)
 	}
 
 	return
 }
 
-func compareNodeDataSource(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName string) (errs []string) {
-	if nodeIsBlock(base) {
-		newBaseRaw := base.Elem.(providerjson.ResourceJSON).Schema
-		newCurrent := current.Elem.(*providerjson.ResourceJSON).Schema
-		for k, newBase := range newBaseRaw {
-			errs = append(errs, compareNodeDataSource(newBase, newCurrent[k], k)...)
-		}
+func compareNode(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodePath string, rules []schema_rules.BreakingChangeRule) (violations []Violation) {
+	// the nested properties of new blocks are only used when the block is configured, so aren't checked - and when
+	// a block has been removed (or changed to an attribute) that's reported against the block itself
+	baseBlock, baseIsBlock := blockSchema(base)
+	currentBlock, currentIsBlock := blockSchema(current)
+	if baseIsBlock && currentIsBlock {
+		violations = append(violations, compareSchema(baseBlock, currentBlock, nodePath, rules)...)
 	}
 
-	for _, v := range schema_rules.BreakingChangeRulesDataSource {
-		if err := v.Check(base, current, nodeName); err != nil {
-			errs = append(errs, *err)
+	for _, v := range rules {
+		if err := v.Check(base, current, nodePath); err != nil {
+			violations = append(violations, Violation{
+				Property: nodePath,
+				Rule:     v.Name(),
+				Message:  *err,
+			})
 		}
 	}
 
 	return
 }
 
-func nodeIsBlock(input providerjson.SchemaJSON) bool {
-	if input.Type == providerjson.SchemaTypeList || input.Type == providerjson.SchemaTypeSet {
-		if _, ok := input.Elem.(providerjson.ResourceJSON); ok {
-			return true
+// blockSchema returns the nested schema when the property is a block
+func blockSchema(input providerjson.SchemaJSON) (map[string]providerjson.SchemaJSON, bool) {
+	if input.Type != providerjson.SchemaTypeList && input.Type != providerjson.SchemaTypeSet {
+		return nil, false
+	}
+
+	// the base schema is loaded from JSON (as a value) whereas the current schema is loaded from the provider
+	switch t := input.Elem.(type) {
+	case providerjson.ResourceJSON:
+		return t.Schema, true
+	case *providerjson.ResourceJSON:
+		if t != nil {
+			return t.Schema, true
 		}
 	}
 
-	return false
+	return nil, false
+}
+
+func propertyPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
 }
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+	schema_rules "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/schema-rules"
+)
+
+func TestCompareResources(t *testing.T) {
+	// the base schema is loaded from JSON, so blocks are values - whereas the current schema contains pointers
+	base := map[string]providerjson.ResourceJSON{
+		"azurerm_example": {
+			Schema: map[string]providerjson.SchemaJSON{
+				"name":    {Type: providerjson.SchemaTypeString, Required: true, ForceNew: true},
+				"enabled": {Type: providerjson.SchemaTypeBool, Optional: true},
+				"sku": {
+					Type:     providerjson.SchemaTypeList,
+					Optional: true,
+					Elem: providerjson.ResourceJSON{
+						Schema: map[string]providerjson.SchemaJSON{
+							"tier": {Type: providerjson.SchemaTypeString, Optional: true},
+						},
+					},
+				},
+				"identity": {
+					Type:     providerjson.SchemaTypeList,
+					Optional: true,
+					Elem: providerjson.ResourceJSON{
+						Schema: map[string]providerjson.SchemaJSON{
+							"type": {Type: providerjson.SchemaTypeString, Required: true},
+						},
+					},
+				},
+			},
+		},
+		"azurerm_removed": {
+			Schema: map[string]providerjson.SchemaJSON{
+				"name": {Type: providerjson.SchemaTypeString, Required: true},
+			},
+		},
+	}
+	current := map[string]providerjson.ResourceJSON{
+		"azurerm_example": {
+			Schema: map[string]providerjson.SchemaJSON{
+				"name": {Type: providerjson.SchemaTypeString, Required: true, ForceNew: true},
+				"sku": {
+					Type:     providerjson.SchemaTypeList,
+					Optional: true,
+					Elem: &providerjson.ResourceJSON{
+						Schema: map[string]providerjson.SchemaJSON{
+							"tier": {Type: providerjson.SchemaTypeString, Optional: true, ForceNew: true},
+						},
+					},
+				},
+			},
+		},
+		"azurerm_new": {
+			Schema: map[string]providerjson.SchemaJSON{
+				"name": {Type: providerjson.SchemaTypeString, Required: true},
+			},
+		},
+	}
+
+	expected := map[string]bool{
+		"azurerm_example|enabled|property-removed":  true,
+		"azurerm_example|identity|property-removed": true,
+		"azurerm_example|sku.tier|force-new-added":  true,
+		"azurerm_removed||resource-removed":         true,
+	}
+
+	violations := compareResources(KindResource, base, current, schema_rules.ResourceRemoved, schema_rules.BreakingChangeRules)
+	if len(violations) != len(expected) {
+		t.Fatalf("expected %d violations but got %d: %+v", len(expected), len(violations), violations)
+	}
+	for _, v := range violations {
+		if v.Kind != KindResource {
+			t.Errorf("expected the kind to be %q but got %q", KindResource, v.Kind)
+		}
+		if key := v.Name + "|" + v.Property + "|" + v.Rule; !expected[key] {
+			t.Errorf("unexpected violation %+v", v)
+		}
+	}
+}
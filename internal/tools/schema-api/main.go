@@ -10,11 +10,13 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/differ"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/report"
 )
 
 func main() {
@@ -26,6 +28,8 @@ func main() {
 	exportSchema := f.String("export", "", "export the schema to the given path/filename. Intended for use in the release process")
 	detectBreakingChanges := f.String("detect", "", "compare current schema to named dump.")
 	errorOnBreakingChange := f.Bool("error-on-violation", false, "should the detect mode exit with a non-zero error code. Defaults to `false`")
+	reportFormat := f.String("format", string(report.FormatText), fmt.Sprintf("the format of the violations output by the detect mode, one of: %s", strings.Join(report.PossibleValuesForFormat(), ", ")))
+	reportOutput := f.String("output", "", "write the violations output by the detect mode to the given path/filename rather than stdout")
 
 	if err := f.Parse(os.Args[1:]); err != nil {
 		fmt.Printf("error parsing args: %+v", err)
@@ -53,13 +57,17 @@ func main() {
 	case pointer.From(detectBreakingChanges) != "":
 		{
 			d := differ.Differ{}
-			if violations := d.Diff(*detectBreakingChanges, *providerName); violations != nil {
-				for _, v := range violations {
-					log.Println(v)
-				}
-				if pointer.From(errorOnBreakingChange) {
-					os.Exit(1)
-				}
+			violations, err := d.Diff(*detectBreakingChanges, *providerName)
+			if err != nil {
+				log.Fatalf("error detecting breaking changes: %+v", err)
+			}
+
+			if err := writeReport(report.Format(*reportFormat), *reportOutput, violations); err != nil {
+				log.Fatalf("error writing the breaking changes report: %+v", err)
+			}
+
+			if len(violations) > 0 && pointer.From(errorOnBreakingChange) {
+				os.Exit(1)
 			}
 
 			os.Exit(0)
@@ -107,3 +115,17 @@ func main() {
 	log.Printf("starting api service on localhost:%d", *apiPort)
 	log.Println(http.ListenAndServe(fmt.Sprintf(":%d", *apiPort), mux))
 }
+
+func writeReport(format report.Format, fileName string, violations []differ.Violation) error {
+	if fileName == "" {
+		return report.Write(os.Stdout, format, violations)
+	}
+
+	f, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return report.Write(f, format, violations)
+}
@@ -33,6 +33,10 @@ type SchemaJSON struct {
 	Elem        interface{} `json:"elem,omitempty"`
 	MaxItems    int         `json:"maxItems,omitempty"`
 	MinItems    int         `json:"minItems,omitempty"`
+	Sensitive   bool        `json:"sensitive,omitempty"`
+
+	// PossibleValues contains the values allowed by the ValidateFunc, when this is `StringInSlice`
+	PossibleValues []string `json:"possibleValues,omitempty"`
 }
 
 func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
@@ -48,6 +52,14 @@ func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
 	b.Description, _ = m["description"].(string)
 	b.Computed, _ = m["computed"].(bool)
 	b.ForceNew, _ = m["forceNew"].(bool)
+	b.Sensitive, _ = m["sensitive"].(bool)
+	if values, ok := m["possibleValues"].([]interface{}); ok {
+		for _, v := range values {
+			if value, ok := v.(string); ok {
+				b.PossibleValues = append(b.PossibleValues, value)
+			}
+		}
+	}
 	if max, ok := m["maxItems"].(float64); ok {
 		b.MaxItems = int(max)
 	}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerjson
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+
+	gomonkey "github.com/agiledragon/gomonkey/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// the values passed to `validation.StringInSlice` can't otherwise be determined from the ValidateFunc, so (as in
+// the document-lint tool) this is patched to return the possible values as warnings - which is only done within
+// this tool, since the schema isn't used for validation here.
+//
+// NOTE: this relies on `validation.StringInSlice` not being inlined, as such this tool should be run using
+// `-gcflags=all=-l` - otherwise the possible values are omitted.
+func init() {
+	gomonkey.ApplyFunc(validation.StringInSlice, func(valid []string, ignoreCase bool) schema.SchemaValidateFunc { //nolint:staticcheck
+		return func(i interface{}, k string) (warnings []string, errors []error) {
+			return append([]string{}, valid...), nil
+		}
+	})
+}
+
+// possibleValues returns the values allowed by the ValidateFunc for the property, when this is `StringInSlice`
+func possibleValues(input *schema.Schema) []string {
+	if input.ValidateFunc == nil {
+		return nil
+	}
+
+	// this matches both `validation.StringInSlice` within the Plugin SDK and the wrapper in `internal/tf/validation`
+	name := runtime.FuncForPC(reflect.ValueOf(input.ValidateFunc).Pointer()).Name()
+	if !strings.Contains(name, "StringInSlice") && !strings.Contains(name, "providerjson.init") {
+		return nil
+	}
+
+	values, _ := input.ValidateFunc(nil, "")
+	if len(values) == 0 {
+		return nil
+	}
+	sort.Strings(values)
+	return values
+}
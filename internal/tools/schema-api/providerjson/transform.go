@@ -57,6 +57,9 @@ func schemaFromRaw(input *schema.Schema) SchemaJSON {
 		Elem:        decodeElem(input.Elem),
 		MaxItems:    input.MaxItems,
 		MinItems:    input.MinItems,
+		Sensitive:   input.Sensitive,
+
+		PossibleValues: possibleValues(input),
 	}
 }
 
@@ -110,6 +113,16 @@ func SchemaFromMap(input map[string]interface{}) SchemaJSON {
 		result.MaxItems = int(t.(float64))
 	}
 
+	if t, ok := input["sensitive"]; ok {
+		result.Sensitive = t.(bool)
+	}
+
+	if t, ok := input["possibleValues"]; ok {
+		for _, v := range t.([]interface{}) {
+			result.PossibleValues = append(result.PossibleValues, v.(string))
+		}
+	}
+
 	return result
 }
 
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/differ"
+)
+
+func writeJSON(w io.Writer, violations []differ.Violation) error {
+	if violations == nil {
+		violations = make([]differ.Violation, 0)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(violations)
+}
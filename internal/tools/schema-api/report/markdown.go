@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/differ"
+)
+
+func writeMarkdown(w io.Writer, violations []differ.Violation) error {
+	sb := strings.Builder{}
+	sb.WriteString("## Breaking Schema Changes\n\n")
+
+	if len(violations) == 0 {
+		sb.WriteString("No breaking changes were detected.\n")
+		_, err := io.WriteString(w, sb.String())
+		return err
+	}
+
+	sb.WriteString(fmt.Sprintf("%d breaking change(s) were detected.\n", len(violations)))
+
+	for _, section := range []struct {
+		title string
+		kind  string
+	}{
+		{title: "Resources", kind: differ.KindResource},
+		{title: "Data Sources", kind: differ.KindDataSource},
+	} {
+		// the violations are sorted by name, so those for each Resource/Data Source are adjacent
+		name := ""
+		for _, v := range violations {
+			if v.Kind != section.kind {
+				continue
+			}
+			if name == "" {
+				sb.WriteString(fmt.Sprintf("\n### %s\n", section.title))
+			}
+			if v.Name != name {
+				name = v.Name
+				sb.WriteString(fmt.Sprintf("\n#### `%s`\n\n", name))
+			}
+
+			if v.Property != "" {
+				sb.WriteString(fmt.Sprintf("* `%s` - %s (`%s`)\n", v.Property, v.Message, v.Rule))
+			} else {
+				sb.WriteString(fmt.Sprintf("* %s (`%s`)\n", v.Message, v.Rule))
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
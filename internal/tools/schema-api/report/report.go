@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/differ"
+)
+
+type Format string
+
+const (
+	// FormatText outputs a line per violation, intended for humans reading the CI output
+	FormatText Format = "text"
+
+	// FormatJSON outputs the violations as a JSON array
+	FormatJSON Format = "json"
+
+	// FormatSARIF outputs the violations as a SARIF 2.1.0 log, for consumption by code review tooling
+	FormatSARIF Format = "sarif"
+
+	// FormatMarkdown outputs the violations grouped by Resource/Data Source, intended for PR comments and
+	// for authors of the upgrade guide
+	FormatMarkdown Format = "markdown"
+)
+
+func PossibleValuesForFormat() []string {
+	return []string{
+		string(FormatText),
+		string(FormatJSON),
+		string(FormatSARIF),
+		string(FormatMarkdown),
+	}
+}
+
+// Write outputs the violations to w in the specified format
+func Write(w io.Writer, format Format, violations []differ.Violation) error {
+	switch format {
+	case FormatText:
+		return writeText(w, violations)
+	case FormatJSON:
+		return writeJSON(w, violations)
+	case FormatSARIF:
+		return writeSARIF(w, violations)
+	case FormatMarkdown:
+		return writeMarkdown(w, violations)
+	}
+
+	return fmt.Errorf("unsupported format %q, expected one of: %s", format, strings.Join(PossibleValuesForFormat(), ", "))
+}
+
+func writeText(w io.Writer, violations []differ.Violation) error {
+	for _, v := range violations {
+		if _, err := fmt.Fprintln(w, v.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/differ"
+)
+
+var testViolations = []differ.Violation{
+	{
+		Kind:     differ.KindResource,
+		Name:     "azurerm_example",
+		Property: "sku.tier",
+		Rule:     "possible-values-narrowed",
+		Message:  `Cannot remove Possible Values from property "sku.tier" (Basic)`,
+	},
+	{
+		Kind:    differ.KindResource,
+		Name:    "azurerm_removed",
+		Rule:    "resource-removed",
+		Message: `Cannot remove the resource "azurerm_removed"`,
+	},
+	{
+		Kind:     differ.KindDataSource,
+		Name:     "azurerm_example",
+		Property: "secret",
+		Rule:     "sensitive-removed",
+		Message:  `Cannot remove Sensitive from property "secret"`,
+	},
+}
+
+func TestWriteText(t *testing.T) {
+	buf := bytes.Buffer{}
+	if err := Write(&buf, FormatText, testViolations); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if lines := strings.Split(strings.TrimSpace(buf.String()), "\n"); len(lines) != len(testViolations) {
+		t.Fatalf("expected %d lines but got %d: %s", len(testViolations), len(lines), buf.String())
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	buf := bytes.Buffer{}
+	if err := Write(&buf, FormatJSON, nil); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if strings.TrimSpace(buf.String()) != "[]" {
+		t.Fatalf("expected an empty array for no violations but got %q", buf.String())
+	}
+
+	buf.Reset()
+	if err := Write(&buf, FormatJSON, testViolations); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	var output []differ.Violation
+	if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+		t.Fatalf("parsing the output: %+v", err)
+	}
+	if len(output) != len(testViolations) || output[1] != testViolations[1] {
+		t.Fatalf("expected the violations to round-trip but got %+v", output)
+	}
+}
+
+func TestWriteSARIF(t *testing.T) {
+	buf := bytes.Buffer{}
+	if err := Write(&buf, FormatSARIF, testViolations); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	var output sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+		t.Fatalf("parsing the output: %+v", err)
+	}
+	if output.Version != sarifVersion || len(output.Runs) != 1 {
+		t.Fatalf("expected a single SARIF %s run but got %+v", sarifVersion, output)
+	}
+
+	run := output.Runs[0]
+	if len(run.Tool.Driver.Rules) != 3 || len(run.Results) != 3 {
+		t.Fatalf("expected 3 rules and 3 results but got %d and %d", len(run.Tool.Driver.Rules), len(run.Results))
+	}
+	for _, result := range run.Results {
+		if run.Tool.Driver.Rules[result.RuleIndex].Id != result.RuleId {
+			t.Fatalf("expected the rule index for %q to reference the rule but got %q", result.RuleId, run.Tool.Driver.Rules[result.RuleIndex].Id)
+		}
+	}
+	if name := run.Results[0].Locations[0].LogicalLocations[0].FullyQualifiedName; name != "azurerm_example.sku.tier" {
+		t.Fatalf("expected the location to be `azurerm_example.sku.tier` but got %q", name)
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	buf := bytes.Buffer{}
+	if err := Write(&buf, FormatMarkdown, testViolations); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	output := buf.String()
+	for _, expected := range []string{
+		"### Resources",
+		"### Data Sources",
+		"#### `azurerm_removed`",
+		"* `sku.tier` - ",
+		"(`resource-removed`)",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Fatalf("expected the output to contain %q but got:\n%s", expected, output)
+		}
+	}
+	if strings.Index(output, "### Resources") > strings.Index(output, "### Data Sources") {
+		t.Fatalf("expected Resources to be output before Data Sources but got:\n%s", output)
+	}
+}
+
+func TestWriteUnsupportedFormat(t *testing.T) {
+	if err := Write(&bytes.Buffer{}, Format("xml"), testViolations); err == nil {
+		t.Fatalf("expected an error for an unsupported format but didn't get one")
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/differ"
+)
+
+// the subset of the SARIF 2.1.0 schema used to report violations, see https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	Id               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleId    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+func writeSARIF(w io.Writer, violations []differ.Violation) error {
+	// only the rules which have been violated are included, since the results reference these by index
+	ruleIds := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, v := range violations {
+		if _, ok := seen[v.Rule]; !ok {
+			seen[v.Rule] = struct{}{}
+			ruleIds = append(ruleIds, v.Rule)
+		}
+	}
+	sort.Strings(ruleIds)
+
+	rules := make([]sarifRule, 0, len(ruleIds))
+	ruleIndexes := make(map[string]int, len(ruleIds))
+	for i, id := range ruleIds {
+		rules = append(rules, sarifRule{
+			Id: id,
+			ShortDescription: sarifMessage{
+				Text: id,
+			},
+		})
+		ruleIndexes[id] = i
+	}
+
+	results := make([]sarifResult, 0, len(violations))
+	for _, v := range violations {
+		location := sarifLogicalLocation{
+			Name:               v.Name,
+			FullyQualifiedName: v.Name,
+			Kind:               "type",
+		}
+		if v.Property != "" {
+			location = sarifLogicalLocation{
+				Name:               v.Property,
+				FullyQualifiedName: v.Name + "." + v.Property,
+				Kind:               "member",
+			}
+		}
+
+		results = append(results, sarifResult{
+			RuleId:    v.Rule,
+			RuleIndex: ruleIndexes[v.Rule],
+			Level:     "error",
+			Message: sarifMessage{
+				Text: v.String(),
+			},
+			Locations: []sarifLocation{
+				{
+					LogicalLocations: []sarifLogicalLocation{location},
+				},
+			},
+		})
+	}
+
+	output := sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:  "schema-api",
+						Rules: rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
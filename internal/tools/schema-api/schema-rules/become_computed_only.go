@@ -15,6 +15,10 @@ type becomeComputedOnly struct {
 
 var _ BreakingChangeRule = becomeComputedOnly{}
 
+func (becomeComputedOnly) Name() string {
+	return "become-computed-only"
+}
+
 // Check - Checks that an Optional or Required property is not updated to become Computed only
 func (o becomeComputedOnly) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
 	if (base.Optional || base.Required) && (!current.Optional && !current.Required && current.Computed) {
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+type forceNewAdded struct{}
+
+var _ BreakingChangeRule = forceNewAdded{}
+
+func (forceNewAdded) Name() string {
+	return "force-new-added"
+}
+
+// Check - Checks that an existing property is not updated to become ForceNew, since changing this would then recreate the resource
+func (forceNewAdded) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type != "" && !base.ForceNew && current.ForceNew {
+		return pointer.To(fmt.Sprintf("Cannot change property %q to be ForceNew", propertyName))
+	}
+
+	return nil
+}
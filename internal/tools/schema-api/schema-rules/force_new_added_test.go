@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var forceNewAddedBaseNode = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+	ForceNew: false,
+}
+
+var forceNewAddedPasses = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+	ForceNew: false,
+}
+
+var forceNewAddedViolates = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+	ForceNew: true, // violation
+}
+
+func TestForceNewAdded_Check(t *testing.T) {
+	data := forceNewAdded{}
+	if res := data.Check(forceNewAddedBaseNode, forceNewAddedPasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(providerjson.SchemaJSON{}, forceNewAddedViolates, ""); res != nil {
+		t.Errorf("expected no violation for a new property, got %+v", res)
+	}
+	if res := data.Check(forceNewAddedViolates, forceNewAddedPasses, ""); res != nil {
+		t.Errorf("expected no violation when removing ForceNew, got %+v", res)
+	}
+	if res := data.Check(forceNewAddedBaseNode, forceNewAddedViolates, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+}
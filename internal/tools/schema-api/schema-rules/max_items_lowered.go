@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+type maxItemsLowered struct{}
+
+var _ BreakingChangeRule = maxItemsLowered{}
+
+func (maxItemsLowered) Name() string {
+	return "max-items-lowered"
+}
+
+// Check - Checks that the MaxItems of an existing property is not lowered (or introduced), since users configurations may contain more items
+func (maxItemsLowered) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type == "" || current.Type == "" || current.MaxItems == 0 {
+		return nil
+	}
+
+	if base.MaxItems == 0 || current.MaxItems < base.MaxItems {
+		return pointer.To(fmt.Sprintf("Cannot lower MaxItems for property %q (%d to %d)", propertyName, base.MaxItems, current.MaxItems))
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var maxItemsLoweredBaseNode = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 5,
+}
+
+var maxItemsLoweredPasses = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 10,
+}
+
+var maxItemsLoweredViolates = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 1, // violation
+}
+
+var maxItemsLoweredUnlimited = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 0,
+}
+
+func TestMaxItemsLowered_Check(t *testing.T) {
+	data := maxItemsLowered{}
+	if res := data.Check(maxItemsLoweredBaseNode, maxItemsLoweredPasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(maxItemsLoweredBaseNode, maxItemsLoweredUnlimited, ""); res != nil {
+		t.Errorf("expected no violation when removing MaxItems, got %+v", res)
+	}
+	if res := data.Check(maxItemsLoweredBaseNode, maxItemsLoweredViolates, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+	if res := data.Check(maxItemsLoweredUnlimited, maxItemsLoweredViolates, ""); res == nil {
+		t.Errorf("expected violation when introducing MaxItems, but didn't get one")
+	}
+}
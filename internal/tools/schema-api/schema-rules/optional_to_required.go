@@ -15,6 +15,10 @@ type optionalToRequired struct {
 
 var _ BreakingChangeRule = optionalToRequired{}
 
+func (optionalToRequired) Name() string {
+	return "optional-to-required"
+}
+
 // Check - Checks that an Optional property is not update to become Required
 func (o optionalToRequired) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
 	if base.Optional && current.Required {
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+type possibleValuesNarrowed struct{}
+
+var _ BreakingChangeRule = possibleValuesNarrowed{}
+
+func (possibleValuesNarrowed) Name() string {
+	return "possible-values-narrowed"
+}
+
+// Check - Checks that none of the Possible Values of an existing property have been removed, since these may be used in users configurations.
+// Properties without Possible Values in the base schema (including those from schemas exported prior to these being included) are skipped.
+func (possibleValuesNarrowed) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if len(base.PossibleValues) == 0 || current.Type == "" || len(current.PossibleValues) == 0 {
+		return nil
+	}
+
+	existing := make(map[string]struct{}, len(current.PossibleValues))
+	for _, v := range current.PossibleValues {
+		existing[v] = struct{}{}
+	}
+
+	removed := make([]string, 0)
+	for _, v := range base.PossibleValues {
+		if _, ok := existing[v]; !ok {
+			removed = append(removed, v)
+		}
+	}
+
+	if len(removed) > 0 {
+		return pointer.To(fmt.Sprintf("Cannot remove Possible Values from property %q (%s)", propertyName, strings.Join(removed, ", ")))
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var possibleValuesNarrowedBaseNode = providerjson.SchemaJSON{
+	Type:           providerjson.SchemaTypeString,
+	Optional:       true,
+	PossibleValues: []string{"Basic", "Standard"},
+}
+
+var possibleValuesNarrowedPasses = providerjson.SchemaJSON{
+	Type:           providerjson.SchemaTypeString,
+	Optional:       true,
+	PossibleValues: []string{"Basic", "Premium", "Standard"},
+}
+
+var possibleValuesNarrowedViolates = providerjson.SchemaJSON{
+	Type:           providerjson.SchemaTypeString,
+	Optional:       true,
+	PossibleValues: []string{"Standard"}, // violation
+}
+
+var possibleValuesNarrowedUnvalidated = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+}
+
+func TestPossibleValuesNarrowed_Check(t *testing.T) {
+	data := possibleValuesNarrowed{}
+	if res := data.Check(possibleValuesNarrowedBaseNode, possibleValuesNarrowedPasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(possibleValuesNarrowedBaseNode, possibleValuesNarrowedUnvalidated, ""); res != nil {
+		t.Errorf("expected no violation when removing the validation, got %+v", res)
+	}
+	if res := data.Check(possibleValuesNarrowedUnvalidated, possibleValuesNarrowedViolates, ""); res != nil {
+		t.Errorf("expected no violation when the base has no Possible Values, got %+v", res)
+	}
+	if res := data.Check(possibleValuesNarrowedBaseNode, possibleValuesNarrowedViolates, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+}
@@ -6,17 +6,35 @@ package schema_rules
 import "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 
 type BreakingChangeRule interface {
+	// Name returns the identifier of this rule, which is used in the machine-readable reports
+	Name() string
+
 	Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string
 }
 
+const (
+	// ResourceRemoved is the identifier of the rule which checks that a Resource has not been removed
+	ResourceRemoved = "resource-removed"
+
+	// DataSourceRemoved is the identifier of the rule which checks that a Data Source has not been removed
+	DataSourceRemoved = "data-source-removed"
+)
+
 var BreakingChangeRules = []BreakingChangeRule{
 	becomeComputedOnly{},
+	forceNewAdded{},
+	maxItemsLowered{},
 	newRequiredPropertyExistingResource{},
 	optionalRemoveComputed{},
 	optionalToRequired{},
+	possibleValuesNarrowed{},
+	propertyRemoved{},
 	propertyType{},
+	sensitiveRemoved{},
 }
 
 var BreakingChangeRulesDataSource = []BreakingChangeRule{
+	propertyRemoved{},
 	propertyType{},
+	sensitiveRemoved{},
 }
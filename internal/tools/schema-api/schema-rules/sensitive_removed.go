@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+type sensitiveRemoved struct{}
+
+var _ BreakingChangeRule = sensitiveRemoved{}
+
+func (sensitiveRemoved) Name() string {
+	return "sensitive-removed"
+}
+
+// Check - Checks that Sensitive is not removed from an existing property, since the value would then be exposed in the plan and outputs
+func (sensitiveRemoved) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Sensitive && current.Type != "" && !current.Sensitive {
+		return pointer.To(fmt.Sprintf("Cannot remove Sensitive from property %q", propertyName))
+	}
+
+	return nil
+}
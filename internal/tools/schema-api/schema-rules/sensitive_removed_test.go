@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var sensitiveRemovedBaseNode = providerjson.SchemaJSON{
+	Type:      providerjson.SchemaTypeString,
+	Computed:  true,
+	Sensitive: true,
+}
+
+var sensitiveRemovedPasses = providerjson.SchemaJSON{
+	Type:      providerjson.SchemaTypeString,
+	Computed:  true,
+	Sensitive: true,
+}
+
+var sensitiveRemovedViolates = providerjson.SchemaJSON{
+	Type:      providerjson.SchemaTypeString,
+	Computed:  true,
+	Sensitive: false, // violation
+}
+
+func TestSensitiveRemoved_Check(t *testing.T) {
+	data := sensitiveRemoved{}
+	if res := data.Check(sensitiveRemovedBaseNode, sensitiveRemovedPasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(sensitiveRemovedViolates, sensitiveRemovedBaseNode, ""); res != nil {
+		t.Errorf("expected no violation when adding Sensitive, got %+v", res)
+	}
+	if res := data.Check(sensitiveRemovedBaseNode, sensitiveRemovedViolates, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+}
@@ -14,7 +14,8 @@ import (
 )
 
 var allRules = map[string]rules.Rule{
-	rules.TypedSDKBitCheck{}.Name(): rules.TypedSDKBitCheck{},
+	rules.TypedSDKBitCheck{}.Name():      rules.TypedSDKBitCheck{},
+	rules.TypedModelSchemaCheck{}.Name(): rules.TypedModelSchemaCheck{},
 }
 
 func main() {
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ Rule = TypedModelSchemaCheck{}
+
+type TypedModelSchemaCheck struct{}
+
+func (r TypedModelSchemaCheck) Run() (errors []error) {
+	for _, s := range provider.SupportedTypedServices() {
+		for _, resource := range provider.TypedResourcesForService(s) {
+			errors = append(errors, checkTypedModel(resource.ResourceType(), resource.ModelObject(), resource.Arguments(), resource.Attributes())...)
+		}
+		for _, datasource := range s.DataSources() {
+			errors = append(errors, checkTypedModel(datasource.ResourceType(), datasource.ModelObject(), datasource.Arguments(), datasource.Attributes())...)
+		}
+	}
+
+	return
+}
+
+func (r TypedModelSchemaCheck) Name() string {
+	return "checkModelSchemaConsistency"
+}
+
+func (r TypedModelSchemaCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check that the model used by a TypedSDK resource or data source matches its schema, that is that
+each property in the 'Arguments()' and 'Attributes()' has a field with a matching 'tfschema' tag (and vice versa) of a compatible Go type.
+`, r.Name())
+}
+
+func checkTypedModel(resourceType string, modelObject interface{}, arguments, attributes map[string]*pluginsdk.Schema) []error {
+	modelType := reflect.TypeOf(modelObject)
+	if modelType == nil {
+		// base types (e.g. roleAssignmentBaseResource) don't have a model
+		return nil
+	}
+	if modelType.Kind() != reflect.Ptr || modelType.Elem().Kind() != reflect.Struct {
+		return []error{fmt.Errorf("%q cannot be checked, ModelObject did not return a pointer to a struct\n", resourceType)}
+	}
+
+	s := make(map[string]*pluginsdk.Schema, len(arguments)+len(attributes))
+	for k, v := range arguments {
+		s[k] = v
+	}
+	for k, v := range attributes {
+		s[k] = v
+	}
+
+	return compareModelToSchema(resourceType, "", modelType.Elem(), s)
+}
+
+// modelField is a field within a model with a `tfschema` struct tag
+type modelField struct {
+	field reflect.StructField
+
+	// conditional specifies that this field is only present in the schema for a specific major version of the provider
+	conditional bool
+}
+
+func compareModelToSchema(resourceType, path string, model reflect.Type, s map[string]*pluginsdk.Schema) (errors []error) {
+	fields := make(map[string]modelField)
+	for i := 0; i < model.NumField(); i++ {
+		field := model.Field(i)
+		tag, ok := field.Tag.Lookup("tfschema")
+		if !ok {
+			continue
+		}
+
+		components := strings.Split(tag, ",")
+		name := strings.TrimSpace(components[0])
+		if name == "" {
+			errors = append(errors, fmt.Errorf("%s: field %s in model %s has an empty `tfschema` tag\n", resourceType, field.Name, model.Name()))
+			continue
+		}
+		if existing, ok := fields[name]; ok {
+			errors = append(errors, fmt.Errorf("%s: fields %s and %s in model %s both have the `tfschema` tag %q\n", resourceType, existing.field.Name, field.Name, model.Name(), name))
+			continue
+		}
+
+		conditional := false
+		for _, v := range components[1:] {
+			v = strings.TrimSpace(v)
+			if strings.EqualFold(v, "addedInNextMajorVersion") || strings.EqualFold(v, "removedInNextMajorVersion") {
+				conditional = true
+			}
+		}
+		fields[name] = modelField{
+			field:       field,
+			conditional: conditional,
+		}
+	}
+
+	names := make([]string, 0, len(s))
+	for k := range s {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		property := propertyPath(path, name)
+		f, ok := fields[name]
+		if !ok {
+			errors = append(errors, fmt.Errorf("%s: property %q has no field with the `tfschema` tag %q in model %s\n", resourceType, property, name, model.Name()))
+			continue
+		}
+
+		errors = append(errors, compareFieldToSchema(resourceType, property, model, f.field, s[name])...)
+	}
+
+	fieldNames := make([]string, 0, len(fields))
+	for k := range fields {
+		fieldNames = append(fieldNames, k)
+	}
+	sort.Strings(fieldNames)
+
+	for _, name := range fieldNames {
+		// fields which are only present in a specific major version of the provider are conditionally in the schema
+		if _, ok := s[name]; !ok && !fields[name].conditional {
+			errors = append(errors, fmt.Errorf("%s: field %s in model %s has the `tfschema` tag %q which isn't in the schema\n", resourceType, fields[name].field.Name, model.Name(), propertyPath(path, name)))
+		}
+	}
+
+	return
+}
+
+func compareFieldToSchema(resourceType, property string, model reflect.Type, field reflect.StructField, s *pluginsdk.Schema) (errors []error) {
+	if s == nil {
+		return nil
+	}
+
+	t := field.Type
+	if t.Kind() == reflect.Ptr {
+		// a property which is always set doesn't need to distinguish between being unset and the zero value
+		if s.Required || (s.Computed && !s.Optional) {
+			errors = append(errors, fmt.Errorf("%s: field %s in model %s is a pointer but property %q is always set, expected `%s`\n", resourceType, field.Name, model.Name(), property, t.Elem().String()))
+		}
+		t = t.Elem()
+	}
+
+	mismatch := func(expected string) error {
+		return fmt.Errorf("%s: field %s in model %s should be %s for the %s property %q, got `%s`\n", resourceType, field.Name, model.Name(), expected, s.Type.String(), property, field.Type.String())
+	}
+
+	switch {
+	case isPrimitiveType(s.Type):
+		if !kindMatchesType(t.Kind(), s.Type) {
+			errors = append(errors, mismatch(expectedTypeName(s.Type)))
+		}
+
+	case s.Type == pluginsdk.TypeList || s.Type == pluginsdk.TypeSet:
+		if t.Kind() != reflect.Slice {
+			errors = append(errors, mismatch("a slice"))
+			return
+		}
+
+		switch elem := s.Elem.(type) {
+		case *pluginsdk.Resource:
+			nested := t.Elem()
+			if nested.Kind() != reflect.Struct {
+				errors = append(errors, mismatch("a slice of structs"))
+				return
+			}
+			errors = append(errors, compareModelToSchema(resourceType, property, nested, elem.Schema)...)
+
+		case *pluginsdk.Schema:
+			if isPrimitiveType(elem.Type) && !kindMatchesType(t.Elem().Kind(), elem.Type) {
+				errors = append(errors, mismatch("[]"+expectedTypeName(elem.Type)))
+			}
+		}
+
+	case s.Type == pluginsdk.TypeMap:
+		elemType := pluginsdk.TypeString
+		if elem, ok := s.Elem.(*pluginsdk.Schema); ok {
+			elemType = elem.Type
+		}
+		// the values are decoded as-is, so `map[string]interface{}` is also supported
+		if t.Kind() != reflect.Map || t.Key().Kind() != reflect.String || (t.Elem().Kind() != reflect.Interface && !kindMatchesType(t.Elem().Kind(), elemType)) {
+			errors = append(errors, mismatch("map[string]"+expectedTypeName(elemType)))
+		}
+	}
+
+	return
+}
+
+func isPrimitiveType(valueType pluginsdk.ValueType) bool {
+	return valueType == pluginsdk.TypeString || valueType == pluginsdk.TypeInt || valueType == pluginsdk.TypeFloat || valueType == pluginsdk.TypeBool
+}
+
+// kindMatchesType returns whether a field of the specified kind can be decoded from/encoded into a property of the
+// specified type - the width of integers and floats is checked by TypedSDKBitCheck
+func kindMatchesType(kind reflect.Kind, valueType pluginsdk.ValueType) bool {
+	switch valueType {
+	case pluginsdk.TypeString:
+		return kind == reflect.String
+	case pluginsdk.TypeInt:
+		return kind == reflect.Int || kind == reflect.Int8 || kind == reflect.Int16 || kind == reflect.Int32 || kind == reflect.Int64
+	case pluginsdk.TypeFloat:
+		return kind == reflect.Float32 || kind == reflect.Float64
+	case pluginsdk.TypeBool:
+		return kind == reflect.Bool
+	}
+
+	return false
+}
+
+func expectedTypeName(valueType pluginsdk.ValueType) string {
+	switch valueType {
+	case pluginsdk.TypeString:
+		return "string"
+	case pluginsdk.TypeInt:
+		return "int64"
+	case pluginsdk.TypeFloat:
+		return "float64"
+	case pluginsdk.TypeBool:
+		return "bool"
+	}
+
+	return valueType.String()
+}
+
+func propertyPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type testNestedModel struct {
+	Name  string `tfschema:"name"`
+	Count int64  `tfschema:"count"`
+}
+
+type testModel struct {
+	Name        string            `tfschema:"name"`
+	Description *string           `tfschema:"description"`
+	Enabled     bool              `tfschema:"enabled"`
+	Tags        map[string]string `tfschema:"tags"`
+	Zones       []string          `tfschema:"zones"`
+	Nested      []testNestedModel `tfschema:"nested"`
+	Legacy      string            `tfschema:"legacy,removedInNextMajorVersion"`
+	Internal    string
+}
+
+func testArguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+		"zones": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+		"nested": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"count": {
+						Type:     pluginsdk.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func testAttributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func TestTypedModelSchemaCheck_Valid(t *testing.T) {
+	if errors := checkTypedModel("azurerm_example", &testModel{}, testArguments(), testAttributes()); len(errors) > 0 {
+		t.Fatalf("expected no errors but got: %+v", errors)
+	}
+}
+
+func TestTypedModelSchemaCheck_NoModel(t *testing.T) {
+	if errors := checkTypedModel("azurerm_example", nil, testArguments(), testAttributes()); len(errors) > 0 {
+		t.Fatalf("expected no errors but got: %+v", errors)
+	}
+}
+
+func TestTypedModelSchemaCheck_Mismatches(t *testing.T) {
+	arguments := testArguments()
+	// missing a field in the model
+	arguments["sku_name"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+	}
+	// the wrong Go type
+	arguments["enabled"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+	}
+	// a block rather than a list of strings
+	arguments["zones"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"zone": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+	// a map of integers rather than strings
+	arguments["tags"].Elem = &pluginsdk.Schema{
+		Type: pluginsdk.TypeInt,
+	}
+	// a pointer for a property which is always set
+	arguments["description"].Optional = false
+	arguments["description"].Required = true
+	// a nested field which isn't in the schema
+	delete(arguments["nested"].Elem.(*pluginsdk.Resource).Schema, "count")
+
+	errors := checkTypedModel("azurerm_example", &testModel{}, arguments, nil)
+
+	expected := []string{
+		`property "sku_name" has no field`,
+		"field Enabled in model testModel should be string",
+		"field Zones in model testModel should be a slice of structs",
+		"field Tags in model testModel should be map[string]int64",
+		"field Description in model testModel is a pointer",
+		`field Count in model testNestedModel has the ` + "`tfschema`" + ` tag "nested.count" which isn't in the schema`,
+	}
+	if len(errors) != len(expected) {
+		t.Fatalf("expected %d errors but got %d: %+v", len(expected), len(errors), errors)
+	}
+	for _, e := range expected {
+		found := false
+		for _, err := range errors {
+			if strings.Contains(err.Error(), e) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("expected an error containing %q but got: %+v", e, errors)
+		}
+	}
+}
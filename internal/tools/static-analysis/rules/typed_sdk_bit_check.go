@@ -16,7 +16,7 @@ type TypedSDKBitCheck struct{}
 
 func (r TypedSDKBitCheck) Run() (errors []error) {
 	for _, s := range provider.SupportedTypedServices() {
-		for _, resource := range s.Resources() {
+		for _, resource := range provider.TypedResourcesForService(s) {
 			modelType := reflect.TypeOf(resource.ModelObject())
 			switch {
 			case modelType != nil && modelType.Kind() == reflect.Ptr:
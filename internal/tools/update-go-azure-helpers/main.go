@@ -60,7 +60,7 @@ func main() {
 	}
 
 	if err := run(context.Background(), input); err != nil {
-		log.Fatalf(err.Error())
+		log.Fatal(err)
 	}
 }
 
@@ -74,7 +74,7 @@ func main() {
 	}
 
 	if err := run(context.Background(), input); err != nil {
-		log.Fatalf(err.Error())
+		log.Fatal(err)
 	}
 }
 
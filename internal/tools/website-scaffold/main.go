@@ -139,7 +139,7 @@ func getContent(resourceName, brandName string, resourceId *string, isResource b
 		}
 	} else {
 		for _, service := range provider.SupportedTypedServices() {
-			for _, rs := range service.Resources() {
+			for _, rs := range provider.TypedResourcesForService(service) {
 				if rs.ResourceType() == resourceName {
 					wrapper := sdk.NewResourceWrapper(rs)
 					rsWrapper, err := wrapper.Resource()
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	AttributePollerType      = attribute.Key("azurerm.poller.type")
+	AttributePollerIteration = attribute.Key("azurerm.poller.iteration")
+	AttributePollerStatus    = attribute.Key("azurerm.poller.status")
+)
+
+var _ pollers.PollerType = &tracedPoller{}
+
+type tracedPoller struct {
+	poller    pollers.PollerType
+	iteration int
+}
+
+// WrapPoller returns a PollerType which records each poll of the specified PollerType as a span
+func WrapPoller(poller pollers.PollerType) pollers.PollerType {
+	return &tracedPoller{
+		poller: poller,
+	}
+}
+
+func (p *tracedPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	p.iteration++
+
+	ctx, span := StartSpan(ctx, "poll", trace.WithAttributes(
+		AttributePollerType.String(fmt.Sprintf("%T", p.poller)),
+		AttributePollerIteration.Int(p.iteration),
+	))
+
+	result, err := p.poller.Poll(ctx)
+	if result != nil {
+		span.SetAttributes(AttributePollerStatus.String(string(result.Status)))
+	}
+	End(span, err)
+
+	return result, err
+}
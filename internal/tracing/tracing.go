@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-azurerm/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// EnvExporter is the Environment Variable used to enable tracing, by specifying where spans are exported to
+	EnvExporter = "ARM_TRACING_EXPORTER"
+
+	// EnvFilePath is the Environment Variable containing the path of the file which spans are written to, when
+	// using the `file` Exporter
+	EnvFilePath = "ARM_TRACING_FILE_PATH"
+)
+
+const instrumentationName = "github.com/hashicorp/terraform-provider-azurerm"
+
+type Exporter string
+
+const (
+	// ExporterOTLP exports spans to an OpenTelemetry Collector using OTLP over HTTP, which is configured
+	// using the standard `OTEL_EXPORTER_OTLP_*` Environment Variables
+	ExporterOTLP Exporter = "otlp"
+
+	// ExporterFile appends each span (as a line of JSON) to the file specified in `ARM_TRACING_FILE_PATH`
+	ExporterFile Exporter = "file"
+)
+
+const (
+	AttributeCorrelationRequestID = attribute.Key("azurerm.correlation_request_id")
+	AttributeResourceType         = attribute.Key("azurerm.resource_type")
+	AttributeOperation            = attribute.Key("azurerm.operation")
+	AttributeResourceID           = attribute.Key("azurerm.resource_id")
+)
+
+var (
+	correlationRequestIDLock sync.RWMutex
+	correlationRequestID     string
+)
+
+// ExporterFromEnvironment returns the Exporter specified in the `ARM_TRACING_EXPORTER` Environment Variable,
+// or an empty string when tracing is disabled
+func ExporterFromEnvironment() Exporter {
+	return Exporter(strings.ToLower(strings.TrimSpace(os.Getenv(EnvExporter))))
+}
+
+// Start configures the global Tracer Provider using the Exporter specified in the `ARM_TRACING_EXPORTER`
+// Environment Variable, returning a function which flushes any pending spans and should be called prior
+// to the Provider exiting. When tracing is disabled spans are discarded.
+func Start(ctx context.Context) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+
+	var exporter sdktrace.SpanExporter
+	var closer func() error
+	var options []sdktrace.TracerProviderOption
+
+	mode := ExporterFromEnvironment()
+	switch mode {
+	case "":
+		return noop, nil
+
+	case ExporterOTLP:
+		client, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return noop, fmt.Errorf("building OTLP exporter: %+v", err)
+		}
+		exporter = client
+		options = append(options, sdktrace.WithBatcher(exporter))
+
+	case ExporterFile:
+		path := os.Getenv(EnvFilePath)
+		if path == "" {
+			return noop, fmt.Errorf("`%s` must be set when `%s` is %q", EnvFilePath, EnvExporter, string(ExporterFile))
+		}
+
+		// the Provider is launched multiple times during a Terraform run, so spans are appended
+		file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return noop, fmt.Errorf("opening %q: %+v", path, err)
+		}
+		closer = file.Close
+
+		writer, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			file.Close()
+			return noop, fmt.Errorf("building file exporter: %+v", err)
+		}
+		exporter = writer
+		// spans are written as they end, so that they're retained should the Provider be terminated
+		options = append(options, sdktrace.WithSyncer(exporter))
+
+	default:
+		return noop, fmt.Errorf("unsupported value %q for `%s` - supported values are %q and %q", string(mode), EnvExporter, string(ExporterOTLP), string(ExporterFile))
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", "terraform-provider-azurerm"),
+		attribute.String("service.version", version.ProviderVersion),
+	))
+	if err != nil {
+		res = resource.Default()
+	}
+	options = append(options, sdktrace.WithResource(res))
+
+	provider := sdktrace.NewTracerProvider(options...)
+	otel.SetTracerProvider(provider)
+	log.Printf("[DEBUG] Exporting OpenTelemetry spans using the %q exporter", string(mode))
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closer != nil {
+			err = errors.Join(err, closer())
+		}
+		return err
+	}, nil
+}
+
+// SetCorrelationRequestID sets the Correlation Request ID which spans are tagged with
+func SetCorrelationRequestID(id string) {
+	correlationRequestIDLock.Lock()
+	defer correlationRequestIDLock.Unlock()
+	correlationRequestID = id
+}
+
+func currentCorrelationRequestID() string {
+	correlationRequestIDLock.RLock()
+	defer correlationRequestIDLock.RUnlock()
+	return correlationRequestID
+}
+
+// StartSpan starts a span as a child of any span within ctx, tagged with the Correlation Request ID. The span
+// must be ended by the caller, typically using End.
+func StartSpan(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if id := currentCorrelationRequestID(); id != "" {
+		options = append(options, trace.WithAttributes(AttributeCorrelationRequestID.String(id)))
+	}
+
+	return otel.Tracer(instrumentationName).Start(ctx, name, options...)
+}
+
+// End records the error (if any) against the span and then ends it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestStartDisabled(t *testing.T) {
+	t.Setenv(EnvExporter, "")
+
+	shutdown, err := Start(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("unexpected error during shutdown: %+v", err)
+	}
+}
+
+func TestStartInvalid(t *testing.T) {
+	t.Setenv(EnvExporter, "zipkin")
+
+	if _, err := Start(context.Background()); err == nil {
+		t.Fatalf("expected an error for an unsupported exporter")
+	}
+
+	t.Setenv(EnvExporter, string(ExporterFile))
+	t.Setenv(EnvFilePath, "")
+
+	if _, err := Start(context.Background()); err == nil {
+		t.Fatalf("expected an error when the file path isn't specified")
+	}
+}
+
+func TestStartFile(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	defer otel.SetTracerProvider(previous)
+
+	path := filepath.Join(t.TempDir(), "spans.json")
+	t.Setenv(EnvExporter, "FILE")
+	t.Setenv(EnvFilePath, path)
+	SetCorrelationRequestID("00000000-0000-0000-0000-000000000001")
+	defer SetCorrelationRequestID("")
+
+	ctx := context.Background()
+	shutdown, err := Start(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	_, span := StartSpan(ctx, "Create azurerm_resource_group")
+	End(span, fmt.Errorf("bad things happened"))
+
+	if err := shutdown(ctx); err != nil {
+		t.Fatalf("unexpected error during shutdown: %+v", err)
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading %q: %+v", path, err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 span but got %d: %s", len(lines), contents)
+	}
+
+	var written struct {
+		Name       string
+		Attributes []struct {
+			Key   string
+			Value struct {
+				Value interface{}
+			}
+		}
+		Status struct {
+			Code string
+		}
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &written); err != nil {
+		t.Fatalf("parsing span: %+v", err)
+	}
+
+	if written.Name != "Create azurerm_resource_group" {
+		t.Fatalf("expected the span name `Create azurerm_resource_group` but got %q", written.Name)
+	}
+	if written.Status.Code != "Error" {
+		t.Fatalf("expected the span status `Error` but got %q", written.Status.Code)
+	}
+
+	found := false
+	for _, v := range written.Attributes {
+		if v.Key == string(AttributeCorrelationRequestID) && v.Value.Value == "00000000-0000-0000-0000-000000000001" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected the span to be tagged with the Correlation Request ID: %s", lines[0])
+	}
+}
+
+type testPoller struct {
+	statuses []pollers.PollingStatus
+}
+
+func (p *testPoller) Poll(_ context.Context) (*pollers.PollResult, error) {
+	status := p.statuses[0]
+	p.statuses = p.statuses[1:]
+	return &pollers.PollResult{
+		Status: status,
+	}, nil
+}
+
+func TestWrapPoller(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	defer otel.SetTracerProvider(previous)
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	poller := WrapPoller(&testPoller{
+		statuses: []pollers.PollingStatus{pollers.PollingStatusInProgress, pollers.PollingStatusSucceeded},
+	})
+	for i := 0; i < 2; i++ {
+		if _, err := poller.Poll(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans but got %d", len(spans))
+	}
+
+	expected := []string{string(pollers.PollingStatusInProgress), string(pollers.PollingStatusSucceeded)}
+	for i, span := range spans {
+		if span.Status().Code == codes.Error {
+			t.Fatalf("expected span %d not to be an error", i)
+		}
+
+		attributes := make(map[string]interface{})
+		for _, v := range span.Attributes() {
+			attributes[string(v.Key)] = v.Value.AsInterface()
+		}
+		if attributes[string(AttributePollerIteration)] != int64(i+1) {
+			t.Fatalf("expected span %d to be iteration %d but got %v", i, i+1, attributes[string(AttributePollerIteration)])
+		}
+		if attributes[string(AttributePollerStatus)] != expected[i] {
+			t.Fatalf("expected span %d to have the status %q but got %v", i, expected[i], attributes[string(AttributePollerStatus)])
+		}
+		if attributes[string(AttributePollerType)] != "*tracing.testPoller" {
+			t.Fatalf("expected span %d to have the type `*tracing.testPoller` but got %v", i, attributes[string(AttributePollerType)])
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tracing"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
@@ -27,6 +28,16 @@ func main() {
 
 	ctx := context.Background()
 
+	shutdownTracing, err := tracing.Start(ctx)
+	if err != nil {
+		log.Fatalf("configuring OpenTelemetry tracing: %+v", err)
+	}
+	defer func() {
+		if err := shutdownTracing(ctx); err != nil {
+			log.Printf("[WARN] flushing OpenTelemetry spans: %+v", err)
+		}
+	}()
+
 	if features.FourPointOhBeta() {
 		providerServer, _, err := framework.ProtoV5ProviderServerFactory(ctx)
 		if err != nil {
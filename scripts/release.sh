@@ -120,7 +120,7 @@ fi
 echo "exporting Provider Schema JSON"
 (
   set -x
-  ${debug}go run internal/tools/schema-api/main.go -export .release/provider-schema.json
+  ${debug}go run -gcflags=all=-l internal/tools/schema-api/main.go -export .release/provider-schema.json
 )
 
 echo "Committing changelog and provider schema..."
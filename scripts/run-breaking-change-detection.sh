@@ -3,12 +3,14 @@
 # SPDX-License-Identifier: MPL-2.0
 
 
+# inlining is disabled so that the Possible Values of each property can be determined, additional arguments (for
+# example `-format sarif -output breaking-changes.sarif`) are passed to the tool
 function runDetect {
-  go run internal/tools/schema-api/main.go -detect .release/provider-schema.json
+  go run -gcflags=all=-l internal/tools/schema-api/main.go -detect .release/provider-schema.json "$@"
 }
 
 function main {
-  runDetect
+  runDetect "$@"
 }
 
-main
\ No newline at end of file
+main "$@"
\ No newline at end of file